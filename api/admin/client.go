@@ -25,12 +25,15 @@ type Client interface {
 	Alias(ctx context.Context, endpoint string, alias string, options ...rpc.Option) error
 	AliasChain(ctx context.Context, chainID string, alias string, options ...rpc.Option) error
 	GetChainAliases(ctx context.Context, chainID string, options ...rpc.Option) ([]string, error)
+	Reindex(ctx context.Context, chain string, options ...rpc.Option) error
 	Stacktrace(context.Context, ...rpc.Option) error
 	LoadVMs(context.Context, ...rpc.Option) (map[ids.ID][]string, map[ids.ID]string, error)
 	SetLoggerLevel(ctx context.Context, loggerName, logLevel, displayLevel string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetLoggerLevel(ctx context.Context, loggerName string, options ...rpc.Option) (map[string]LogAndDisplayLevels, error)
 	GetConfig(ctx context.Context, options ...rpc.Option) (interface{}, error)
 	DBGet(ctx context.Context, key []byte, options ...rpc.Option) ([]byte, error)
+	AddPeer(ctx context.Context, nodeID ids.NodeID, ip string, trusted bool, options ...rpc.Option) error
+	RemovePeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error
 }
 
 // Client implementation for the Avalanche Platform Info API Endpoint
@@ -83,6 +86,12 @@ func (c *client) GetChainAliases(ctx context.Context, chain string, options ...r
 	return res.Aliases, err
 }
 
+func (c *client) Reindex(ctx context.Context, chain string, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.reindex", &ReindexArgs{
+		Chain: chain,
+	}, &api.EmptyReply{}, options...)
+}
+
 func (c *client) Stacktrace(ctx context.Context, options ...rpc.Option) error {
 	return c.requester.SendRequest(ctx, "admin.stacktrace", struct{}{}, &api.EmptyReply{}, options...)
 }
@@ -163,3 +172,17 @@ func (c *client) DBGet(ctx context.Context, key []byte, options ...rpc.Option) (
 	}
 	return formatting.Decode(formatting.HexNC, res.Value)
 }
+
+func (c *client) AddPeer(ctx context.Context, nodeID ids.NodeID, ip string, trusted bool, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.addPeer", &AddPeerArgs{
+		NodeID:  nodeID,
+		IP:      ip,
+		Trusted: trusted,
+	}, &api.EmptyReply{}, options...)
+}
+
+func (c *client) RemovePeer(ctx context.Context, nodeID ids.NodeID, options ...rpc.Option) error {
+	return c.requester.SendRequest(ctx, "admin.removePeer", &RemovePeerArgs{
+		NodeID: nodeID,
+	}, &api.EmptyReply{}, options...)
+}
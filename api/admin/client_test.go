@@ -155,6 +155,16 @@ func TestGetChainAliases(t *testing.T) {
 	})
 }
 
+func TestReindex(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.Reindex(context.Background(), "chain")
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestStacktrace(t *testing.T) {
 	for _, test := range SuccessResponseTests {
 		t.Run(test.name, func(t *testing.T) {
@@ -165,6 +175,26 @@ func TestStacktrace(t *testing.T) {
 	}
 }
 
+func TestAddPeer(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.AddPeer(context.Background(), ids.GenerateTestNodeID(), "127.0.0.1:9651", true)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestRemovePeer(t *testing.T) {
+	for _, test := range SuccessResponseTests {
+		t.Run(test.name, func(t *testing.T) {
+			mockClient := client{requester: NewMockClient(&api.EmptyReply{}, test.expectedErr)}
+			err := mockClient.RemovePeer(context.Background(), ids.GenerateTestNodeID())
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
 func TestReloadInstalledVMs(t *testing.T) {
 	t.Run("successful", func(t *testing.T) {
 		require := require.New(t)
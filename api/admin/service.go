@@ -18,9 +18,12 @@ import (
 	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/rpcdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/indexer"
+	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/ips"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/perms"
@@ -41,6 +44,7 @@ const (
 var (
 	errAliasTooLong = errors.New("alias length is too long")
 	errNoLogLevel   = errors.New("need to specify either displayLevel or logLevel")
+	errPeerNotFound = errors.New("peer isn't pinned")
 )
 
 type Config struct {
@@ -50,9 +54,11 @@ type Config struct {
 	NodeConfig   interface{}
 	DB           database.Database
 	ChainManager chains.Manager
+	Indexer      indexer.Indexer
 	HTTPServer   server.PathAdderWithReadLock
 	VMRegistry   registry.VMRegistry
 	VMManager    vms.Manager
+	Network      network.Network
 }
 
 // Admin is the API service for node admin management
@@ -214,6 +220,28 @@ func (a *Admin) GetChainAliases(_ *http.Request, args *GetChainAliasesArgs, repl
 	return err
 }
 
+// ReindexArgs are the arguments for calling Reindex
+type ReindexArgs struct {
+	Chain string `json:"chain"`
+}
+
+// Reindex starts rebuilding the block index of the chain from the blocks it
+// has accepted. The index is rebuilt in the background, so this returns before
+// the index is complete.
+func (a *Admin) Reindex(_ *http.Request, args *ReindexArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "reindex"),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := a.ChainManager.Lookup(args.Chain)
+	if err != nil {
+		return err
+	}
+	return a.Indexer.Reindex(chainID)
+}
+
 // Stacktrace returns the current global stacktrace
 func (a *Admin) Stacktrace(_ *http.Request, _ *struct{}, _ *api.EmptyReply) error {
 	a.Log.Debug("API called",
@@ -413,3 +441,54 @@ func (a *Admin) DbGet(_ *http.Request, args *DBGetArgs, reply *DBGetReply) error
 	reply.Value, err = formatting.Encode(formatting.HexNC, value)
 	return err
 }
+
+// AddPeerArgs are the arguments for calling AddPeer
+type AddPeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	IP     string     `json:"ip"`
+	// Trusted peers are exempt from inbound connection throttling
+	Trusted bool `json:"trusted"`
+}
+
+// AddPeer pins a peer, so that the node always attempts to stay connected to
+// it
+func (a *Admin) AddPeer(_ *http.Request, args *AddPeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "addPeer"),
+		zap.Stringer("nodeID", args.NodeID),
+		logging.UserString("ip", args.IP),
+		zap.Bool("trusted", args.Trusted),
+	)
+
+	ip, err := ips.ToIPPort(args.IP)
+	if err != nil {
+		return err
+	}
+
+	a.Network.AddPinnedPeer(network.PinnedPeer{
+		NodeID:  args.NodeID,
+		IP:      ip,
+		Trusted: args.Trusted,
+	})
+	return nil
+}
+
+// RemovePeerArgs are the arguments for calling RemovePeer
+type RemovePeerArgs struct {
+	NodeID ids.NodeID `json:"nodeID"`
+}
+
+// RemovePeer unpins a peer that was pinned by AddPeer or by the node's config
+func (a *Admin) RemovePeer(_ *http.Request, args *RemovePeerArgs, _ *api.EmptyReply) error {
+	a.Log.Debug("API called",
+		zap.String("service", "admin"),
+		zap.String("method", "removePeer"),
+		zap.Stringer("nodeID", args.NodeID),
+	)
+
+	if !a.Network.RemovePinnedPeer(args.NodeID) {
+		return errPeerNotFound
+	}
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms"
@@ -167,3 +168,46 @@ func TestServiceDBGet(t *testing.T) {
 		})
 	}
 }
+
+type testNetwork struct {
+	network.Network
+	pinnedPeers map[ids.NodeID]network.PinnedPeer
+}
+
+func (n *testNetwork) AddPinnedPeer(peer network.PinnedPeer) {
+	n.pinnedPeers[peer.NodeID] = peer
+}
+
+func (n *testNetwork) RemovePinnedPeer(nodeID ids.NodeID) bool {
+	_, ok := n.pinnedPeers[nodeID]
+	delete(n.pinnedPeers, nodeID)
+	return ok
+}
+
+func TestServiceAddAndRemovePeer(t *testing.T) {
+	require := require.New(t)
+
+	net := &testNetwork{
+		pinnedPeers: make(map[ids.NodeID]network.PinnedPeer),
+	}
+	a := &Admin{Config: Config{
+		Log:     logging.NoLog{},
+		Network: net,
+	}}
+
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(a.AddPeer(nil, &AddPeerArgs{
+		NodeID:  nodeID,
+		IP:      "127.0.0.1:9651",
+		Trusted: true,
+	}, nil))
+	require.Contains(net.pinnedPeers, nodeID)
+	require.True(net.pinnedPeers[nodeID].Trusted)
+	require.Equal(uint16(9651), net.pinnedPeers[nodeID].IP.Port)
+
+	require.NoError(a.RemovePeer(nil, &RemovePeerArgs{NodeID: nodeID}, nil))
+	require.Empty(net.pinnedPeers)
+
+	err := a.RemovePeer(nil, &RemovePeerArgs{NodeID: nodeID}, nil)
+	require.ErrorIs(err, errPeerNotFound)
+}
@@ -100,6 +100,9 @@ type FormattedBlock struct {
 type GetTxArgs struct {
 	TxID     ids.ID              `json:"txID"`
 	Encoding formatting.Encoding `json:"encoding"`
+	// Rich annotates the tx with the data that is needed to render it without
+	// further calls. Only supported with the [JSON] encoding.
+	Rich bool `json:"rich"`
 }
 
 // GetTxReply defines an object containing a single [Tx] object along with Encoding
@@ -157,4 +160,66 @@ type GetUTXOsReply struct {
 	EndIndex Index `json:"endIndex"`
 	// Encoding specifies the encoding format the UTXOs are returned in
 	Encoding formatting.Encoding `json:"encoding"`
+	// Spendability of each UTXO, in the same order as [UTXOs]. An entry is
+	// nil if the spendability of its UTXO isn't known.
+	Spendability []*Spendability `json:"spendability,omitempty"`
+}
+
+// Spendability describes when, and by whom, a UTXO can be spent. Locktimes are
+// compared against the local time of the node, which is also the time that
+// the node verifies spends against.
+type Spendability struct {
+	// Spendable is true if the UTXO isn't locked and the requested addresses
+	// can provide enough signatures to spend it now
+	Spendable bool `json:"spendable"`
+	// Locktime is the Unix time until which the UTXO can't be spent
+	Locktime avajson.Uint64 `json:"locktime"`
+	// StakeableLocktime is the Unix time until which the UTXO can only be
+	// staked
+	StakeableLocktime avajson.Uint64 `json:"stakeableLocktime,omitempty"`
+	// Threshold is the number of [Addresses] that must sign to spend the UTXO
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// GetExportedUTXOsArgs are arguments for passing into GetExportedUTXOs.
+// Gets the UTXOs that were exported to [DestinationChain], that haven't been
+// imported yet, and that reference at least one address in [Addresses].
+// Returns at most [limit] addresses.
+// If specified, [StartIndex] defines where to start fetching UTXOs (for
+// pagination.)
+type GetExportedUTXOsArgs struct {
+	Addresses        []string            `json:"addresses"`
+	DestinationChain string              `json:"destinationChain"`
+	Limit            avajson.Uint32      `json:"limit"`
+	StartIndex       Index               `json:"startIndex"`
+	Encoding         formatting.Encoding `json:"encoding"`
+}
+
+// GetAtomicUTXOStatusArgs are arguments for passing into GetAtomicUTXOStatus
+type GetAtomicUTXOStatusArgs struct {
+	UTXOID ids.ID `json:"utxoID"`
+	// Chain is the chain that the UTXO was exported to or from
+	Chain string `json:"chain"`
+}
+
+// GetAtomicUTXOStatusReply is the response from calling GetAtomicUTXOStatus
+type GetAtomicUTXOStatusReply struct {
+	// Status is one of:
+	// - Exported: exported by this chain and pending import on [Chain]
+	// - Importable: exported by [Chain] and pending import on this chain
+	// - Imported: imported by this chain in [ImportTxID]
+	// - Unknown: not pending import and not imported by this chain
+	//
+	// Shared memory drops a UTXO once it is imported, so the exporting chain
+	// reports the UTXOs it exported that were already imported as Unknown.
+	// Only the importing chain reports them as Imported.
+	Status string `json:"status"`
+	// ImportTxID is only set if the UTXO was imported by this chain
+	ImportTxID *ids.ID `json:"importTxID,omitempty"`
+	// ImportsIndexed is false while the node is indexing the UTXOs that it
+	// imported before it started indexing them, after upgrading to a version
+	// that serves this method. Until then, imported UTXOs may be reported as
+	// Unknown.
+	ImportsIndexed bool `json:"importsIndexed"`
 }
@@ -24,6 +24,8 @@ type Client interface {
 	GetNetworkName(context.Context, ...rpc.Option) (string, error)
 	GetBlockchainID(context.Context, string, ...rpc.Option) (ids.ID, error)
 	Peers(context.Context, ...rpc.Option) ([]Peer, error)
+	DetailedPeers(context.Context, []ids.NodeID, ...rpc.Option) ([]Peer, error)
+	KnownPeers(context.Context, []ids.NodeID, ...rpc.Option) ([]KnownPeer, error)
 	IsBootstrapped(context.Context, string, ...rpc.Option) (bool, error)
 	GetTxFee(context.Context, ...rpc.Option) (*GetTxFeeResponse, error)
 	Uptime(context.Context, ids.ID, ...rpc.Option) (*UptimeResponse, error)
@@ -86,6 +88,23 @@ func (c *client) Peers(ctx context.Context, options ...rpc.Option) ([]Peer, erro
 	return res.Peers, err
 }
 
+func (c *client) DetailedPeers(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]Peer, error) {
+	res := &PeersReply{}
+	err := c.requester.SendRequest(ctx, "info.peers", &PeersArgs{
+		NodeIDs:  nodeIDs,
+		Detailed: true,
+	}, res, options...)
+	return res.Peers, err
+}
+
+func (c *client) KnownPeers(ctx context.Context, nodeIDs []ids.NodeID, options ...rpc.Option) ([]KnownPeer, error) {
+	res := &KnownPeersReply{}
+	err := c.requester.SendRequest(ctx, "info.knownPeers", &KnownPeersArgs{
+		NodeIDs: nodeIDs,
+	}, res, options...)
+	return res.Peers, err
+}
+
 func (c *client) IsBootstrapped(ctx context.Context, chainID string, options ...rpc.Option) (bool, error) {
 	res := &IsBootstrappedResponse{}
 	err := c.requester.SendRequest(ctx, "info.isBootstrapped", &IsBootstrappedArgs{
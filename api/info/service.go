@@ -210,6 +210,8 @@ func (i *Info) GetBlockchainID(_ *http.Request, args *GetBlockchainIDArgs, reply
 // PeersArgs are the arguments for calling Peers
 type PeersArgs struct {
 	NodeIDs []ids.NodeID `json:"nodeIDs"`
+	// Detailed includes the bandwidth consumed by each peer in the reply.
+	Detailed bool `json:"detailed"`
 }
 
 type Peer struct {
@@ -233,7 +235,7 @@ func (i *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply) error
 		zap.String("method", "peers"),
 	)
 
-	peers := i.networking.PeerInfo(args.NodeIDs)
+	peers := i.networking.PeerInfo(args.NodeIDs, args.Detailed)
 	peerInfo := make([]Peer, len(peers))
 	for index, peer := range peers {
 		benchedIDs := i.benchlist.GetBenched(peer.ID)
@@ -256,6 +258,51 @@ func (i *Info) Peers(_ *http.Request, args *PeersArgs, reply *PeersReply) error
 	return nil
 }
 
+// KnownPeersArgs are the arguments for calling KnownPeers
+type KnownPeersArgs struct {
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+type KnownPeer struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	IP     string     `json:"ip"`
+	// Unix time at which the peer signed [IP]
+	Timestamp json.Uint64 `json:"timestamp"`
+}
+
+// KnownPeersReply are the results from calling KnownPeers
+type KnownPeersReply struct {
+	// Number of elements in [Peers]
+	NumPeers json.Uint64 `json:"numPeers"`
+	// Each element is a known peer
+	Peers []KnownPeer `json:"peers"`
+}
+
+// KnownPeers returns the most recently known IP of every validator, including
+// validators that this node isn't connected to. If [args.NodeIDs] is
+// non-empty, only the IPs of those validators are returned.
+func (i *Info) KnownPeers(_ *http.Request, args *KnownPeersArgs, reply *KnownPeersReply) error {
+	i.log.Debug("API called",
+		zap.String("service", "info"),
+		zap.String("method", "knownPeers"),
+	)
+
+	nodeIDs := set.Of(args.NodeIDs...)
+	reply.Peers = []KnownPeer{}
+	for _, ip := range i.networking.KnownPeerIPs() {
+		if nodeIDs.Len() > 0 && !nodeIDs.Contains(ip.NodeID) {
+			continue
+		}
+		reply.Peers = append(reply.Peers, KnownPeer{
+			NodeID:    ip.NodeID,
+			IP:        ip.IPPort.String(),
+			Timestamp: json.Uint64(ip.Timestamp),
+		})
+	}
+	reply.NumPeers = json.Uint64(len(reply.Peers))
+	return nil
+}
+
 // IsBootstrappedArgs are the arguments for calling IsBootstrapped
 type IsBootstrappedArgs struct {
 	// Alias of the chain
@@ -354,7 +401,7 @@ func (i *Info) Acps(_ *http.Request, _ *struct{}, reply *ACPsReply) error {
 	)
 
 	reply.ACPs = make(map[uint32]*ACP, constants.CurrentACPs.Len())
-	peers := i.networking.PeerInfo(nil)
+	peers := i.networking.PeerInfo(nil, false /*=detailed*/)
 	for _, peer := range peers {
 		weight := json.Uint64(i.validators.GetWeight(constants.PrimaryNetworkID, peer.ID))
 		if weight == 0 {
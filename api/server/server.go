@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/NYTimes/gziphandler"
+	"github.com/gorilla/rpc/v2"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/cors"
 	"go.uber.org/zap"
@@ -24,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/trace"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 )
 
@@ -74,6 +76,11 @@ type HTTPConfig struct {
 	ReadHeaderTimeout time.Duration `json:"readHeaderTimeout"`
 	WriteTimeout      time.Duration `json:"writeHeaderTimeout"`
 	IdleTimeout       time.Duration `json:"idleTimeout"`
+	// MaxBatchSize is the maximum number of calls in a JSON-RPC batch request
+	MaxBatchSize int `json:"maxBatchSize"`
+	// MaxRequestBodySize is the maximum size, in bytes, of the body of a
+	// request to a JSON-RPC handler that supports batch requests
+	MaxRequestBodySize int64 `json:"maxRequestBodySize"`
 }
 
 type server struct {
@@ -89,6 +96,11 @@ type server struct {
 
 	metrics *metrics
 
+	// Maximum number of calls in a JSON-RPC batch request
+	maxBatchSize int
+	// Maximum size, in bytes, of the body of a JSON-RPC request
+	maxRequestBodySize int64
+
 	// Maps endpoints to handlers
 	router *router
 
@@ -152,15 +164,17 @@ func New(
 	)
 
 	return &server{
-		log:             log,
-		factory:         factory,
-		shutdownTimeout: shutdownTimeout,
-		tracingEnabled:  tracingEnabled,
-		tracer:          tracer,
-		metrics:         m,
-		router:          router,
-		srv:             httpServer,
-		listener:        listener,
+		log:                log,
+		factory:            factory,
+		shutdownTimeout:    shutdownTimeout,
+		tracingEnabled:     tracingEnabled,
+		tracer:             tracer,
+		metrics:            m,
+		maxBatchSize:       httpConfig.MaxBatchSize,
+		maxRequestBodySize: httpConfig.MaxRequestBodySize,
+		router:             router,
+		srv:                httpServer,
+		listener:           listener,
 	}, nil
 }
 
@@ -212,9 +226,13 @@ func (s *server) addChainRoute(chainName string, handler http.Handler, ctx *snow
 		zap.String("url", url),
 		zap.String("endpoint", endpoint),
 	)
+	_, isRPCServer := handler.(*rpc.Server)
 	if s.tracingEnabled {
 		handler = api.TraceHandler(handler, chainName, s.tracer)
 	}
+	if isRPCServer {
+		handler = s.wrapBatchHandler(handler)
+	}
 	// Apply middleware to reject calls to the handler before the chain finishes bootstrapping
 	handler = rejectMiddleware(handler, ctx)
 	handler = s.metrics.wrapHandler(chainName, handler)
@@ -238,14 +256,26 @@ func (s *server) addRoute(handler http.Handler, base, endpoint string) error {
 		zap.String("endpoint", endpoint),
 	)
 
+	_, isRPCServer := handler.(*rpc.Server)
 	if s.tracingEnabled {
 		handler = api.TraceHandler(handler, url, s.tracer)
 	}
+	if isRPCServer {
+		handler = s.wrapBatchHandler(handler)
+	}
 
 	handler = s.metrics.wrapHandler(base, handler)
 	return s.router.AddRouter(url, endpoint, handler)
 }
 
+// wrapBatchHandler serves each call of a batch request to [handler] as its own
+// request. It must only wrap gorilla rpc servers, which don't support batch
+// requests. Other handlers, such as the C-chain's, implement their own
+// batching and limits.
+func (s *server) wrapBatchHandler(handler http.Handler) http.Handler {
+	return json.NewBatchHandler(handler, s.maxBatchSize, s.maxRequestBodySize)
+}
+
 // Reject middleware wraps a handler. If the chain that the context describes is
 // not done state-syncing/bootstrapping, writes back an error.
 func rejectMiddleware(handler http.Handler, ctx *snow.ConsensusContext) http.Handler {
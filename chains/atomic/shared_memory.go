@@ -10,7 +10,10 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 )
 
-var _ SharedMemory = (*sharedMemory)(nil)
+var (
+	_ SharedMemory         = (*sharedMemory)(nil)
+	_ OutboundSharedMemory = (*sharedMemory)(nil)
+)
 
 type Requests struct {
 	RemoveRequests [][]byte   `serialize:"true"`
@@ -55,6 +58,35 @@ type SharedMemory interface {
 	Apply(requests map[ids.ID]*Requests, batches ...database.Batch) error
 }
 
+// OutboundSharedMemory allows a blockchain to inspect the values that it has
+// sent to other blockchains that haven't been removed by their recipients yet.
+//
+// It is implemented by the shared memory of blockchains that run in the same
+// process as the shared memory, but isn't exposed over gRPC.
+type OutboundSharedMemory interface {
+	// OutboundGet fetches the values corresponding to [keys] that have been
+	// sent to [peerChainID] and haven't been removed by [peerChainID]
+	//
+	// Invariant: OutboundGet guarantees that the resulting values array is the
+	//            same length as keys.
+	OutboundGet(peerChainID ids.ID, keys [][]byte) (values [][]byte, err error)
+	// OutboundIndexed returns a paginated result of values that possess any of
+	// the given traits, were sent to [peerChainID] and haven't been removed by
+	// [peerChainID].
+	OutboundIndexed(
+		peerChainID ids.ID,
+		traits [][]byte,
+		startTrait,
+		startKey []byte,
+		limit int,
+	) (
+		values [][]byte,
+		lastTrait,
+		lastKey []byte,
+		err error,
+	)
+}
+
 // sharedMemory provides the API for a blockchain to interact with shared memory
 // of another blockchain
 type sharedMemory struct {
@@ -63,12 +95,40 @@ type sharedMemory struct {
 }
 
 func (sm *sharedMemory) Get(peerChainID ids.ID, keys [][]byte) ([][]byte, error) {
+	return sm.get(inbound, peerChainID, keys)
+}
+
+func (sm *sharedMemory) Indexed(
+	peerChainID ids.ID,
+	traits [][]byte,
+	startTrait,
+	startKey []byte,
+	limit int,
+) ([][]byte, []byte, []byte, error) {
+	return sm.indexed(inbound, peerChainID, traits, startTrait, startKey, limit)
+}
+
+func (sm *sharedMemory) OutboundGet(peerChainID ids.ID, keys [][]byte) ([][]byte, error) {
+	return sm.get(outbound, peerChainID, keys)
+}
+
+func (sm *sharedMemory) OutboundIndexed(
+	peerChainID ids.ID,
+	traits [][]byte,
+	startTrait,
+	startKey []byte,
+	limit int,
+) ([][]byte, []byte, []byte, error) {
+	return sm.indexed(outbound, peerChainID, traits, startTrait, startKey, limit)
+}
+
+func (sm *sharedMemory) get(p prefixes, peerChainID ids.ID, keys [][]byte) ([][]byte, error) {
 	sharedID := sharedID(peerChainID, sm.thisChainID)
 	db := sm.m.GetSharedDatabase(sm.m.db, sharedID)
 	defer sm.m.ReleaseSharedDatabase(sharedID)
 
 	s := state{
-		valueDB: inbound.getValueDB(sm.thisChainID, peerChainID, db),
+		valueDB: p.getValueDB(sm.thisChainID, peerChainID, db),
 	}
 
 	values := make([][]byte, len(keys))
@@ -82,7 +142,8 @@ func (sm *sharedMemory) Get(peerChainID ids.ID, keys [][]byte) ([][]byte, error)
 	return values, nil
 }
 
-func (sm *sharedMemory) Indexed(
+func (sm *sharedMemory) indexed(
+	p prefixes,
 	peerChainID ids.ID,
 	traits [][]byte,
 	startTrait,
@@ -94,7 +155,7 @@ func (sm *sharedMemory) Indexed(
 	defer sm.m.ReleaseSharedDatabase(sharedID)
 
 	s := state{}
-	s.valueDB, s.indexDB = inbound.getValueAndIndexDB(sm.thisChainID, peerChainID, db)
+	s.valueDB, s.indexDB = p.getValueAndIndexDB(sm.thisChainID, peerChainID, db)
 
 	keys, lastTrait, lastKey, err := s.getKeys(traits, startTrait, startKey, limit)
 	if err != nil {
@@ -6,6 +6,9 @@ package atomic
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
@@ -29,3 +32,51 @@ func TestSharedMemory(t *testing.T) {
 		test(t, chainID0, chainID1, sm0, sm1, testDB)
 	}
 }
+
+func TestSharedMemoryOutbound(t *testing.T) {
+	require := require.New(t)
+
+	chainID0 := ids.GenerateTestID()
+	chainID1 := ids.GenerateTestID()
+
+	m := NewMemory(memdb.New())
+	sm0 := m.NewSharedMemory(chainID0)
+	sm1 := m.NewSharedMemory(chainID1)
+	outbound0 := sm0.(OutboundSharedMemory)
+
+	require.NoError(sm0.Apply(map[ids.ID]*Requests{chainID1: {PutRequests: []*Element{
+		{
+			Key:    []byte{0},
+			Value:  []byte{1},
+			Traits: [][]byte{{2}},
+		},
+		{
+			Key:    []byte{3},
+			Value:  []byte{4},
+			Traits: [][]byte{{2}},
+		},
+	}}}))
+
+	// Values sent to [chainID1] can be read by the sender
+	values, err := outbound0.OutboundGet(chainID1, [][]byte{{0}, {3}})
+	require.NoError(err)
+	require.Equal([][]byte{{1}, {4}}, values)
+
+	values, _, _, err = outbound0.OutboundIndexed(chainID1, [][]byte{{2}}, nil, nil, 2)
+	require.NoError(err)
+	require.ElementsMatch([][]byte{{1}, {4}}, values)
+
+	// Values weren't sent to other chains
+	_, err = outbound0.OutboundGet(ids.GenerateTestID(), [][]byte{{0}})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Values removed by the recipient are no longer outbound
+	require.NoError(sm1.Apply(map[ids.ID]*Requests{chainID0: {RemoveRequests: [][]byte{{0}}}}))
+
+	_, err = outbound0.OutboundGet(chainID1, [][]byte{{0}})
+	require.ErrorIs(err, database.ErrNotFound)
+
+	values, _, _, err = outbound0.OutboundIndexed(chainID1, [][]byte{{2}}, nil, nil, 2)
+	require.NoError(err)
+	require.Equal([][]byte{{4}}, values)
+}
@@ -28,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/proto/pb/p2p"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/state"
@@ -467,6 +468,7 @@ func (m *manager) buildChain(chainParams ChainParameters, sb subnets.Subnet) (*c
 			ValidatorState: m.validatorState,
 			ChainDataDir:   chainDataDir,
 		},
+		VMID:                chainParams.VMID,
 		BlockAcceptor:       m.BlockAcceptorGroup,
 		TxAcceptor:          m.TxAcceptorGroup,
 		VertexAcceptor:      m.VertexAcceptorGroup,
@@ -803,6 +805,7 @@ func (m *manager) createAvalancheChain(
 		validators.UnhandledSubnetConnector, // avalanche chains don't use subnet connector
 		sb,
 		connectedValidators,
+		m.penalizeInvalidMessage,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("error initializing network handler: %w", err)
@@ -1149,6 +1152,7 @@ func (m *manager) createSnowmanChain(
 		subnetConnector,
 		sb,
 		connectedValidators,
+		m.penalizeInvalidMessage,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't initialize message handler: %w", err)
@@ -1379,6 +1383,12 @@ func (m *manager) LookupVM(alias string) (ids.ID, error) {
 	return m.VMManager.Lookup(alias)
 }
 
+// penalizeInvalidMessage lowers the reputation of [nodeID] for sending a
+// message that a chain's handler dropped because one of its fields is invalid
+func (m *manager) penalizeInvalidMessage(nodeID ids.NodeID) {
+	m.Net.Penalize(nodeID, peer.HandlerError)
+}
+
 // Notify registrants [those who want to know about the creation of chains]
 // that the specified chain has been created
 func (m *manager) notifyRegistrants(name string, ctx *snow.ConsensusContext, vm common.VM) {
@@ -24,6 +24,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/node"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
@@ -103,6 +104,11 @@ var (
 	errUnmarshalling                          = errors.New("unmarshalling failed")
 	errFileDoesNotExist                       = errors.New("file does not exist")
 	errGzipDeprecatedMsg                      = errors.New("gzip compression is not supported, use zstd or no compression")
+	errInvalidPinnedPeer                      = errors.New("invalid pinned peer")
+	errPrivateNodeWithoutSentries             = fmt.Errorf("%s requires %s or %s to be set", NetworkPrivateNodeKey, NetworkStaticPeersKey, NetworkTrustedPeersKey)
+	errInvalidHTTPMaxRequestBodySize          = fmt.Errorf("%s must be > 0", HTTPMaxRequestBodySizeKey)
+	errInvalidAddressBookMaxAge               = fmt.Errorf("%s must be > 0", NetworkAddressBookMaxAgeKey)
+	errPrivateNodeWithBootstrappers           = fmt.Errorf("%s can't be set with %s or %s, as the sentries are used as the bootstrappers", NetworkPrivateNodeKey, BootstrapIDsKey, BootstrapIPsKey)
 )
 
 func getConsensusConfig(v *viper.Viper) snowball.Parameters {
@@ -190,17 +196,25 @@ func getHTTPConfig(v *viper.Viper) (node.HTTPConfig, error) {
 		}
 	}
 
+	maxRequestBodySize := v.GetInt64(HTTPMaxRequestBodySizeKey)
+	if maxRequestBodySize <= 0 {
+		return node.HTTPConfig{}, errInvalidHTTPMaxRequestBodySize
+	}
+
 	return node.HTTPConfig{
 		HTTPConfig: server.HTTPConfig{
-			ReadTimeout:       v.GetDuration(HTTPReadTimeoutKey),
-			ReadHeaderTimeout: v.GetDuration(HTTPReadHeaderTimeoutKey),
-			WriteTimeout:      v.GetDuration(HTTPWriteTimeoutKey),
-			IdleTimeout:       v.GetDuration(HTTPIdleTimeoutKey),
+			ReadTimeout:        v.GetDuration(HTTPReadTimeoutKey),
+			ReadHeaderTimeout:  v.GetDuration(HTTPReadHeaderTimeoutKey),
+			WriteTimeout:       v.GetDuration(HTTPWriteTimeoutKey),
+			IdleTimeout:        v.GetDuration(HTTPIdleTimeoutKey),
+			MaxBatchSize:       int(v.GetUint(HTTPMaxBatchSizeKey)),
+			MaxRequestBodySize: maxRequestBodySize,
 		},
 		APIConfig: node.APIConfig{
 			APIIndexerConfig: node.APIIndexerConfig{
 				IndexAPIEnabled:      v.GetBool(IndexEnabledKey),
 				IndexAllowIncomplete: v.GetBool(IndexAllowIncompleteKey),
+				IndexBackfill:        v.GetBool(IndexBackfillKey),
 			},
 			AdminAPIEnabled:    v.GetBool(AdminAPIEnabledKey),
 			InfoAPIEnabled:     v.GetBool(InfoAPIEnabledKey),
@@ -326,6 +340,11 @@ func getNetworkConfig(
 	// peers that we support these upgrades.
 	supportedACPs.Union(constants.ScheduledACPs)
 
+	pinnedPeers, err := getPinnedPeers(v)
+	if err != nil {
+		return network.Config{}, err
+	}
+
 	config := network.Config{
 		ThrottlerConfig: network.ThrottlerConfig{
 			MaxInboundConnsPerSec: maxInboundConnsPerSec,
@@ -360,6 +379,12 @@ func getNetworkConfig(
 			},
 		},
 
+		ReputationConfig: peer.ReputationConfig{
+			BanThreshold:  v.GetFloat64(NetworkPeerBanThresholdKey),
+			ScoreHalflife: v.GetDuration(NetworkPeerScoreHalflifeKey),
+			BanDuration:   v.GetDuration(NetworkPeerBanDurationKey),
+		},
+
 		HealthConfig: network.HealthConfig{
 			Enabled:                      sybilProtectionEnabled,
 			MaxTimeSinceMsgSent:          v.GetDuration(NetworkHealthMaxTimeSinceMsgSentKey),
@@ -410,6 +435,14 @@ func getNetworkConfig(
 		SupportedACPs: supportedACPs,
 		ObjectedACPs:  objectedACPs,
 
+		PinnedPeers: pinnedPeers,
+		PrivateNode: v.GetBool(NetworkPrivateNodeKey),
+
+		AddressBookMaxAge:   v.GetDuration(NetworkAddressBookMaxAgeKey),
+		AddressBookSyncFreq: v.GetDuration(NetworkAddressBookSyncFreqKey),
+
+		BandwidthMetricsNumPeers: v.GetInt(NetworkBandwidthMetricsNumPeersKey),
+
 		RequireValidatorToConnect: v.GetBool(NetworkRequireValidatorToConnectKey),
 		PeerReadBufferSize:        int(v.GetUint(NetworkPeerReadBufferSizeKey)),
 		PeerWriteBufferSize:       int(v.GetUint(NetworkPeerWriteBufferSizeKey)),
@@ -452,6 +485,20 @@ func getNetworkConfig(
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkReadHandshakeTimeoutKey)
 	case config.MaxClockDifference < 0:
 		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkMaxClockDifferenceKey)
+	case config.AddressBookMaxAge <= 0:
+		return network.Config{}, errInvalidAddressBookMaxAge
+	case config.AddressBookSyncFreq <= 0:
+		return network.Config{}, fmt.Errorf("%s must be > 0", NetworkAddressBookSyncFreqKey)
+	case config.ReputationConfig.BanThreshold < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerBanThresholdKey)
+	case config.ReputationConfig.ScoreHalflife <= 0:
+		return network.Config{}, fmt.Errorf("%s must be > 0", NetworkPeerScoreHalflifeKey)
+	case config.ReputationConfig.BanDuration < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkPeerBanDurationKey)
+	case config.BandwidthMetricsNumPeers < 0:
+		return network.Config{}, fmt.Errorf("%s must be >= 0", NetworkBandwidthMetricsNumPeersKey)
+	case config.PrivateNode && len(config.PinnedPeers) == 0:
+		return network.Config{}, errPrivateNodeWithoutSentries
 	}
 	return config, nil
 }
@@ -515,6 +562,49 @@ func getStateSyncConfig(v *viper.Viper) (node.StateSyncConfig, error) {
 	return config, nil
 }
 
+// getPinnedPeers parses the static and trusted peers, which are provided in the
+// form NodeID@IP:port. A peer that is both static and trusted is trusted.
+func getPinnedPeers(v *viper.Viper) ([]network.PinnedPeer, error) {
+	var (
+		pinnedPeers []network.PinnedPeer
+		indices     = make(map[ids.NodeID]int)
+	)
+	for _, key := range []string{NetworkStaticPeersKey, NetworkTrustedPeersKey} {
+		for _, peerStr := range v.GetStringSlice(key) {
+			peerStr = strings.TrimSpace(peerStr)
+			if peerStr == "" {
+				continue
+			}
+
+			nodeIDStr, ipStr, ok := strings.Cut(peerStr, "@")
+			if !ok {
+				return nil, fmt.Errorf("%w: %q must be in the form NodeID@IP:port", errInvalidPinnedPeer, peerStr)
+			}
+			nodeID, err := ids.NodeIDFromString(nodeIDStr)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't parse %s peer id %s: %w", key, nodeIDStr, err)
+			}
+			ip, err := ips.ToIPPort(ipStr)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't parse %s peer ip %s: %w", key, ipStr, err)
+			}
+
+			pinnedPeer := network.PinnedPeer{
+				NodeID:  nodeID,
+				IP:      ip,
+				Trusted: key == NetworkTrustedPeersKey,
+			}
+			if i, ok := indices[nodeID]; ok {
+				pinnedPeers[i] = pinnedPeer
+				continue
+			}
+			indices[nodeID] = len(pinnedPeers)
+			pinnedPeers = append(pinnedPeers, pinnedPeer)
+		}
+	}
+	return pinnedPeers, nil
+}
+
 func getBootstrapConfig(v *viper.Viper, networkID uint32) (node.BootstrapConfig, error) {
 	config := node.BootstrapConfig{
 		BootstrapBeaconConnectionTimeout:        v.GetDuration(BootstrapBeaconConnectionTimeoutKey),
@@ -1390,6 +1480,21 @@ func GetNodeConfig(v *viper.Viper) (node.Config, error) {
 	if err != nil {
 		return node.Config{}, err
 	}
+	if nodeConfig.NetworkConfig.PrivateNode {
+		if v.IsSet(BootstrapIDsKey) || v.IsSet(BootstrapIPsKey) {
+			return node.Config{}, errPrivateNodeWithBootstrappers
+		}
+
+		// A private node can only connect to its sentries, so it must
+		// bootstrap from them.
+		nodeConfig.Bootstrappers = make([]genesis.Bootstrapper, len(nodeConfig.NetworkConfig.PinnedPeers))
+		for i, pinnedPeer := range nodeConfig.NetworkConfig.PinnedPeers {
+			nodeConfig.Bootstrappers[i] = genesis.Bootstrapper{
+				ID: pinnedPeer.NodeID,
+				IP: ips.IPDesc(pinnedPeer.IP),
+			}
+		}
+	}
 
 	// Chain Configs
 	nodeConfig.ChainConfigs, err = getChainConfigs(v)
@@ -8,9 +8,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -18,9 +20,11 @@ import (
 
 	"github.com/ava-labs/avalanchego/chains"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowball"
 	"github.com/ava-labs/avalanchego/subnets"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/ips"
 )
 
 func TestGetChainConfigsFromFiles(t *testing.T) {
@@ -322,6 +326,94 @@ func TestGetVMAliasesFromFlag(t *testing.T) {
 	}
 }
 
+func TestGetPinnedPeers(t *testing.T) {
+	nodeID1, err := ids.NodeIDFromString("NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET")
+	require.NoError(t, err)
+	nodeID2, err := ids.NodeIDFromString("NodeID-8CrVPQZ4VSqgL8zTdvL14G8HqAfrBr4z")
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		staticPeers  []string
+		trustedPeers []string
+		expected     []network.PinnedPeer
+		expectedErr  error
+	}{
+		"no peers": {
+			expected: nil,
+		},
+		"static and trusted peers": {
+			staticPeers: []string{
+				"NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET@127.0.0.1:9651",
+				"NodeID-8CrVPQZ4VSqgL8zTdvL14G8HqAfrBr4z@127.0.0.1:9653",
+			},
+			trustedPeers: []string{
+				"NodeID-8CrVPQZ4VSqgL8zTdvL14G8HqAfrBr4z@127.0.0.1:9655",
+			},
+			expected: []network.PinnedPeer{
+				{
+					NodeID: nodeID1,
+					IP:     ips.IPPort{IP: net.IPv4(127, 0, 0, 1), Port: 9651},
+				},
+				{
+					NodeID:  nodeID2,
+					IP:      ips.IPPort{IP: net.IPv4(127, 0, 0, 1), Port: 9655},
+					Trusted: true,
+				},
+			},
+		},
+		"missing ip": {
+			staticPeers: []string{"NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET"},
+			expectedErr: errInvalidPinnedPeer,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(NetworkStaticPeersKey, test.staticPeers)
+			v.Set(NetworkTrustedPeersKey, test.trustedPeers)
+
+			pinnedPeers, err := getPinnedPeers(v)
+			require.ErrorIs(err, test.expectedErr)
+			require.Equal(test.expected, pinnedPeers)
+		})
+	}
+}
+
+func TestGetNetworkConfigAddressBookMaxAge(t *testing.T) {
+	tests := map[string]struct {
+		maxAge      time.Duration
+		expectedErr error
+	}{
+		"positive": {
+			maxAge: time.Hour,
+		},
+		"zero": {
+			maxAge:      0,
+			expectedErr: errInvalidAddressBookMaxAge,
+		},
+		"negative": {
+			maxAge:      -time.Hour,
+			expectedErr: errInvalidAddressBookMaxAge,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			require := require.New(t)
+
+			v := setupViperFlags()
+			v.Set(NetworkAddressBookMaxAgeKey, test.maxAge)
+
+			config, err := getNetworkConfig(v, constants.LocalID, true, time.Minute)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr == nil {
+				require.Equal(test.maxAge, config.AddressBookMaxAge)
+			}
+		})
+	}
+}
+
 func TestGetVMAliasesDefaultDir(t *testing.T) {
 	require := require.New(t)
 	root := t.TempDir()
@@ -178,6 +178,16 @@ func addNodeFlags(fs *pflag.FlagSet) {
 
 	fs.String(NetworkTLSKeyLogFileKey, "", "TLS key log file path. Should only be specified for debugging")
 
+	fs.StringSlice(NetworkStaticPeersKey, nil, "Comma separated list of peers, in the form NodeID@IP:port, that this node always attempts to stay connected to. Example: NodeID-JR4dVmy6ffUGAKCBDkyCbeZbyHQBeDsET@127.0.0.1:9651")
+	fs.StringSlice(NetworkTrustedPeersKey, nil, fmt.Sprintf("Comma separated list of peers, in the form NodeID@IP:port, that are treated like --%s and are exempt from inbound connection throttling", NetworkStaticPeersKey))
+	fs.Bool(NetworkPrivateNodeKey, false, fmt.Sprintf("If true, this node only connects to the peers given by --%s and --%s, which act as its sentries, and never reveals its IP to other peers. The sentries are also used as the bootstrappers, so --%s and --%s must not be set. This mode is only for nodes that aren't validators. Sentries don't relay consensus messages, so the node shuts down if it becomes a primary network validator", NetworkStaticPeersKey, NetworkTrustedPeersKey, BootstrapIDsKey, BootstrapIPsKey))
+	fs.Duration(NetworkAddressBookMaxAgeKey, constants.DefaultNetworkAddressBookMaxAge, "Amount of time a persisted validator IP is kept after it was last known to be the validator's most recent IP. Persisted IPs are dialed when the node restarts. Must be > 0")
+	fs.Duration(NetworkAddressBookSyncFreqKey, constants.DefaultNetworkAddressBookSyncFreq, "Frequency to persist the known validator IPs to the database")
+	fs.Float64(NetworkPeerBanThresholdKey, constants.DefaultNetworkPeerBanThreshold, "Misbehavior score at which a peer is disconnected and banned. Parse failures, invalid messages, handler errors, unrequested app responses, benchings and throttle violations all add to a peer's score. Validators and pinned peers are never banned. If 0, peers are never banned")
+	fs.Duration(NetworkPeerScoreHalflifeKey, constants.DefaultNetworkPeerScoreHalflife, "Halflife of a peer's misbehavior score")
+	fs.Duration(NetworkPeerBanDurationKey, constants.DefaultNetworkPeerBanDuration, "Amount of time the node ID and IP of a banned peer are refused")
+	fs.Int(NetworkBandwidthMetricsNumPeersKey, constants.DefaultNetworkBandwidthMetricsNumPeers, "Number of peers that consumed the most inbound bandwidth, and the most outbound bandwidth, to report per-peer bandwidth metrics for")
+
 	// Benchlist
 	fs.Int(BenchlistFailThresholdKey, constants.DefaultBenchlistFailThreshold, "Number of consecutive failed queries before benchlisting a node")
 	fs.Duration(BenchlistDurationKey, constants.DefaultBenchlistDuration, "Max amount of time a peer is benchlisted after surpassing the threshold")
@@ -225,6 +235,8 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	fs.Duration(HTTPReadHeaderTimeoutKey, 30*time.Second, fmt.Sprintf("Maximum duration to read request headers. The connection's read deadline is reset after reading the headers. If %s is zero, the value of %s is used. If both are zero, there is no timeout.", HTTPReadHeaderTimeoutKey, HTTPReadTimeoutKey))
 	fs.Duration(HTTPWriteTimeoutKey, 30*time.Second, "Maximum duration before timing out writes of the response. It is reset whenever a new request's header is read. A zero or negative value means there will be no timeout.")
 	fs.Duration(HTTPIdleTimeoutKey, 120*time.Second, fmt.Sprintf("Maximum duration to wait for the next request when keep-alives are enabled. If %s is zero, the value of %s is used. If both are zero, there is no timeout.", HTTPIdleTimeoutKey, HTTPReadTimeoutKey))
+	fs.Uint(HTTPMaxBatchSizeKey, 1000, "Maximum number of calls in a JSON-RPC batch request to a built-in API, such as the X-chain or P-chain API. Batch requests with more calls are rejected. The C-chain enforces its own batch limits")
+	fs.Int64(HTTPMaxRequestBodySizeKey, 16*units.MiB, "Maximum size, in bytes, of the body of a request to a built-in API, such as the X-chain or P-chain API. Requests with larger bodies are rejected. Must be > 0")
 
 	// Enable/Disable APIs
 	fs.Bool(AdminAPIEnabledKey, false, "If true, this node exposes the Admin API")
@@ -321,6 +333,7 @@ func addNodeFlags(fs *pflag.FlagSet) {
 	// Indexer
 	fs.Bool(IndexEnabledKey, false, "If true, index all accepted containers and transactions and expose them via an API")
 	fs.Bool(IndexAllowIncompleteKey, false, "If true, allow running the node in such a way that could cause an index to miss transactions. Ignored if index is disabled")
+	fs.Bool(IndexBackfillKey, false, "If true, populate the index of each linear chain with the blocks that were accepted before the chain was indexed. The index is populated in the background. Ignored if index is disabled")
 
 	// Config Directories
 	fs.String(ChainConfigDirKey, defaultChainConfigDir, fmt.Sprintf("Chain specific configurations parent directory. Ignored if %s is specified", ChainConfigContentKey))
@@ -58,6 +58,8 @@ const (
 	HTTPReadHeaderTimeoutKey                           = "http-read-header-timeout"
 	HTTPWriteTimeoutKey                                = "http-write-timeout"
 	HTTPIdleTimeoutKey                                 = "http-idle-timeout"
+	HTTPMaxBatchSizeKey                                = "http-max-batch-size"
+	HTTPMaxRequestBodySizeKey                          = "http-max-request-body-size"
 	StateSyncIPsKey                                    = "state-sync-ips"
 	StateSyncIDsKey                                    = "state-sync-ids"
 	BootstrapIPsKey                                    = "bootstrap-ips"
@@ -107,6 +109,15 @@ const (
 	NetworkTCPProxyEnabledKey                          = "network-tcp-proxy-enabled"
 	NetworkTCPProxyReadTimeoutKey                      = "network-tcp-proxy-read-timeout"
 	NetworkTLSKeyLogFileKey                            = "network-tls-key-log-file-unsafe"
+	NetworkStaticPeersKey                              = "network-static-peers"
+	NetworkTrustedPeersKey                             = "network-trusted-peers"
+	NetworkPrivateNodeKey                              = "network-private-node"
+	NetworkAddressBookMaxAgeKey                        = "network-address-book-max-age"
+	NetworkAddressBookSyncFreqKey                      = "network-address-book-sync-frequency"
+	NetworkPeerBanThresholdKey                         = "network-peer-ban-threshold"
+	NetworkPeerScoreHalflifeKey                        = "network-peer-score-halflife"
+	NetworkPeerBanDurationKey                          = "network-peer-ban-duration"
+	NetworkBandwidthMetricsNumPeersKey                 = "network-bandwidth-metrics-num-peers"
 	NetworkInboundConnUpgradeThrottlerCooldownKey      = "network-inbound-connection-throttling-cooldown"
 	NetworkInboundThrottlerMaxConnsPerSecKey           = "network-inbound-connection-throttling-max-conns-per-sec"
 	NetworkOutboundConnectionThrottlingRpsKey          = "network-outbound-connection-throttling-rps"
@@ -155,6 +166,7 @@ const (
 	FdLimitKey                                         = "fd-limit"
 	IndexEnabledKey                                    = "index-enabled"
 	IndexAllowIncompleteKey                            = "index-allow-incomplete"
+	IndexBackfillKey                                   = "index-backfill"
 	RouterHealthMaxDropRateKey                         = "router-health-max-drop-rate"
 	RouterHealthMaxOutstandingRequestsKey              = "router-health-max-outstanding-requests"
 	HealthCheckFreqKey                                 = "health-check-frequency"
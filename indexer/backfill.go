@@ -0,0 +1,89 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+// backfillStartHeight returns the height of the first block that [vm]
+// accepted but that isn't in [index].
+func backfillStartHeight(ctx context.Context, vm block.ChainVM, index *index) (uint64, error) {
+	if err := vm.VerifyHeightIndex(ctx); err != nil {
+		return 0, fmt.Errorf("couldn't verify height index: %w", err)
+	}
+
+	lastIndexed, err := index.GetLastAccepted()
+	switch {
+	case err == nil:
+		blk, err := vm.GetBlock(ctx, lastIndexed.ID)
+		if err != nil {
+			return 0, fmt.Errorf("couldn't get last indexed block %s: %w", lastIndexed.ID, err)
+		}
+		return blk.Height() + 1, nil
+	case errors.Is(err, errNoneAccepted):
+		// The genesis block is never passed to the acceptor, so it isn't
+		// indexed.
+		return 1, nil
+	default:
+		return 0, fmt.Errorf("couldn't get last indexed block: %w", err)
+	}
+}
+
+// backfillRange adds at most [maxBlocks] blocks that [vm] accepted, starting
+// at [startHeight], to [index]. Returns the height to continue from and true
+// if every block up to the last accepted block was indexed.
+//
+// Assumes the chain's ctx.Lock is held.
+func backfillRange(
+	ctx context.Context,
+	log logging.Logger,
+	vm block.ChainVM,
+	index *index,
+	startHeight uint64,
+	maxBlocks uint64,
+) (uint64, bool, error) {
+	lastAcceptedID, err := vm.LastAccepted(ctx)
+	if err != nil {
+		return 0, false, fmt.Errorf("couldn't get last accepted block: %w", err)
+	}
+	lastAccepted, err := vm.GetBlock(ctx, lastAcceptedID)
+	if err != nil {
+		return 0, false, fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+
+	lastAcceptedHeight := lastAccepted.Height()
+	if startHeight > lastAcceptedHeight || maxBlocks == 0 {
+		return startHeight, startHeight > lastAcceptedHeight, nil
+	}
+	endHeight := lastAcceptedHeight
+	if maxBlocks <= endHeight-startHeight {
+		endHeight = startHeight + maxBlocks - 1
+	}
+
+	for height := startHeight; height <= endHeight; height++ {
+		blkID, err := vm.GetBlockIDAtHeight(ctx, height)
+		if errors.Is(err, database.ErrNotFound) {
+			// Blocks below a state summary aren't stored by the VM.
+			continue
+		}
+		if err != nil {
+			return 0, false, fmt.Errorf("couldn't get block ID at height %d: %w", height, err)
+		}
+		blk, err := vm.GetBlock(ctx, blkID)
+		if err != nil {
+			return 0, false, fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+		if err := index.acceptAt(log, blkID, blk.Bytes(), blk.Timestamp()); err != nil {
+			return 0, false, err
+		}
+	}
+	return endHeight + 1, endHeight == lastAcceptedHeight, nil
+}
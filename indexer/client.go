@@ -6,6 +6,7 @@ package indexer
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -32,6 +33,17 @@ type Client interface {
 	IsAccepted(ctx context.Context, containerID ids.ID, options ...rpc.Option) (bool, error)
 	// Get a container and its index by its ID
 	GetContainerByID(ctx context.Context, containerID ids.ID, options ...rpc.Option) (Container, uint64, error)
+	// GetDecodedContainerRange is GetContainerRange, but also returns the txs
+	// in each container as decoded by the VM's parser. If [txTypes] is
+	// non-empty, only containers with txs of those types are returned, along
+	// with only those txs.
+	GetDecodedContainerRange(ctx context.Context, startIndex uint64, numToFetch int, txTypes []string, options ...rpc.Option) ([]DecodedContainer, error)
+	// Returns the indices of the first and last containers accepted in
+	// [startTime, endTime]
+	GetIndexRangeByTime(ctx context.Context, startTime, endTime time.Time, options ...rpc.Option) (uint64, uint64, error)
+	// Returns the indices of the first and last blocks with heights in
+	// [startHeight, endHeight]
+	GetIndexRangeByHeight(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (uint64, uint64, error)
 }
 
 // Client implementation for Avalanche Indexer API Endpoint
@@ -154,3 +166,56 @@ func (c *client) GetContainerByID(ctx context.Context, id ids.ID, options ...rpc
 		Bytes:     containerBytes,
 	}, uint64(fc.Index), nil
 }
+
+func (c *client) GetDecodedContainerRange(ctx context.Context, startIndex uint64, numToFetch int, txTypes []string, options ...rpc.Option) ([]DecodedContainer, error) {
+	var fcs GetContainerRangeResponse
+	err := c.requester.SendRequest(ctx, "index.getContainerRange", &GetContainerRangeArgs{
+		StartIndex: json.Uint64(startIndex),
+		NumToFetch: json.Uint64(numToFetch),
+		Encoding:   formatting.Hex,
+		Decode:     true,
+		TxTypes:    txTypes,
+	}, &fcs, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	response := make([]DecodedContainer, len(fcs.Containers))
+	for i, resp := range fcs.Containers {
+		containerBytes, err := formatting.Decode(resp.Encoding, resp.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't decode container %s: %w", resp.ID, err)
+		}
+		response[i] = DecodedContainer{
+			Container: Container{
+				ID:        resp.ID,
+				Timestamp: resp.Timestamp.Unix(),
+				Bytes:     containerBytes,
+			},
+			Index: uint64(resp.Index),
+			Txs:   resp.Txs,
+		}
+		if resp.Height != nil {
+			response[i].Height = uint64(*resp.Height)
+		}
+	}
+	return response, nil
+}
+
+func (c *client) GetIndexRangeByTime(ctx context.Context, startTime, endTime time.Time, options ...rpc.Option) (uint64, uint64, error) {
+	var res GetIndexRangeResponse
+	err := c.requester.SendRequest(ctx, "index.getIndexRangeByTime", &GetIndexRangeByTimeArgs{
+		StartTime: startTime,
+		EndTime:   endTime,
+	}, &res, options...)
+	return uint64(res.StartIndex), uint64(res.EndIndex), err
+}
+
+func (c *client) GetIndexRangeByHeight(ctx context.Context, startHeight, endHeight uint64, options ...rpc.Option) (uint64, uint64, error) {
+	var res GetIndexRangeResponse
+	err := c.requester.SendRequest(ctx, "index.getIndexRangeByHeight", &GetIndexRangeByHeightArgs{
+		StartHeight: json.Uint64(startHeight),
+		EndHeight:   json.Uint64(endHeight),
+	}, &res, options...)
+	return uint64(res.StartIndex), uint64(res.EndIndex), err
+}
@@ -6,6 +6,7 @@ package indexer
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -129,4 +130,56 @@ func TestIndexClient(t *testing.T) {
 		require.Equal(bytes, container.Bytes)
 		require.Equal(uint64(10), index)
 	}
+	{
+		// Test GetDecodedContainerRange
+		id := ids.GenerateTestID()
+		bytes := utils.RandomBytes(10)
+		bytesStr, err := formatting.Encode(formatting.Hex, bytes)
+		require.NoError(err)
+		height := json.Uint64(3)
+		txs := []DecodedTx{{
+			ID:   ids.GenerateTestID(),
+			Type: "ImportTx",
+		}}
+		client.requester = &mockClient{
+			require:        require,
+			expectedMethod: "index.getContainerRange",
+			onSendRequestF: func(reply interface{}) error {
+				*(reply.(*GetContainerRangeResponse)) = GetContainerRangeResponse{Containers: []FormattedContainer{{
+					ID:     id,
+					Bytes:  bytesStr,
+					Index:  json.Uint64(2),
+					Height: &height,
+					Txs:    txs,
+				}}}
+				return nil
+			},
+		}
+		containers, err := client.GetDecodedContainerRange(context.Background(), 1, 10, []string{"ImportTx"})
+		require.NoError(err)
+		require.Len(containers, 1)
+		require.Equal(id, containers[0].ID)
+		require.Equal(bytes, containers[0].Bytes)
+		require.Equal(uint64(2), containers[0].Index)
+		require.Equal(uint64(3), containers[0].Height)
+		require.Equal(txs, containers[0].Txs)
+	}
+	{
+		// Test GetIndexRangeByTime
+		client.requester = &mockClient{
+			require:        require,
+			expectedMethod: "index.getIndexRangeByTime",
+			onSendRequestF: func(reply interface{}) error {
+				*(reply.(*GetIndexRangeResponse)) = GetIndexRangeResponse{
+					StartIndex: 4,
+					EndIndex:   7,
+				}
+				return nil
+			},
+		}
+		startIndex, endIndex, err := client.GetIndexRangeByTime(context.Background(), time.Unix(1, 0), time.Unix(2, 0))
+		require.NoError(err)
+		require.Equal(uint64(4), startIndex)
+		require.Equal(uint64(7), endIndex)
+	}
 }
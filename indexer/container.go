@@ -12,6 +12,19 @@ type Container struct {
 	ID ids.ID `serialize:"true"`
 	// Byte representation of this container
 	Bytes []byte `serialize:"true"`
-	// Unix time, in nanoseconds, at which this container was accepted by this node
+	// Unix time, in nanoseconds, at which this container was accepted by this
+	// node. Blocks that were added to the index by a backfill, rather than as
+	// they were accepted, use the block's timestamp instead.
 	Timestamp int64 `serialize:"true"`
 }
+
+// DecodedContainer is a container along with the txs that were decoded from it
+type DecodedContainer struct {
+	Container
+	// Index of this container
+	Index uint64
+	// Height of this container, if it is a block
+	Height uint64
+	// Txs in this container, filtered by the requested tx types
+	Txs []DecodedTx
+}
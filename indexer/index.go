@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -55,6 +56,10 @@ type index struct {
 	// Container ID --> Index
 	containerToIndex database.Database
 	log              logging.Logger
+	// If true, Accept doesn't index containers because they will be added by
+	// an ongoing backfill. Modifying this requires holding the chain's
+	// ctx.Lock, which is also held when Accept is called.
+	backfilling bool
 }
 
 // Create a new thread-safe index.
@@ -111,6 +116,21 @@ func (i *index) Close() error {
 // Returned error should be treated as fatal; the VM should not commit [containerID]
 // or any new containers as accepted.
 func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, containerBytes []byte) error {
+	i.lock.RLock()
+	backfilling := i.backfilling
+	i.lock.RUnlock()
+	if backfilling {
+		ctx.Log.Debug("not indexing container during backfill",
+			zap.Stringer("containerID", containerID),
+		)
+		return nil
+	}
+	return i.acceptAt(ctx.Log, containerID, containerBytes, i.clock.Time())
+}
+
+// acceptAt indexes [containerID] as the next accepted container, recording
+// [timestamp] as the time it was accepted.
+func (i *index) acceptAt(log logging.Logger, containerID ids.ID, containerBytes []byte, timestamp time.Time) error {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -120,7 +140,7 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	// Make sure we don't index the same container twice in that event.
 	_, err := i.containerToIndex.Get(containerID[:])
 	if err == nil {
-		ctx.Log.Debug("not indexing already accepted container",
+		log.Debug("not indexing already accepted container",
 			zap.Stringer("containerID", containerID),
 		)
 		return nil
@@ -129,7 +149,7 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 		return fmt.Errorf("couldn't get whether %s is accepted: %w", containerID, err)
 	}
 
-	ctx.Log.Debug("indexing container",
+	log.Debug("indexing container",
 		zap.Uint64("nextAcceptedIndex", i.nextAcceptedIndex),
 		zap.Stringer("containerID", containerID),
 	)
@@ -138,7 +158,7 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	bytes, err := Codec.Marshal(CodecVersion, Container{
 		ID:        containerID,
 		Bytes:     containerBytes,
-		Timestamp: i.clock.Time().UnixNano(),
+		Timestamp: timestamp.UnixNano(),
 	})
 	if err != nil {
 		return fmt.Errorf("couldn't serialize container %s: %w", containerID, err)
@@ -162,6 +182,30 @@ func (i *index) Accept(ctx *snow.ConsensusContext, containerID ids.ID, container
 	return i.vDB.Commit()
 }
 
+// Remove every container from the index
+func (i *index) clear() error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if err := database.AtomicClear(i.baseDB, i.vDB); err != nil {
+		i.vDB.Abort()
+		return fmt.Errorf("couldn't clear index: %w", err)
+	}
+	i.nextAcceptedIndex = 0
+	return i.vDB.Commit()
+}
+
+// setBackfilling sets whether containers passed to Accept are ignored because
+// they will be added by a backfill.
+//
+// Assumes the chain's ctx.Lock is held.
+func (i *index) setBackfilling(backfilling bool) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.backfilling = backfilling
+}
+
 // Returns the ID of the [index]th accepted container and the container itself.
 // For example, if [index] == 0, returns the first accepted container.
 // If [index] == 1, returns the second accepted container, etc.
@@ -271,6 +315,35 @@ func (i *index) GetLastAccepted() (Container, error) {
 	return i.getContainerByIndex(lastAcceptedIndex)
 }
 
+// Search returns the first index in [0, n) at which [f] returns true, where n
+// is the number of accepted containers. If [f] doesn't return true for any
+// container, n is returned. [f] must return false for a prefix of the
+// containers and true for the rest. For example, because containers are indexed
+// in order of acceptance, [f] may compare the timestamp of the container.
+func (i *index) Search(f func(Container) (bool, error)) (uint64, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	low, high := uint64(0), i.nextAcceptedIndex
+	for low < high {
+		mid := low + (high-low)/2
+		container, err := i.getContainerByIndex(mid)
+		if err != nil {
+			return 0, err
+		}
+		found, err := f(container)
+		if err != nil {
+			return 0, err
+		}
+		if found {
+			high = mid
+		} else {
+			low = mid + 1
+		}
+	}
+	return low, nil
+}
+
 // Assumes i.lock is held
 // Returns:
 //
@@ -4,6 +4,8 @@
 package indexer
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"sync"
@@ -23,8 +25,12 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/version"
+
+	vmindex "github.com/ava-labs/avalanchego/vms/components/index"
 )
 
 const (
@@ -34,12 +40,20 @@ const (
 	blockPrefix             = 0x03
 	isIncompletePrefix      = 0x04
 	previouslyIndexedPrefix = 0x05
+
+	// Maximum number of blocks that are indexed at a time while the chain's
+	// ctx.Lock is held when an index is rebuilt
+	reindexBatchSize = 1024
 )
 
 var (
 	_ Indexer = (*indexer)(nil)
 
 	hasRunKey = []byte{0x07}
+
+	errClosed            = errors.New("indexer is closed")
+	errCantReindex       = errors.New("chain isn't indexed or its index can't be rebuilt")
+	errAlreadyReindexing = errors.New("index is already being rebuilt")
 )
 
 // Config for an indexer
@@ -48,11 +62,16 @@ type Config struct {
 	Log                  logging.Logger
 	IndexingEnabled      bool
 	AllowIncompleteIndex bool
+	Backfill             bool
 	BlockAcceptorGroup   snow.AcceptorGroup
 	TxAcceptorGroup      snow.AcceptorGroup
 	VertexAcceptorGroup  snow.AcceptorGroup
 	APIServer            server.PathAdder
 	ShutdownF            func()
+	// VM ID --> creates the parser of the containers accepted by chains
+	// running that VM. The containers of chains whose VM isn't in this map
+	// can't be decoded.
+	ContainerParsers map[ids.ID]vmindex.ContainerParserFactory
 }
 
 // Indexer causes accepted containers for a given chain
@@ -61,25 +80,38 @@ type Config struct {
 // Indexer is threadsafe.
 type Indexer interface {
 	chains.Registrant
+	// Reindex clears the block index of [chainID] and starts rebuilding it
+	// from the blocks accepted by the chain's VM. The index is rebuilt in the
+	// background, in batches, so the chain keeps accepting blocks. Only linear
+	// chains can be reindexed. The vertex and tx indices of DAG chains can't
+	// be rebuilt because their VMs don't store accepted vertices in order.
+	Reindex(chainID ids.ID) error
 	// Close will do nothing and return nil after the first call
 	io.Closer
 }
 
 // NewIndexer returns a new Indexer and registers a new endpoint on the given API server.
 func NewIndexer(config Config) (Indexer, error) {
+	ctx, cancel := context.WithCancel(context.Background())
 	indexer := &indexer{
+		ctx:                  ctx,
+		cancel:               cancel,
 		log:                  config.Log,
 		db:                   config.DB,
 		allowIncompleteIndex: config.AllowIncompleteIndex,
 		indexingEnabled:      config.IndexingEnabled,
+		backfill:             config.Backfill,
 		blockAcceptorGroup:   config.BlockAcceptorGroup,
 		txAcceptorGroup:      config.TxAcceptorGroup,
 		vertexAcceptorGroup:  config.VertexAcceptorGroup,
 		txIndices:            map[ids.ID]*index{},
 		vtxIndices:           map[ids.ID]*index{},
 		blockIndices:         map[ids.ID]*index{},
+		linearChains:         map[ids.ID]linearChain{},
+		reindexBatchSize:     reindexBatchSize,
 		pathAdder:            config.APIServer,
 		shutdownF:            config.ShutdownF,
+		containerParsers:     config.ContainerParsers,
 	}
 
 	hasRun, err := indexer.hasRun()
@@ -97,6 +129,11 @@ type indexer struct {
 	db     database.Database
 	closed bool
 
+	// Passed to the VMs of chains whose block index is being populated.
+	// Cancelled when the indexer is closed.
+	ctx    context.Context
+	cancel context.CancelFunc
+
 	// Called in a goroutine on shutdown
 	shutdownF func()
 
@@ -106,6 +143,10 @@ type indexer struct {
 	// Used to add API endpoint for new indices
 	pathAdder server.PathAdder
 
+	// VM ID --> creates the parser of the containers of chains running that
+	// VM
+	containerParsers map[ids.ID]vmindex.ContainerParserFactory
+
 	// If true, allow running in such a way that could allow the creation
 	// of an index which could be missing accepted containers.
 	allowIncompleteIndex bool
@@ -113,12 +154,23 @@ type indexer struct {
 	// If false, don't create index for a chain when RegisterChain is called
 	indexingEnabled bool
 
+	// If true, populate the block index of linear chains with the blocks that
+	// were accepted before the chain was indexed
+	backfill bool
+
 	// Chain ID --> index of blocks of that chain (if applicable)
 	blockIndices map[ids.ID]*index
 	// Chain ID --> index of vertices of that chain (if applicable)
 	vtxIndices map[ids.ID]*index
 	// Chain ID --> index of txs of that chain (if applicable)
 	txIndices map[ids.ID]*index
+	// Chain ID --> linear chain whose block index can be rebuilt from its VM
+	linearChains map[ids.ID]linearChain
+	// Chains whose block index is being backfilled or rebuilt
+	reindexing set.Set[ids.ID]
+	// Maximum number of blocks that are indexed at a time when backfilling or
+	// rebuilding an index
+	reindexBatchSize uint64
 
 	// Notifies of newly accepted blocks
 	blockAcceptorGroup snow.AcceptorGroup
@@ -128,6 +180,12 @@ type indexer struct {
 	vertexAcceptorGroup snow.AcceptorGroup
 }
 
+// linearChain is a chain whose accepted blocks can be fetched by height
+type linearChain struct {
+	ctx *snow.ConsensusContext
+	vm  block.ChainVM
+}
+
 // Assumes [ctx.Lock] is not held
 func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm common.VM) {
 	i.lock.Lock()
@@ -217,7 +275,14 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 		return
 	}
 
-	if !i.allowIncompleteIndex && isIncomplete && (previouslyIndexed || i.hasRunBefore) {
+	// The blocks of linear chains can be fetched from the VM, so an incomplete
+	// block index can be rebuilt. The vertices and txs of DAG chains can't be.
+	_, isDAG := vm.(vertex.DAGVM)
+	chainVM, isChainVM := vm.(block.ChainVM)
+	isLinear := isChainVM && !isDAG
+	backfillChain := i.backfill && isLinear
+
+	if !i.allowIncompleteIndex && isIncomplete && (previouslyIndexed || i.hasRunBefore) && !backfillChain {
 		i.log.Fatal("index is incomplete but incomplete indices are disabled. Shutting down",
 			zap.String("chainName", chainName),
 		)
@@ -243,7 +308,39 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 		return
 	}
 
-	index, err := i.registerChainHelper(chainID, blockPrefix, chainName, "block", i.blockAcceptorGroup)
+	var (
+		chain = linearChain{
+			ctx: ctx,
+			vm:  chainVM,
+		}
+		backfillHeight uint64
+		populate       func(*index) error
+	)
+	if backfillChain {
+		populate = func(index *index) error {
+			// An incomplete index may be missing any of its containers, so it
+			// is rebuilt from scratch.
+			var err error
+			backfillHeight, err = i.startBackfill(chainID, chain, index, isIncomplete)
+			return err
+		}
+	}
+
+	// The containers of the chain can only be decoded if its VM provides a
+	// parser.
+	var vmParser vmindex.ContainerParser
+	if newContainerParser, ok := i.containerParsers[ctx.VMID]; ok {
+		vmParser, err = newContainerParser(ctx.Context)
+		if err != nil {
+			i.log.Error("couldn't create container parser. Containers won't be decoded",
+				zap.String("chainName", chainName),
+				zap.Error(err),
+			)
+			vmParser = nil
+		}
+	}
+
+	index, err := i.registerChainHelper(ctx, blockPrefix, chainName, "block", i.blockAcceptorGroup, vmParser, populate)
 	if err != nil {
 		i.log.Fatal("failed to create index",
 			zap.String("chainName", chainName),
@@ -258,10 +355,24 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 		return
 	}
 	i.blockIndices[chainID] = index
+	if isLinear {
+		i.linearChains[chainID] = chain
+	}
+
+	// The blocks accepted before the chain was indexed are added in the
+	// background so that registering the chain doesn't block on it.
+	if backfillChain {
+		i.log.Info("backfilling index",
+			zap.String("chainName", chainName),
+			zap.Uint64("startHeight", backfillHeight),
+		)
+		i.reindexing.Add(chainID)
+		go i.reindex(chainID, chain, index, backfillHeight)
+	}
 
 	switch vm.(type) {
 	case vertex.DAGVM:
-		vtxIndex, err := i.registerChainHelper(chainID, vtxPrefix, chainName, "vtx", i.vertexAcceptorGroup)
+		vtxIndex, err := i.registerChainHelper(ctx, vtxPrefix, chainName, "vtx", i.vertexAcceptorGroup, vmParser, nil)
 		if err != nil {
 			i.log.Fatal("couldn't create index",
 				zap.String("chainName", chainName),
@@ -277,7 +388,7 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 		}
 		i.vtxIndices[chainID] = vtxIndex
 
-		txIndex, err := i.registerChainHelper(chainID, txPrefix, chainName, "tx", i.txAcceptorGroup)
+		txIndex, err := i.registerChainHelper(ctx, txPrefix, chainName, "tx", i.txAcceptorGroup, vmParser, nil)
 		if err != nil {
 			i.log.Fatal("couldn't create index",
 				zap.String("chainName", chainName),
@@ -306,12 +417,19 @@ func (i *indexer) RegisterChain(chainName string, ctx *snow.ConsensusContext, vm
 	}
 }
 
+// registerChainHelper creates an index and registers it to learn about
+// accepted containers. The containers are decoded with [vmParser], if it isn't
+// nil. If [populate] isn't nil, it is called with the index before the index
+// is registered.
 func (i *indexer) registerChainHelper(
-	chainID ids.ID,
+	ctx *snow.ConsensusContext,
 	prefixEnd byte,
 	name, endpoint string,
 	acceptorGroup snow.AcceptorGroup,
+	vmParser vmindex.ContainerParser,
+	populate func(*index) error,
 ) (*index, error) {
+	chainID := ctx.ChainID
 	prefix := make([]byte, ids.IDLen+wrappers.ByteLen)
 	copy(prefix, chainID[:])
 	prefix[ids.IDLen] = prefixEnd
@@ -322,6 +440,13 @@ func (i *indexer) registerChainHelper(
 		return nil, err
 	}
 
+	if populate != nil {
+		if err := populate(index); err != nil {
+			_ = index.Close()
+			return nil, err
+		}
+	}
+
 	// Register index to learn about new accepted vertices
 	if err := acceptorGroup.RegisterAcceptor(chainID, fmt.Sprintf("%s%s", indexNamePrefix, chainID), index, true); err != nil {
 		_ = index.Close()
@@ -333,7 +458,11 @@ func (i *indexer) registerChainHelper(
 	codec := json.NewCodec()
 	apiServer.RegisterCodec(codec, "application/json")
 	apiServer.RegisterCodec(codec, "application/json;charset=UTF-8")
-	if err := apiServer.RegisterService(&service{index: index}, "index"); err != nil {
+	indexService := &service{
+		index:  index,
+		parser: newParser(vmParser, version.GetDurangoTime(ctx.NetworkID), prefixEnd),
+	}
+	if err := apiServer.RegisterService(indexService, "index"); err != nil {
 		_ = index.Close()
 		return nil, err
 	}
@@ -344,6 +473,121 @@ func (i *indexer) registerChainHelper(
 	return index, nil
 }
 
+func (i *indexer) Reindex(chainID ids.ID) error {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	if i.closed {
+		return errClosed
+	}
+	chain, ok := i.linearChains[chainID]
+	if !ok {
+		return fmt.Errorf("%w: %s", errCantReindex, chainID)
+	}
+	if i.reindexing.Contains(chainID) {
+		return fmt.Errorf("%w: %s", errAlreadyReindexing, chainID)
+	}
+	index := i.blockIndices[chainID]
+
+	startHeight, err := i.startBackfill(chainID, chain, index, true /*=clear*/)
+	if err != nil {
+		return err
+	}
+
+	i.log.Info("rebuilding index",
+		zap.Stringer("chainID", chainID),
+		zap.Uint64("startHeight", startHeight),
+	)
+	i.reindexing.Add(chainID)
+	go i.reindex(chainID, chain, index, startHeight)
+	return nil
+}
+
+// startBackfill stops [index] from indexing accepted blocks until the blocks
+// that the chain accepted before them have been added to it. If [clear] is
+// true, [index] is cleared first. Returns the height to start backfilling
+// from.
+//
+// Assumes [i.lock] is held.
+func (i *indexer) startBackfill(chainID ids.ID, chain linearChain, index *index, clear bool) (uint64, error) {
+	// Prevent blocks from being accepted while the index is modified
+	chain.ctx.Lock.Lock()
+	defer chain.ctx.Lock.Unlock()
+
+	// If the index can't be backfilled, it is left incomplete.
+	if err := i.markIncomplete(chainID); err != nil {
+		return 0, err
+	}
+	if clear {
+		if err := index.clear(); err != nil {
+			return 0, err
+		}
+	}
+	index.setBackfilling(true)
+	return backfillStartHeight(i.ctx, chain.vm, index)
+}
+
+// reindex backfills [index] from [startHeight] in batches, releasing the locks
+// between batches so that the chain can accept blocks and the index can be
+// queried while it is populated. Blocks accepted in the meantime are added by
+// a later batch. Stops when the indexer is closed.
+func (i *indexer) reindex(chainID ids.ID, chain linearChain, index *index, startHeight uint64) {
+	defer func() {
+		i.lock.Lock()
+		defer i.lock.Unlock()
+
+		i.reindexing.Remove(chainID)
+	}()
+
+	nextHeight := startHeight
+	for {
+		var (
+			done bool
+			err  error
+		)
+		nextHeight, done, err = i.reindexBatch(chainID, chain, index, nextHeight)
+		if err != nil {
+			i.log.Error("failed to backfill index. The index is incomplete until it is rebuilt",
+				zap.Stringer("chainID", chainID),
+				zap.Error(err),
+			)
+			return
+		}
+		if done {
+			i.log.Info("finished backfilling index",
+				zap.Stringer("chainID", chainID),
+				zap.Uint64("nextHeight", nextHeight),
+			)
+			return
+		}
+	}
+}
+
+// reindexBatch adds the next batch of blocks, starting at [startHeight], to
+// [index]. If every accepted block has been added, [index] resumes indexing
+// accepted blocks and is marked as complete.
+func (i *indexer) reindexBatch(chainID ids.ID, chain linearChain, index *index, startHeight uint64) (uint64, bool, error) {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	if i.closed {
+		return 0, false, errClosed
+	}
+
+	chain.ctx.Lock.Lock()
+	defer chain.ctx.Lock.Unlock()
+
+	nextHeight, done, err := backfillRange(i.ctx, i.log, chain.vm, index, startHeight, i.reindexBatchSize)
+	if err != nil || !done {
+		return nextHeight, done, err
+	}
+
+	// No blocks can be accepted before the lock is released, so the next
+	// accepted block is indexed by Accept.
+	index.setBackfilling(false)
+	return nextHeight, true, i.markComplete(chainID)
+}
+
 // Close this indexer. Stops indexing all chains.
 // Closes [i.db]. Assumes Close is only called after
 // the node is done making decisions.
@@ -360,6 +604,7 @@ func (i *indexer) close() error {
 		return nil
 	}
 	i.closed = true
+	i.cancel()
 
 	errs := &wrappers.Errs{}
 	for chainID, txIndex := range i.txIndices {
@@ -393,6 +638,13 @@ func (i *indexer) markIncomplete(chainID ids.ID) error {
 	return i.db.Put(key, nil)
 }
 
+func (i *indexer) markComplete(chainID ids.ID) error {
+	key := make([]byte, ids.IDLen+wrappers.ByteLen)
+	copy(key, chainID[:])
+	key[ids.IDLen] = isIncompletePrefix
+	return i.db.Delete(key)
+}
+
 // Returns true if this chain is incomplete
 func (i *indexer) isIncomplete(chainID ids.ID) (bool, error) {
 	key := make([]byte, ids.IDLen+wrappers.ByteLen)
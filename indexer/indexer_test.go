@@ -4,6 +4,7 @@
 package indexer
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"sync"
@@ -18,6 +19,8 @@ import (
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/avalanche/vertex"
 	"github.com/ava-labs/avalanchego/snow/engine/snowman/block"
 	"github.com/ava-labs/avalanchego/snow/snowtest"
@@ -474,6 +477,165 @@ func TestIncompleteIndex(t *testing.T) {
 	require.IsType(&indexer{}, idxrIntf)
 }
 
+// Test that blocks accepted before a chain was indexed are backfilled and that
+// the index of a chain can be rebuilt
+func TestBackfillIndex(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	// Blocks accepted by the VM, by height
+	blks := make([]*snowman.TestBlock, 4)
+	for height := range blks {
+		blks[height] = &snowman.TestBlock{
+			TestDecidable: choices.TestDecidable{
+				IDV:     ids.GenerateTestID(),
+				StatusV: choices.Accepted,
+			},
+			HeightV:    uint64(height),
+			TimestampV: time.Unix(int64(height), 0),
+			BytesV:     utils.RandomBytes(32),
+		}
+	}
+	lastAccepted := 1
+
+	chainVM := block.NewMockChainVM(ctrl)
+	chainVM.EXPECT().VerifyHeightIndex(gomock.Any()).Return(nil).AnyTimes()
+	chainVM.EXPECT().LastAccepted(gomock.Any()).DoAndReturn(
+		func(context.Context) (ids.ID, error) {
+			return blks[lastAccepted].ID(), nil
+		},
+	).AnyTimes()
+	chainVM.EXPECT().GetBlockIDAtHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, height uint64) (ids.ID, error) {
+			return blks[height].ID(), nil
+		},
+	).AnyTimes()
+	chainVM.EXPECT().GetBlock(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, blkID ids.ID) (snowman.Block, error) {
+			for _, blk := range blks {
+				if blk.ID() == blkID {
+					return blk, nil
+				}
+			}
+			return nil, errUnimplemented
+		},
+	).AnyTimes()
+
+	baseDB := memdb.New()
+	config := Config{
+		IndexingEnabled:      false,
+		AllowIncompleteIndex: false,
+		Backfill:             true,
+		Log:                  logging.NoLog{},
+		DB:                   versiondb.New(baseDB),
+		BlockAcceptorGroup:   snow.NewAcceptorGroup(logging.NoLog{}),
+		TxAcceptorGroup:      snow.NewAcceptorGroup(logging.NoLog{}),
+		VertexAcceptorGroup:  snow.NewAcceptorGroup(logging.NoLog{}),
+		APIServer:            &apiServerMock{},
+		ShutdownF:            func() {},
+	}
+	idxrIntf, err := NewIndexer(config)
+	require.NoError(err)
+	require.IsType(&indexer{}, idxrIntf)
+	idxr := idxrIntf.(*indexer)
+
+	// Register a chain with indexing disabled, making its index incomplete
+	snowCtx := snowtest.Context(t, snowtest.PChainID)
+	chainCtx := snowtest.ConsensusContext(snowCtx)
+	idxr.RegisterChain("P", chainCtx, chainVM)
+	isIncomplete, err := idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.True(isIncomplete)
+
+	// Close and re-open the indexer with indexing enabled. The index is
+	// rebuilt rather than causing the node to die.
+	require.NoError(config.DB.(*versiondb.Database).Commit())
+	require.NoError(idxr.Close())
+	config.IndexingEnabled = true
+	config.DB = versiondb.New(baseDB)
+	idxrIntf, err = NewIndexer(config)
+	require.NoError(err)
+	require.IsType(&indexer{}, idxrIntf)
+	idxr = idxrIntf.(*indexer)
+
+	idxr.RegisterChain("P", chainCtx, chainVM)
+	require.False(idxr.closed)
+
+	// The index is backfilled in the background
+	require.Eventually(func() bool {
+		idxr.lock.RLock()
+		defer idxr.lock.RUnlock()
+		return !idxr.reindexing.Contains(chainCtx.ChainID)
+	}, 5*time.Second, 10*time.Millisecond)
+	isIncomplete, err = idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.False(isIncomplete)
+
+	// The genesis block isn't indexed
+	blkIdx := idxr.blockIndices[chainCtx.ChainID]
+	require.NotNil(blkIdx)
+	containers, err := blkIdx.GetContainerRange(0, MaxFetchedByRange)
+	require.NoError(err)
+	require.Equal(
+		[]Container{
+			{
+				ID:        blks[1].ID(),
+				Bytes:     blks[1].Bytes(),
+				Timestamp: blks[1].Timestamp().UnixNano(),
+			},
+		},
+		containers,
+	)
+
+	// Accept a block, then rebuild the index one block at a time
+	chainCtx.Lock.Lock()
+	lastAccepted = 2
+	require.NoError(config.BlockAcceptorGroup.Accept(chainCtx, blks[2].ID(), blks[2].Bytes()))
+	chainCtx.Lock.Unlock()
+	idxr.reindexBatchSize = 1
+	require.NoError(idxr.Reindex(chainCtx.ChainID))
+
+	// Blocks accepted while the index is rebuilt are indexed in order
+	chainCtx.Lock.Lock()
+	lastAccepted = 3
+	require.NoError(config.BlockAcceptorGroup.Accept(chainCtx, blks[3].ID(), blks[3].Bytes()))
+	chainCtx.Lock.Unlock()
+
+	require.Eventually(func() bool {
+		idxr.lock.RLock()
+		defer idxr.lock.RUnlock()
+		return !idxr.reindexing.Contains(chainCtx.ChainID)
+	}, 5*time.Second, 10*time.Millisecond)
+	isIncomplete, err = idxr.isIncomplete(chainCtx.ChainID)
+	require.NoError(err)
+	require.False(isIncomplete)
+
+	containers, err = blkIdx.GetContainerRange(0, MaxFetchedByRange)
+	require.NoError(err)
+	require.Len(containers, 3)
+	for i, container := range containers {
+		blk := blks[i+1]
+		require.Equal(
+			Container{
+				ID:        blk.ID(),
+				Bytes:     blk.Bytes(),
+				Timestamp: blk.Timestamp().UnixNano(),
+			},
+			container,
+		)
+	}
+
+	// Chains that aren't indexed can't be rebuilt
+	err = idxr.Reindex(snowtest.CChainID)
+	require.ErrorIs(err, errCantReindex)
+
+	// Closing the indexer cancels any ongoing backfill
+	require.NoError(idxr.Close())
+	require.ErrorIs(idxr.ctx.Err(), context.Canceled)
+	err = idxr.Reindex(chainCtx.ChainID)
+	require.ErrorIs(err, errClosed)
+}
+
 // Ensure we only index chains in the primary network
 func TestIgnoreNonDefaultChains(t *testing.T) {
 	require := require.New(t)
@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"errors"
+	"time"
+
+	vmindex "github.com/ava-labs/avalanchego/vms/components/index"
+	proposervmblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+var (
+	_ parser = (*blockParser)(nil)
+	_ parser = (*txParser)(nil)
+
+	errDecodingUnsupported = errors.New("decoding the containers of this index isn't supported")
+	errNotBlockIndex       = errors.New("index doesn't contain blocks")
+)
+
+// DecodedTx is a tx that was decoded from a container by the VM's parser
+type DecodedTx = vmindex.DecodedTx
+
+// decodedContainer is the result of decoding a container
+type decodedContainer struct {
+	// Only set if the container is a block
+	height  uint64
+	isBlock bool
+	txs     []DecodedTx
+}
+
+// parser decodes the containers of an index
+type parser interface {
+	parse(containerBytes []byte) (decodedContainer, error)
+}
+
+// newParser returns the parser of the containers of the index with prefix
+// [prefixEnd], using the parser provided by the chain's VM, or nil if that
+// index can't be decoded. Vertices can't be decoded because they are built by
+// the consensus engine rather than the VM.
+//
+// [durangoTime] is the time the chain's proposervm blocks were upgraded to
+// Durango, which determines how they are parsed.
+func newParser(vmParser vmindex.ContainerParser, durangoTime time.Time, prefixEnd byte) parser {
+	if vmParser == nil {
+		return nil
+	}
+	switch prefixEnd {
+	case blockPrefix:
+		return &blockParser{
+			vmParser:    vmParser,
+			durangoTime: durangoTime,
+		}
+	case txPrefix:
+		return &txParser{
+			vmParser: vmParser,
+		}
+	default:
+		return nil
+	}
+}
+
+type blockParser struct {
+	vmParser    vmindex.ContainerParser
+	durangoTime time.Time
+}
+
+func (p *blockParser) parse(containerBytes []byte) (decodedContainer, error) {
+	height, txs, err := p.vmParser.ParseBlock(p.innerBlockBytes(containerBytes))
+	if err != nil {
+		return decodedContainer{}, err
+	}
+	return decodedContainer{
+		height:  height,
+		isBlock: true,
+		txs:     txs,
+	}, nil
+}
+
+// innerBlockBytes returns the bytes of the block wrapped by the proposervm
+// block [blkBytes]. If [blkBytes] isn't a proposervm block, it was accepted
+// before the proposervm was activated and is returned as is.
+func (p *blockParser) innerBlockBytes(blkBytes []byte) []byte {
+	proposerVMBlock, err := proposervmblock.Parse(blkBytes, p.durangoTime)
+	if err != nil {
+		return blkBytes
+	}
+	return proposerVMBlock.Block()
+}
+
+type txParser struct {
+	vmParser vmindex.ContainerParser
+}
+
+func (p *txParser) parse(containerBytes []byte) (decodedContainer, error) {
+	tx, err := p.vmParser.ParseTx(containerBytes)
+	if err != nil {
+		return decodedContainer{}, err
+	}
+	return decodedContainer{
+		txs: []DecodedTx{tx},
+	}, nil
+}
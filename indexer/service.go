@@ -4,6 +4,7 @@
 package indexer
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -12,10 +13,18 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+var (
+	errInvalidRange        = errors.New("end of range is before its start")
+	errNoContainersInRange = errors.New("no containers in range")
 )
 
 type service struct {
 	index *index
+	// nil if the containers of [index] can't be decoded
+	parser parser
 }
 
 type FormattedContainer struct {
@@ -24,6 +33,10 @@ type FormattedContainer struct {
 	Timestamp time.Time           `json:"timestamp"`
 	Encoding  formatting.Encoding `json:"encoding"`
 	Index     json.Uint64         `json:"index"`
+	// Height and Txs are only set if the container was decoded. Height is only
+	// set if the container is a block.
+	Height *json.Uint64 `json:"height,omitempty"`
+	Txs    []DecodedTx  `json:"txs,omitempty"`
 }
 
 func newFormattedContainer(c Container, index uint64, enc formatting.Encoding) (FormattedContainer, error) {
@@ -41,6 +54,33 @@ func newFormattedContainer(c Container, index uint64, enc formatting.Encoding) (
 	return fc, nil
 }
 
+// decode sets the height and txs of [fc] by decoding [containerBytes]. If
+// [txTypes] isn't empty, only the txs of those types are set and false is
+// returned if the container doesn't contain any of them.
+func (s *service) decode(fc *FormattedContainer, containerBytes []byte, txTypes set.Set[string]) (bool, error) {
+	if s.parser == nil {
+		return false, errDecodingUnsupported
+	}
+	decoded, err := s.parser.parse(containerBytes)
+	if err != nil {
+		return false, fmt.Errorf("couldn't decode container %s: %w", fc.ID, err)
+	}
+	if decoded.isBlock {
+		height := json.Uint64(decoded.height)
+		fc.Height = &height
+	}
+	if txTypes.Len() == 0 {
+		fc.Txs = decoded.txs
+		return true, nil
+	}
+	for _, tx := range decoded.txs {
+		if txTypes.Contains(tx.Type) {
+			fc.Txs = append(fc.Txs, tx)
+		}
+	}
+	return len(fc.Txs) > 0, nil
+}
+
 type GetLastAcceptedArgs struct {
 	Encoding formatting.Encoding `json:"encoding"`
 }
@@ -80,6 +120,11 @@ type GetContainerRangeArgs struct {
 	StartIndex json.Uint64         `json:"startIndex"`
 	NumToFetch json.Uint64         `json:"numToFetch"`
 	Encoding   formatting.Encoding `json:"encoding"`
+	// If true, the txs of each container are decoded using the VM's parser
+	Decode bool `json:"decode"`
+	// If non-empty, only the containers that contain txs of these types are
+	// returned, along with only those txs. Implies [Decode].
+	TxTypes []string `json:"txTypes"`
 }
 
 type GetContainerRangeResponse struct {
@@ -91,23 +136,137 @@ type GetContainerRangeResponse struct {
 // If [startIndex] > the last accepted index, returns an error (unless the above apply.)
 // If [n] > [MaxFetchedByRange], returns an error.
 // If we run out of transactions, returns the ones fetched before running out.
+// If [TxTypes] is non-empty, the containers without txs of those types are
+// omitted, so fewer than [n] containers may be returned.
 func (s *service) GetContainerRange(_ *http.Request, args *GetContainerRangeArgs, reply *GetContainerRangeResponse) error {
 	containers, err := s.index.GetContainerRange(uint64(args.StartIndex), uint64(args.NumToFetch))
 	if err != nil {
 		return err
 	}
 
-	reply.Containers = make([]FormattedContainer, len(containers))
-	for i, container := range containers {
+	var (
+		txTypes = set.Of(args.TxTypes...)
+		decode  = args.Decode || txTypes.Len() > 0
+	)
+	reply.Containers = make([]FormattedContainer, 0, len(containers))
+	for _, container := range containers {
 		index, err := s.index.GetIndex(container.ID)
 		if err != nil {
 			return fmt.Errorf("couldn't get index: %w", err)
 		}
-		reply.Containers[i], err = newFormattedContainer(container, index, args.Encoding)
+		fc, err := newFormattedContainer(container, index, args.Encoding)
 		if err != nil {
 			return err
 		}
+		if decode {
+			matches, err := s.decode(&fc, container.Bytes, txTypes)
+			if err != nil {
+				return err
+			}
+			if !matches {
+				continue
+			}
+		}
+		reply.Containers = append(reply.Containers, fc)
+	}
+	return nil
+}
+
+type GetIndexRangeByTimeArgs struct {
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+}
+
+type GetIndexRangeResponse struct {
+	StartIndex json.Uint64 `json:"startIndex"`
+	EndIndex   json.Uint64 `json:"endIndex"`
+}
+
+// GetIndexRangeByTime returns the indices of the first and last containers
+// accepted in [startTime, endTime]. The containers in the range can then be
+// fetched with GetContainerRange.
+// Returns an error if no containers were accepted in the range.
+//
+// The range is found with a binary search, which assumes that the timestamps
+// of the containers don't decrease as their indices increase. If they do, such
+// as if the node's clock was moved backwards, containers near the jump may be
+// left out of the range.
+func (s *service) GetIndexRangeByTime(_ *http.Request, args *GetIndexRangeByTimeArgs, reply *GetIndexRangeResponse) error {
+	if args.EndTime.Before(args.StartTime) {
+		return errInvalidRange
+	}
+
+	var (
+		startTime = args.StartTime.UnixNano()
+		endTime   = args.EndTime.UnixNano()
+	)
+	startIndex, err := s.index.Search(func(c Container) (bool, error) {
+		return c.Timestamp >= startTime, nil
+	})
+	if err != nil {
+		return err
+	}
+	endIndex, err := s.index.Search(func(c Container) (bool, error) {
+		return c.Timestamp > endTime, nil
+	})
+	if err != nil {
+		return err
+	}
+	return setIndexRange(reply, startIndex, endIndex)
+}
+
+type GetIndexRangeByHeightArgs struct {
+	StartHeight json.Uint64 `json:"startHeight"`
+	EndHeight   json.Uint64 `json:"endHeight"`
+}
+
+// GetIndexRangeByHeight returns the indices of the first and last blocks with
+// heights in [startHeight, endHeight]. The blocks in the range can then be
+// fetched with GetContainerRange.
+// Returns an error if the index doesn't contain decodable blocks or if no
+// blocks in the range were accepted.
+func (s *service) GetIndexRangeByHeight(_ *http.Request, args *GetIndexRangeByHeightArgs, reply *GetIndexRangeResponse) error {
+	if args.EndHeight < args.StartHeight {
+		return errInvalidRange
+	}
+	if s.parser == nil {
+		return errDecodingUnsupported
+	}
+
+	height := func(c Container) (uint64, error) {
+		decoded, err := s.parser.parse(c.Bytes)
+		if err != nil {
+			return 0, fmt.Errorf("couldn't decode container %s: %w", c.ID, err)
+		}
+		if !decoded.isBlock {
+			return 0, errNotBlockIndex
+		}
+		return decoded.height, nil
+	}
+	startIndex, err := s.index.Search(func(c Container) (bool, error) {
+		h, err := height(c)
+		return h >= uint64(args.StartHeight), err
+	})
+	if err != nil {
+		return err
+	}
+	endIndex, err := s.index.Search(func(c Container) (bool, error) {
+		h, err := height(c)
+		return h > uint64(args.EndHeight), err
+	})
+	if err != nil {
+		return err
+	}
+	return setIndexRange(reply, startIndex, endIndex)
+}
+
+// setIndexRange sets [reply] to the range of indices [startIndex, endIndex).
+func setIndexRange(reply *GetIndexRangeResponse, startIndex, endIndex uint64) error {
+	if startIndex >= endIndex {
+		return errNoContainersInRange
 	}
+	reply.StartIndex = json.Uint64(startIndex)
+	reply.EndIndex = json.Uint64(endIndex - 1)
 	return nil
 }
 
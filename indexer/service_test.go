@@ -0,0 +1,231 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package indexer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/database/versiondb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/snowtest"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	platformvmblock "github.com/ava-labs/avalanchego/vms/platformvm/block"
+	proposervmblock "github.com/ava-labs/avalanchego/vms/proposervm/block"
+)
+
+func TestServiceGetIndexRangeByTime(t *testing.T) {
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	idx, err := newIndex(versiondb.New(memdb.New()), logging.NoLog{}, mockable.Clock{})
+	require.NoError(t, err)
+
+	// Accept a container every second
+	startTime := time.Unix(1000, 0)
+	for i := 0; i < 5; i++ {
+		idx.clock.Set(startTime.Add(time.Duration(i) * time.Second))
+		require.NoError(t, idx.Accept(ctx, ids.GenerateTestID(), []byte{byte(i)}))
+	}
+	s := &service{index: idx}
+
+	tests := []struct {
+		name          string
+		startTime     time.Time
+		endTime       time.Time
+		expectedStart uint64
+		expectedEnd   uint64
+		expectedErr   error
+	}{
+		{
+			name:          "inner range",
+			startTime:     startTime.Add(time.Second),
+			endTime:       startTime.Add(3 * time.Second),
+			expectedStart: 1,
+			expectedEnd:   3,
+		},
+		{
+			name:          "between containers",
+			startTime:     startTime.Add(500 * time.Millisecond),
+			endTime:       startTime.Add(2500 * time.Millisecond),
+			expectedStart: 1,
+			expectedEnd:   2,
+		},
+		{
+			name:          "covers every container",
+			startTime:     time.Unix(0, 0),
+			endTime:       startTime.Add(time.Hour),
+			expectedStart: 0,
+			expectedEnd:   4,
+		},
+		{
+			name:        "after every container",
+			startTime:   startTime.Add(time.Minute),
+			endTime:     startTime.Add(time.Hour),
+			expectedErr: errNoContainersInRange,
+		},
+		{
+			name:        "end before start",
+			startTime:   startTime.Add(time.Second),
+			endTime:     startTime,
+			expectedErr: errInvalidRange,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			reply := GetIndexRangeResponse{}
+			err := s.GetIndexRangeByTime(nil, &GetIndexRangeByTimeArgs{
+				StartTime: test.startTime,
+				EndTime:   test.endTime,
+			}, &reply)
+			require.ErrorIs(err, test.expectedErr)
+			if test.expectedErr != nil {
+				return
+			}
+			require.Equal(json.Uint64(test.expectedStart), reply.StartIndex)
+			require.Equal(json.Uint64(test.expectedEnd), reply.EndIndex)
+		})
+	}
+}
+
+func TestServiceDecode(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.PChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	idx, err := newIndex(versiondb.New(memdb.New()), logging.NoLog{}, mockable.Clock{})
+	require.NoError(err)
+
+	advanceTimeTx, err := txs.NewSigned(&txs.AdvanceTimeTx{Time: 1}, txs.Codec, nil)
+	require.NoError(err)
+	createSubnetTx, err := txs.NewSigned(&txs.CreateSubnetTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    snowCtx.NetworkID,
+			BlockchainID: snowCtx.ChainID,
+		}},
+		Owner: &secp256k1fx.OutputOwners{},
+	}, txs.Codec, nil)
+	require.NoError(err)
+
+	// Index a block at height 1 with an AdvanceTimeTx and a block at height
+	// 2 with a CreateSubnetTx
+	parentID := ids.GenerateTestID()
+	for height, tx := range []*txs.Tx{advanceTimeTx, createSubnetTx} {
+		blk, err := platformvmblock.NewApricotStandardBlock(parentID, uint64(height+1), []*txs.Tx{tx})
+		require.NoError(err)
+		require.NoError(idx.Accept(ctx, blk.ID(), blk.Bytes()))
+		parentID = blk.ID()
+	}
+
+	vmParser, err := platformvm.NewContainerParser(snowCtx)
+	require.NoError(err)
+	s := &service{
+		index:  idx,
+		parser: newParser(vmParser, version.GetDurangoTime(snowCtx.NetworkID), blockPrefix),
+	}
+
+	// Decode every container
+	rangeReply := GetContainerRangeResponse{}
+	require.NoError(s.GetContainerRange(nil, &GetContainerRangeArgs{
+		StartIndex: 0,
+		NumToFetch: 10,
+		Encoding:   formatting.Hex,
+		Decode:     true,
+	}, &rangeReply))
+	require.Len(rangeReply.Containers, 2)
+	for i, fc := range rangeReply.Containers {
+		require.NotNil(fc.Height)
+		require.Equal(json.Uint64(i+1), *fc.Height)
+		require.Len(fc.Txs, 1)
+	}
+	require.Equal(advanceTimeTx.ID(), rangeReply.Containers[0].Txs[0].ID)
+	require.Equal("AdvanceTimeTx", rangeReply.Containers[0].Txs[0].Type)
+
+	// Only return the containers with a CreateSubnetTx
+	rangeReply = GetContainerRangeResponse{}
+	require.NoError(s.GetContainerRange(nil, &GetContainerRangeArgs{
+		StartIndex: 0,
+		NumToFetch: 10,
+		Encoding:   formatting.Hex,
+		TxTypes:    []string{"CreateSubnetTx"},
+	}, &rangeReply))
+	require.Len(rangeReply.Containers, 1)
+	require.Equal(json.Uint64(1), rangeReply.Containers[0].Index)
+	require.Len(rangeReply.Containers[0].Txs, 1)
+	require.Equal(createSubnetTx.ID(), rangeReply.Containers[0].Txs[0].ID)
+	require.Equal("CreateSubnetTx", rangeReply.Containers[0].Txs[0].Type)
+
+	// Find the index of a block by its height
+	indexReply := GetIndexRangeResponse{}
+	require.NoError(s.GetIndexRangeByHeight(nil, &GetIndexRangeByHeightArgs{
+		StartHeight: 2,
+		EndHeight:   5,
+	}, &indexReply))
+	require.Equal(json.Uint64(1), indexReply.StartIndex)
+	require.Equal(json.Uint64(1), indexReply.EndIndex)
+
+	// Indices that can't be decoded return an error
+	s.parser = nil
+	err = s.GetContainerRange(nil, &GetContainerRangeArgs{
+		StartIndex: 0,
+		NumToFetch: 10,
+		Encoding:   formatting.Hex,
+		Decode:     true,
+	}, &rangeReply)
+	require.ErrorIs(err, errDecodingUnsupported)
+}
+
+func TestServiceDecodeProposerVMBlock(t *testing.T) {
+	require := require.New(t)
+
+	snowCtx := snowtest.Context(t, snowtest.PChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+	idx, err := newIndex(versiondb.New(memdb.New()), logging.NoLog{}, mockable.Clock{})
+	require.NoError(err)
+
+	advanceTimeTx, err := txs.NewSigned(&txs.AdvanceTimeTx{Time: 1}, txs.Codec, nil)
+	require.NoError(err)
+	innerBlk, err := platformvmblock.NewApricotStandardBlock(ids.GenerateTestID(), 5, []*txs.Tx{advanceTimeTx})
+	require.NoError(err)
+
+	// Blocks accepted after the proposervm was activated are wrapped in a
+	// proposervm block
+	blk, err := proposervmblock.BuildUnsigned(ids.GenerateTestID(), time.Unix(1, 0), 1, innerBlk.Bytes())
+	require.NoError(err)
+	require.NoError(idx.Accept(ctx, blk.ID(), blk.Bytes()))
+
+	vmParser, err := platformvm.NewContainerParser(snowCtx)
+	require.NoError(err)
+	s := &service{
+		index:  idx,
+		parser: newParser(vmParser, version.GetDurangoTime(snowCtx.NetworkID), blockPrefix),
+	}
+
+	reply := GetContainerRangeResponse{}
+	require.NoError(s.GetContainerRange(nil, &GetContainerRangeArgs{
+		StartIndex: 0,
+		NumToFetch: 1,
+		Encoding:   formatting.Hex,
+		Decode:     true,
+	}, &reply))
+	require.Len(reply.Containers, 1)
+	fc := reply.Containers[0]
+	require.NotNil(fc.Height)
+	require.Equal(json.Uint64(5), *fc.Height)
+	require.Len(fc.Txs, 1)
+	require.Equal(advanceTimeTx.ID(), fc.Txs[0].ID)
+}
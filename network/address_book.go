@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/staking"
+	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+)
+
+const maxAddressBookEntryLen = 64 * 1024
+
+var errInvalidAddressBookEntry = errors.New("invalid address book entry")
+
+type addressBookEntry struct {
+	gossipID ids.ID
+	// lastSeen is the last time the IP was known to be the most recent IP of
+	// the peer.
+	lastSeen time.Time
+}
+
+// addressBook persists the most recently known IPs of peers so that they can
+// be dialed after a restart, rather than rediscovering the network from the
+// bootstrappers. Entries that haven't been seen for [maxAge] are evicted.
+//
+// addressBook is not thread safe.
+type addressBook struct {
+	db      database.Database
+	maxAge  time.Duration
+	entries map[ids.NodeID]addressBookEntry
+}
+
+func newAddressBook(db database.Database, maxAge time.Duration) *addressBook {
+	return &addressBook{
+		db:      db,
+		maxAge:  maxAge,
+		entries: make(map[ids.NodeID]addressBookEntry),
+	}
+}
+
+// Load returns the persisted IPs that were seen within [maxAge] of [now].
+// Expired and unparsable entries are removed from the database.
+func (a *addressBook) Load(now time.Time) ([]*ips.ClaimedIPPort, error) {
+	var (
+		claimedIPs []*ips.ClaimedIPPort
+		toDelete   [][]byte
+		it         = a.db.NewIterator()
+	)
+	defer it.Release()
+
+	for it.Next() {
+		// The iterator may reuse the returned slices, so they are copied.
+		key := slices.Clone(it.Key())
+		ip, lastSeen, err := parseAddressBookEntry(slices.Clone(it.Value()))
+		if err != nil || !bytes.Equal(key, ip.NodeID.Bytes()) || now.Sub(lastSeen) > a.maxAge {
+			toDelete = append(toDelete, key)
+			continue
+		}
+
+		a.entries[ip.NodeID] = addressBookEntry{
+			gossipID: ip.GossipID,
+			lastSeen: lastSeen,
+		}
+		claimedIPs = append(claimedIPs, ip)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+
+	for _, key := range toDelete {
+		if err := a.db.Delete(key); err != nil {
+			return nil, err
+		}
+	}
+	return claimedIPs, nil
+}
+
+// Put marks [claimedIPs] as seen at [now] and evicts any entries that haven't
+// been seen within [maxAge]. To avoid rewriting every entry on each call, an
+// unchanged entry is only refreshed once it is halfway to expiring.
+func (a *addressBook) Put(claimedIPs []*ips.ClaimedIPPort, now time.Time) error {
+	batch := a.db.NewBatch()
+	for _, ip := range claimedIPs {
+		entry, ok := a.entries[ip.NodeID]
+		if ok && entry.gossipID == ip.GossipID && now.Sub(entry.lastSeen) < a.maxAge/2 {
+			continue
+		}
+
+		if err := batch.Put(ip.NodeID.Bytes(), marshalAddressBookEntry(ip, now)); err != nil {
+			return err
+		}
+		a.entries[ip.NodeID] = addressBookEntry{
+			gossipID: ip.GossipID,
+			lastSeen: now,
+		}
+	}
+
+	for nodeID, entry := range a.entries {
+		if now.Sub(entry.lastSeen) <= a.maxAge {
+			continue
+		}
+
+		if err := batch.Delete(nodeID.Bytes()); err != nil {
+			return err
+		}
+		delete(a.entries, nodeID)
+	}
+	return batch.Write()
+}
+
+// Remove evicts [nodeID] from the address book.
+func (a *addressBook) Remove(nodeID ids.NodeID) error {
+	delete(a.entries, nodeID)
+	return a.db.Delete(nodeID.Bytes())
+}
+
+func marshalAddressBookEntry(ip *ips.ClaimedIPPort, lastSeen time.Time) []byte {
+	p := wrappers.Packer{
+		MaxSize: maxAddressBookEntryLen,
+	}
+	p.PackBytes(ip.Cert.Raw)
+	p.PackFixedBytes(ip.IPPort.IP.To16())
+	p.PackShort(ip.IPPort.Port)
+	p.PackLong(ip.Timestamp)
+	p.PackBytes(ip.Signature)
+	p.PackLong(uint64(lastSeen.Unix()))
+	return p.Bytes
+}
+
+func parseAddressBookEntry(b []byte) (*ips.ClaimedIPPort, time.Time, error) {
+	p := wrappers.Packer{
+		Bytes: b,
+	}
+	certBytes := p.UnpackBytes()
+	ip := net.IP(p.UnpackFixedBytes(net.IPv6len))
+	port := p.UnpackShort()
+	timestamp := p.UnpackLong()
+	signature := p.UnpackBytes()
+	lastSeen := time.Unix(int64(p.UnpackLong()), 0)
+	if p.Err != nil {
+		return nil, time.Time{}, fmt.Errorf("%w: %w", errInvalidAddressBookEntry, p.Err)
+	}
+	if p.Offset != len(b) {
+		return nil, time.Time{}, fmt.Errorf("%w: %d trailing bytes", errInvalidAddressBookEntry, len(b)-p.Offset)
+	}
+
+	cert, err := staking.ParseCertificatePermissive(certBytes)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("%w: %w", errInvalidAddressBookEntry, err)
+	}
+	claimedIP := ips.NewClaimedIPPort(
+		cert,
+		ips.IPPort{
+			IP:   ip,
+			Port: port,
+		},
+		timestamp,
+		signature,
+	)
+	return claimedIP, lastSeen, nil
+}
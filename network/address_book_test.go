@@ -0,0 +1,116 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/ips"
+)
+
+func requireSameIPs(t *testing.T, expected []*ips.ClaimedIPPort, actual []*ips.ClaimedIPPort) {
+	require := require.New(t)
+
+	require.Len(actual, len(expected))
+	expectedIPs := make(map[ids.NodeID]*ips.ClaimedIPPort, len(expected))
+	for _, ip := range expected {
+		expectedIPs[ip.NodeID] = ip
+	}
+	for _, actualIP := range actual {
+		expectedIP, ok := expectedIPs[actualIP.NodeID]
+		require.True(ok)
+		require.Equal(expectedIP.Cert.Raw, actualIP.Cert.Raw)
+		require.True(expectedIP.IPPort.Equal(actualIP.IPPort))
+		require.Equal(expectedIP.Timestamp, actualIP.Timestamp)
+		require.Equal(expectedIP.GossipID, actualIP.GossipID)
+	}
+}
+
+func TestAddressBookPersistsAcrossRestarts(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	now := time.Unix(1_000_000, 0)
+
+	book := newAddressBook(db, time.Hour)
+	claimedIPs, err := book.Load(now)
+	require.NoError(err)
+	require.Empty(claimedIPs)
+
+	require.NoError(book.Put([]*ips.ClaimedIPPort{ip, otherIP}, now))
+
+	// Simulate a restart by creating a new address book over the same
+	// database.
+	book = newAddressBook(db, time.Hour)
+	claimedIPs, err = book.Load(now)
+	require.NoError(err)
+	requireSameIPs(t, []*ips.ClaimedIPPort{ip, otherIP}, claimedIPs)
+
+	// A newer IP replaces the persisted IP.
+	newIP := newerTestIP(ip)
+	require.NoError(book.Put([]*ips.ClaimedIPPort{newIP}, now))
+
+	book = newAddressBook(db, time.Hour)
+	claimedIPs, err = book.Load(now)
+	require.NoError(err)
+	requireSameIPs(t, []*ips.ClaimedIPPort{newIP, otherIP}, claimedIPs)
+
+	require.NoError(book.Remove(otherIP.NodeID))
+
+	book = newAddressBook(db, time.Hour)
+	claimedIPs, err = book.Load(now)
+	require.NoError(err)
+	requireSameIPs(t, []*ips.ClaimedIPPort{newIP}, claimedIPs)
+}
+
+func TestAddressBookEvictsExpiredIPs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	now := time.Unix(1_000_000, 0)
+
+	book := newAddressBook(db, time.Hour)
+	require.NoError(book.Put([]*ips.ClaimedIPPort{ip, otherIP}, now))
+
+	// Only [ip] continues to be known, so [otherIP] ages out.
+	now = now.Add(45 * time.Minute)
+	require.NoError(book.Put([]*ips.ClaimedIPPort{ip}, now))
+
+	now = now.Add(30 * time.Minute)
+	require.NoError(book.Put([]*ips.ClaimedIPPort{ip}, now))
+	has, err := db.Has(otherIP.NodeID.Bytes())
+	require.NoError(err)
+	require.False(has)
+
+	// IPs that expired while the node was offline are evicted on load.
+	now = now.Add(2 * time.Hour)
+	book = newAddressBook(db, time.Hour)
+	claimedIPs, err := book.Load(now)
+	require.NoError(err)
+	require.Empty(claimedIPs)
+	has, err = db.Has(ip.NodeID.Bytes())
+	require.NoError(err)
+	require.False(has)
+}
+
+func TestAddressBookEvictsInvalidEntries(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	require.NoError(db.Put(ip.NodeID.Bytes(), []byte{0x01}))
+
+	book := newAddressBook(db, time.Hour)
+	claimedIPs, err := book.Load(time.Unix(1_000_000, 0))
+	require.NoError(err)
+	require.Empty(claimedIPs)
+
+	has, err := db.Has(ip.NodeID.Bytes())
+	require.NoError(err)
+	require.False(has)
+}
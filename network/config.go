@@ -8,8 +8,10 @@ import (
 	"crypto/tls"
 	"time"
 
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/dialer"
+	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/network/throttling"
 	"github.com/ava-labs/avalanchego/snow/networking/tracker"
 	"github.com/ava-labs/avalanchego/snow/uptime"
@@ -111,6 +113,15 @@ type ThrottlerConfig struct {
 	MaxInboundConnsPerSec             float64                                      `json:"maxInboundConnsPerSec"`
 }
 
+// PinnedPeer is a peer that this node always attempts to stay connected to,
+// regardless of whether it is a validator.
+type PinnedPeer struct {
+	NodeID ids.NodeID `json:"nodeID"`
+	IP     ips.IPPort `json:"ip"`
+	// Trusted peers are exempt from inbound connection upgrade throttling.
+	Trusted bool `json:"trusted"`
+}
+
 type Config struct {
 	HealthConfig         `json:"healthConfig"`
 	PeerListGossipConfig `json:"peerListGossipConfig"`
@@ -118,6 +129,10 @@ type Config struct {
 	DelayConfig          `json:"delayConfig"`
 	ThrottlerConfig      ThrottlerConfig `json:"throttlerConfig"`
 
+	// ReputationConfig configures when misbehaving peers are disconnected
+	// and banned.
+	ReputationConfig peer.ReputationConfig `json:"reputationConfig"`
+
 	ProxyEnabled           bool          `json:"proxyEnabled"`
 	ProxyReadHeaderTimeout time.Duration `json:"proxyReadHeaderTimeout"`
 
@@ -163,6 +178,38 @@ type Config struct {
 	// responsive for us to vote that they should receive a staking reward.
 	UptimeRequirement float64 `json:"-"`
 
+	// PinnedPeers are the peers that this node always attempts to stay
+	// connected to. If the connection to a pinned peer is lost, it is
+	// reattempted forever.
+	PinnedPeers []PinnedPeer `json:"pinnedPeers"`
+
+	// AddressBookDB persists the most recently known validator IPs so that
+	// they can be dialed after a restart. If nil, IPs are not persisted.
+	AddressBookDB database.Database `json:"-"`
+
+	// AddressBookMaxAge is how long a persisted IP is kept after it was last
+	// known to be the most recent IP of a validator.
+	AddressBookMaxAge time.Duration `json:"addressBookMaxAge"`
+
+	// AddressBookSyncFreq is how frequently the known validator IPs are
+	// persisted.
+	AddressBookSyncFreq time.Duration `json:"addressBookSyncFreq"`
+
+	// BandwidthMetricsNumPeers is the number of peers that consumed the most
+	// inbound bandwidth, and the most outbound bandwidth, to report per-peer
+	// bandwidth metrics for.
+	BandwidthMetricsNumPeers int `json:"bandwidthMetricsNumPeers"`
+
+	// PrivateNode hides this node behind its pinned peers, which act as
+	// its sentries. The node only connects to its pinned peers and never
+	// reveals its IP in its handshake, so its IP is never advertised.
+	//
+	// This mode is only for nodes that aren't validators, such as API or
+	// archival nodes that shouldn't be reachable from the network. Sentries
+	// don't relay consensus messages, so if the node is, or becomes, a primary
+	// network validator, the network is closed and the node shuts down.
+	PrivateNode bool `json:"privateNode"`
+
 	// RequireValidatorToConnect require that all connections must have at least
 	// one validator between the 2 peers. This can be useful to enable if the
 	// node wants to connect to the minimum number of nodes without impacting
@@ -9,6 +9,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
+	"golang.org/x/exp/maps"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/validators"
@@ -81,6 +82,9 @@ type ipTracker struct {
 	lock sync.RWMutex
 	// Manually tracked nodes are always treated like validators
 	manuallyTracked set.Set[ids.NodeID]
+	// Pinned nodes are always connected to, but unlike manually tracked nodes
+	// they are not treated as validators.
+	pinned set.Set[ids.NodeID]
 	// Connected tracks the currently connected peers, including validators and
 	// non-validators. The IP is not necessarily the same IP as in
 	// mostRecentIPs.
@@ -120,14 +124,34 @@ func (i *ipTracker) ManuallyTrack(nodeID ids.NodeID) {
 	i.manuallyTracked.Add(nodeID)
 }
 
+// Pin marks [nodeID] as a node that we should always be connected to.
+func (i *ipTracker) Pin(nodeID ids.NodeID) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.pinned.Add(nodeID)
+}
+
+// Unpin removes the mark previously added by Pin.
+func (i *ipTracker) Unpin(nodeID ids.NodeID) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.pinned.Remove(nodeID)
+}
+
 func (i *ipTracker) WantsConnection(nodeID ids.NodeID) bool {
 	i.lock.RLock()
 	defer i.lock.RUnlock()
 
-	return i.validators.Contains(nodeID)
+	return i.validators.Contains(nodeID) || i.pinned.Contains(nodeID)
 }
 
 func (i *ipTracker) ShouldVerifyIP(ip *ips.ClaimedIPPort) bool {
+	if !isAdvertisable(ip) {
+		return false
+	}
+
 	i.lock.RLock()
 	defer i.lock.RUnlock()
 
@@ -143,6 +167,10 @@ func (i *ipTracker) ShouldVerifyIP(ip *ips.ClaimedIPPort) bool {
 // AddIP returns true if the addition of the provided IP updated the most
 // recently known IP of a validator.
 func (i *ipTracker) AddIP(ip *ips.ClaimedIPPort) bool {
+	if !isAdvertisable(ip) {
+		return false
+	}
+
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
@@ -178,12 +206,20 @@ func (i *ipTracker) GetIP(nodeID ids.NodeID) (*ips.ClaimedIPPort, bool) {
 	return ip, ok
 }
 
+// GetIPs returns the most recently known IP of every validator.
+func (i *ipTracker) GetIPs() []*ips.ClaimedIPPort {
+	i.lock.RLock()
+	defer i.lock.RUnlock()
+
+	return maps.Values(i.mostRecentValidatorIPs)
+}
+
 func (i *ipTracker) Connected(ip *ips.ClaimedIPPort) {
 	i.lock.Lock()
 	defer i.lock.Unlock()
 
 	i.connected[ip.NodeID] = ip
-	if !i.validators.Contains(ip.NodeID) {
+	if !isAdvertisable(ip) || !i.validators.Contains(ip.NodeID) {
 		return
 	}
 
@@ -229,7 +265,7 @@ func (i *ipTracker) onValidatorAdded(nodeID ids.NodeID) {
 
 	i.validators.Add(nodeID)
 	ip, connected := i.connected[nodeID]
-	if !connected {
+	if !connected || !isAdvertisable(ip) {
 		return
 	}
 
@@ -256,6 +292,12 @@ func (i *ipTracker) OnValidatorRemoved(nodeID ids.NodeID, _ uint64) {
 	i.removeGossipableIP(nodeID)
 }
 
+// isAdvertisable returns false if [ip] was signed by a private node, which
+// hides its IP behind the unspecified IP.
+func isAdvertisable(ip *ips.ClaimedIPPort) bool {
+	return !ip.IPPort.IP.IsUnspecified()
+}
+
 func (i *ipTracker) updateMostRecentValidatorIP(ip *ips.ClaimedIPPort) {
 	i.mostRecentValidatorIPs[ip.NodeID] = ip
 	i.numValidatorIPs.Set(float64(len(i.mostRecentValidatorIPs)))
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"net"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -34,6 +35,7 @@ func newerTestIP(ip *ips.ClaimedIPPort) *ips.ClaimedIPPort {
 func requireEqual(t *testing.T, expected, actual *ipTracker) {
 	require := require.New(t)
 	require.Equal(expected.manuallyTracked, actual.manuallyTracked)
+	require.Equal(expected.pinned, actual.pinned)
 	require.Equal(expected.connected, actual.connected)
 	require.Equal(expected.mostRecentValidatorIPs, actual.mostRecentValidatorIPs)
 	require.Equal(expected.validators, actual.validators)
@@ -133,6 +135,50 @@ func TestIPTracker_ManuallyTrack(t *testing.T) {
 	}
 }
 
+func TestIPTracker_Pin(t *testing.T) {
+	require := require.New(t)
+
+	tracker := newTestIPTracker(t)
+	require.False(tracker.WantsConnection(ip.NodeID))
+
+	tracker.Pin(ip.NodeID)
+	require.True(tracker.WantsConnection(ip.NodeID))
+
+	// Pinned nodes aren't treated as validators, so their IPs aren't gossiped
+	tracker.Connected(ip)
+	require.Empty(tracker.gossipableIPs)
+	require.Empty(tracker.mostRecentValidatorIPs)
+
+	tracker.Unpin(ip.NodeID)
+	require.False(tracker.WantsConnection(ip.NodeID))
+	requireMetricsConsistent(t, tracker)
+}
+
+func TestIPTracker_PrivateIPIsNotAdvertised(t *testing.T) {
+	require := require.New(t)
+
+	privateIP := ips.NewClaimedIPPort(
+		ip.Cert,
+		ips.IPPort{
+			IP:   net.IPv6unspecified,
+			Port: ip.IPPort.Port,
+		},
+		ip.Timestamp,
+		ip.Signature,
+	)
+
+	tracker := newTestIPTracker(t)
+	tracker.onValidatorAdded(privateIP.NodeID)
+	require.False(tracker.ShouldVerifyIP(privateIP))
+	require.False(tracker.AddIP(privateIP))
+
+	tracker.Connected(privateIP)
+	require.Contains(tracker.connected, privateIP.NodeID)
+	require.Empty(tracker.mostRecentValidatorIPs)
+	require.Empty(tracker.gossipableIPs)
+	requireMetricsConsistent(t, tracker)
+}
+
 func TestIPTracker_AddIP(t *testing.T) {
 	newerIP := newerTestIP(ip)
 	tests := []struct {
@@ -4,18 +4,36 @@
 package network
 
 import (
+	"cmp"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/exp/maps"
 
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/network/peer"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
+// peerOp identifies the bandwidth of a peer that is reported by a metric. An
+// empty op refers to the total of all message types.
+type peerOp struct {
+	nodeID ids.NodeID
+	op     string
+}
+
+func (p peerOp) labelValues() []string {
+	if p.op == "" {
+		return []string{p.nodeID.String()}
+	}
+	return []string{p.nodeID.String(), p.op}
+}
+
 type metrics struct {
 	numTracked                      prometheus.Gauge
 	numPeers                        prometheus.Gauge
@@ -28,6 +46,8 @@ type metrics struct {
 	acceptFailed                    prometheus.Counter
 	inboundConnRateLimited          prometheus.Counter
 	inboundConnAllowed              prometheus.Counter
+	inboundConnBanned               prometheus.Counter
+	peersBanned                     prometheus.Counter
 	tlsConnRejected                 prometheus.Counter
 	numUselessPeerListBytes         prometheus.Counter
 	nodeUptimeWeightedAverage       prometheus.Gauge
@@ -35,10 +55,22 @@ type metrics struct {
 	nodeSubnetUptimeWeightedAverage *prometheus.GaugeVec
 	nodeSubnetUptimeRewardingStake  *prometheus.GaugeVec
 	peerConnectedLifetimeAverage    prometheus.Gauge
+	peerReceivedBytes               *prometheus.GaugeVec
+	peerSentBytes                   *prometheus.GaugeVec
+	peerOpReceivedBytes             *prometheus.GaugeVec
+	peerOpSentBytes                 *prometheus.GaugeVec
 
 	lock                       sync.RWMutex
 	peerConnectedStartTimes    map[ids.NodeID]float64
 	peerConnectedStartTimesSum float64
+
+	// bandwidthLock protects the series that are currently reported by the
+	// peer bandwidth metrics.
+	bandwidthLock               sync.Mutex
+	reportedPeerReceivedBytes   set.Set[peerOp]
+	reportedPeerSentBytes       set.Set[peerOp]
+	reportedPeerOpReceivedBytes set.Set[peerOp]
+	reportedPeerOpSentBytes     set.Set[peerOp]
 }
 
 func newMetrics(namespace string, registerer prometheus.Registerer, initialSubnetIDs set.Set[ids.ID]) (*metrics, error) {
@@ -96,6 +128,16 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 			Name:      "inbound_conn_throttler_allowed",
 			Help:      "Times this node allowed (attempted to upgrade) an inbound connection",
 		}),
+		inboundConnBanned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "inbound_conn_banned",
+			Help:      "Times this node rejected an inbound connection from a banned IP",
+		}),
+		peersBanned: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "peers_banned",
+			Help:      "Times this node banned a peer for misbehaving",
+		}),
 		tlsConnRejected: prometheus.NewCounter(prometheus.CounterOpts{
 			Namespace: namespace,
 			Name:      "tls_conn_rejected",
@@ -144,6 +186,38 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 				Help:      "The average duration of all peer connections in nanoseconds",
 			},
 		),
+		peerReceivedBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "peer_received_bytes",
+				Help:      "Number of bytes received from the connected peers that have sent this node the most bytes",
+			},
+			[]string{"nodeID"},
+		),
+		peerSentBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "peer_sent_bytes",
+				Help:      "Number of bytes sent to the connected peers that this node has sent the most bytes",
+			},
+			[]string{"nodeID"},
+		),
+		peerOpReceivedBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "peer_op_received_bytes",
+				Help:      "Number of bytes of each message type received from the connected peers that have sent this node the most bytes of that type",
+			},
+			[]string{"nodeID", "op"},
+		),
+		peerOpSentBytes: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "peer_op_sent_bytes",
+				Help:      "Number of bytes of each message type sent to the connected peers that this node has sent the most bytes of that type",
+			},
+			[]string{"nodeID", "op"},
+		),
 		peerConnectedStartTimes: make(map[ids.NodeID]float64),
 	}
 
@@ -158,6 +232,8 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 		registerer.Register(m.disconnected),
 		registerer.Register(m.acceptFailed),
 		registerer.Register(m.inboundConnAllowed),
+		registerer.Register(m.inboundConnBanned),
+		registerer.Register(m.peersBanned),
 		registerer.Register(m.tlsConnRejected),
 		registerer.Register(m.numUselessPeerListBytes),
 		registerer.Register(m.inboundConnRateLimited),
@@ -166,6 +242,10 @@ func newMetrics(namespace string, registerer prometheus.Registerer, initialSubne
 		registerer.Register(m.nodeSubnetUptimeWeightedAverage),
 		registerer.Register(m.nodeSubnetUptimeRewardingStake),
 		registerer.Register(m.peerConnectedLifetimeAverage),
+		registerer.Register(m.peerReceivedBytes),
+		registerer.Register(m.peerSentBytes),
+		registerer.Register(m.peerOpReceivedBytes),
+		registerer.Register(m.peerOpSentBytes),
 	)
 
 	// init subnet tracker metrics with tracked subnets
@@ -232,3 +312,90 @@ func (m *metrics) updatePeerConnectionLifetimeMetrics() {
 
 	m.peerConnectedLifetimeAverage.Set(avg)
 }
+
+// updatePeerBandwidthMetrics reports the bandwidth of the [numPeers] peers that
+// sent the most bytes and of the [numPeers] peers that were sent the most
+// bytes, both in total and for each message type.
+//
+// The reported series are updated in place so that a concurrent scrape never
+// observes a partially populated metric. Series are only removed once their
+// peer disconnects or is no longer in the top [numPeers], so that the number of
+// reported series stays bounded.
+func (m *metrics) updatePeerBandwidthMetrics(bandwidths map[ids.NodeID]peer.Bandwidth, numPeers int) {
+	nodeIDs := maps.Keys(bandwidths)
+	ops := set.Set[string]{}
+	for _, bandwidth := range bandwidths {
+		for op := range bandwidth.Ops {
+			ops.Add(op)
+		}
+	}
+
+	var (
+		receivedBytes   = make(map[peerOp]json.Uint64)
+		sentBytes       = make(map[peerOp]json.Uint64)
+		opReceivedBytes = make(map[peerOp]json.Uint64)
+		opSentBytes     = make(map[peerOp]json.Uint64)
+	)
+	addTopPeers(receivedBytes, nodeIDs, numPeers, "", func(nodeID ids.NodeID) json.Uint64 {
+		return bandwidths[nodeID].Total.InboundBytes
+	})
+	addTopPeers(sentBytes, nodeIDs, numPeers, "", func(nodeID ids.NodeID) json.Uint64 {
+		return bandwidths[nodeID].Total.OutboundBytes
+	})
+	for op := range ops {
+		addTopPeers(opReceivedBytes, nodeIDs, numPeers, op, func(nodeID ids.NodeID) json.Uint64 {
+			return bandwidths[nodeID].Ops[op].InboundBytes
+		})
+		addTopPeers(opSentBytes, nodeIDs, numPeers, op, func(nodeID ids.NodeID) json.Uint64 {
+			return bandwidths[nodeID].Ops[op].OutboundBytes
+		})
+	}
+
+	m.bandwidthLock.Lock()
+	defer m.bandwidthLock.Unlock()
+
+	m.reportedPeerReceivedBytes = updateGauge(m.peerReceivedBytes, m.reportedPeerReceivedBytes, receivedBytes)
+	m.reportedPeerSentBytes = updateGauge(m.peerSentBytes, m.reportedPeerSentBytes, sentBytes)
+	m.reportedPeerOpReceivedBytes = updateGauge(m.peerOpReceivedBytes, m.reportedPeerOpReceivedBytes, opReceivedBytes)
+	m.reportedPeerOpSentBytes = updateGauge(m.peerOpSentBytes, m.reportedPeerOpSentBytes, opSentBytes)
+}
+
+// addTopPeers adds to [values] the [numPeers] peers of [nodeIDs] with the
+// largest non-zero [getBytes] of [op]. [nodeIDs] is sorted as a side effect.
+func addTopPeers(
+	values map[peerOp]json.Uint64,
+	nodeIDs []ids.NodeID,
+	numPeers int,
+	op string,
+	getBytes func(ids.NodeID) json.Uint64,
+) {
+	slices.SortFunc(nodeIDs, func(a, b ids.NodeID) int {
+		return cmp.Compare(getBytes(b), getBytes(a))
+	})
+	for _, nodeID := range nodeIDs[:min(numPeers, len(nodeIDs))] {
+		numBytes := getBytes(nodeID)
+		if numBytes == 0 {
+			break
+		}
+		values[peerOp{nodeID: nodeID, op: op}] = numBytes
+	}
+}
+
+// updateGauge sets the series of [gauge] to [values], deletes the series in
+// [reported] that are no longer in [values], and returns the series that are
+// now reported.
+func updateGauge(
+	gauge *prometheus.GaugeVec,
+	reported set.Set[peerOp],
+	values map[peerOp]json.Uint64,
+) set.Set[peerOp] {
+	for key, value := range values {
+		gauge.WithLabelValues(key.labelValues()...).Set(float64(value))
+	}
+	for key := range reported {
+		if _, ok := values[key]; !ok {
+			gauge.DeleteLabelValues(key.labelValues()...)
+		}
+	}
+	return set.Of(maps.Keys(values)...)
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/utils/set"
+)
+
+func TestUpdatePeerBandwidthMetrics(t *testing.T) {
+	require := require.New(t)
+
+	m, err := newMetrics("", prometheus.NewRegistry(), set.Set[ids.ID]{})
+	require.NoError(err)
+
+	var (
+		nodeID0 = ids.GenerateTestNodeID()
+		nodeID1 = ids.GenerateTestNodeID()
+		nodeID2 = ids.GenerateTestNodeID()
+
+		getOp = message.GetOp.String()
+		putOp = message.PutOp.String()
+	)
+	m.updatePeerBandwidthMetrics(
+		map[ids.NodeID]peer.Bandwidth{
+			nodeID0: {
+				Total: peer.MessageBandwidth{
+					InboundBytes:  3,
+					OutboundBytes: 1,
+				},
+				Ops: map[string]peer.MessageBandwidth{
+					getOp: {
+						InboundBytes:  3,
+						OutboundBytes: 1,
+					},
+				},
+			},
+			nodeID1: {
+				Total: peer.MessageBandwidth{
+					InboundBytes:  2,
+					OutboundBytes: 2,
+				},
+				Ops: map[string]peer.MessageBandwidth{
+					getOp: {
+						InboundBytes: 1,
+					},
+					putOp: {
+						InboundBytes:  1,
+						OutboundBytes: 2,
+					},
+				},
+			},
+			nodeID2: {
+				Total: peer.MessageBandwidth{
+					InboundBytes:  1,
+					OutboundBytes: 3,
+				},
+				Ops: map[string]peer.MessageBandwidth{
+					getOp: {
+						InboundBytes:  1,
+						OutboundBytes: 3,
+					},
+				},
+			},
+		},
+		2,
+	)
+
+	require.Equal(2, testutil.CollectAndCount(m.peerReceivedBytes))
+	require.Equal(float64(3), testutil.ToFloat64(m.peerReceivedBytes.WithLabelValues(nodeID0.String())))
+	require.Equal(float64(2), testutil.ToFloat64(m.peerReceivedBytes.WithLabelValues(nodeID1.String())))
+
+	require.Equal(2, testutil.CollectAndCount(m.peerSentBytes))
+	require.Equal(float64(3), testutil.ToFloat64(m.peerSentBytes.WithLabelValues(nodeID2.String())))
+	require.Equal(float64(2), testutil.ToFloat64(m.peerSentBytes.WithLabelValues(nodeID1.String())))
+
+	// Each op reports its own top peers, excluding peers that didn't exchange
+	// any bytes of that op.
+	require.Equal(3, testutil.CollectAndCount(m.peerOpReceivedBytes))
+	require.Equal(float64(3), testutil.ToFloat64(m.peerOpReceivedBytes.WithLabelValues(nodeID0.String(), getOp)))
+	require.Equal(float64(1), testutil.ToFloat64(m.peerOpReceivedBytes.WithLabelValues(nodeID1.String(), putOp)))
+
+	require.Equal(3, testutil.CollectAndCount(m.peerOpSentBytes))
+	require.Equal(float64(3), testutil.ToFloat64(m.peerOpSentBytes.WithLabelValues(nodeID2.String(), getOp)))
+	require.Equal(float64(1), testutil.ToFloat64(m.peerOpSentBytes.WithLabelValues(nodeID0.String(), getOp)))
+	require.Equal(float64(2), testutil.ToFloat64(m.peerOpSentBytes.WithLabelValues(nodeID1.String(), putOp)))
+
+	// Peers that disconnected are no longer reported, while the series of the
+	// remaining peers are updated in place.
+	m.updatePeerBandwidthMetrics(
+		map[ids.NodeID]peer.Bandwidth{
+			nodeID0: {
+				Total: peer.MessageBandwidth{
+					InboundBytes:  4,
+					OutboundBytes: 4,
+				},
+				Ops: map[string]peer.MessageBandwidth{
+					getOp: {
+						InboundBytes:  4,
+						OutboundBytes: 4,
+					},
+				},
+			},
+		},
+		2,
+	)
+
+	require.Equal(1, testutil.CollectAndCount(m.peerReceivedBytes))
+	require.Equal(float64(4), testutil.ToFloat64(m.peerReceivedBytes.WithLabelValues(nodeID0.String())))
+	require.Equal(1, testutil.CollectAndCount(m.peerSentBytes))
+	require.Equal(float64(4), testutil.ToFloat64(m.peerSentBytes.WithLabelValues(nodeID0.String())))
+	require.Equal(1, testutil.CollectAndCount(m.peerOpReceivedBytes))
+	require.Equal(float64(4), testutil.ToFloat64(m.peerOpReceivedBytes.WithLabelValues(nodeID0.String(), getOp)))
+	require.Equal(1, testutil.CollectAndCount(m.peerOpSentBytes))
+	require.Equal(float64(4), testutil.ToFloat64(m.peerOpSentBytes.WithLabelValues(nodeID0.String(), getOp)))
+}
@@ -44,6 +44,10 @@ const (
 	TimeSinceLastMsgReceivedKey = "timeSinceLastMsgReceived"
 	TimeSinceLastMsgSentKey     = "timeSinceLastMsgSent"
 	SendFailRateKey             = "sendFailRate"
+
+	// reputationPruneFrequency is how often expired bans and negligible
+	// reputation scores are removed.
+	reputationPruneFrequency = time.Minute
 )
 
 var (
@@ -79,10 +83,26 @@ type Network interface {
 	// connect to this ID.
 	ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort)
 
+	// AddPinnedPeer attempts to connect to [peer]. Until the peer is removed
+	// with RemovePinnedPeer, the network will reconnect to it whenever the
+	// connection is lost. If [peer] was already pinned, its IP and trust are
+	// updated.
+	AddPinnedPeer(peer PinnedPeer)
+
+	// RemovePinnedPeer stops keeping [nodeID] connected. If the connection is
+	// no longer desired, it is closed. Returns false if [nodeID] wasn't
+	// pinned.
+	RemovePinnedPeer(nodeID ids.NodeID) bool
+
+	// KnownPeerIPs returns the most recently known IP of every validator,
+	// including validators that this node isn't connected to.
+	KnownPeerIPs() []*ips.ClaimedIPPort
+
 	// PeerInfo returns information about peers. If [nodeIDs] is empty, returns
 	// info about all peers that have finished the handshake. Otherwise, returns
-	// info about the peers in [nodeIDs] that have finished the handshake.
-	PeerInfo(nodeIDs []ids.NodeID) []peer.Info
+	// info about the peers in [nodeIDs] that have finished the handshake. If
+	// [detailed] is true, the bandwidth consumed by each peer is included.
+	PeerInfo(nodeIDs []ids.NodeID, detailed bool) []peer.Info
 
 	// NodeUptime returns given node's [subnetID] UptimeResults in the view of
 	// this node's peer validators.
@@ -140,6 +160,14 @@ type network struct {
 
 	sendFailRateCalculator safemath.Averager
 
+	// Tracks the misbehavior of peers and which peers are banned
+	reputations *peer.Reputations
+
+	// Persists the known validator IPs across restarts. If nil, IPs aren't
+	// persisted.
+	addressBookLock sync.Mutex
+	addressBook     *addressBook
+
 	// Tracks which peers know about which peers
 	ipTracker *ipTracker
 	peersLock sync.RWMutex
@@ -147,7 +175,10 @@ type network struct {
 	// connect to. An entry is added to this set when we first start attempting
 	// to connect to the peer. An entry is deleted from this set once we have
 	// finished the handshake.
-	trackedIPs      map[ids.NodeID]*trackedIP
+	trackedIPs map[ids.NodeID]*trackedIP
+	// pinnedPeers contains the peers that we always attempt to be connected
+	// to.
+	pinnedPeers     map[ids.NodeID]PinnedPeer
 	connectingPeers peer.Set
 	connectedPeers  peer.Set
 	closing         bool
@@ -271,6 +302,9 @@ func NewNetwork(
 		UptimeCalculator:     config.UptimeCalculator,
 		IPSigner:             peer.NewIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey),
 	}
+	if config.PrivateNode {
+		peerConfig.IPSigner = peer.NewPrivateIPSigner(config.MyIPPort, config.TLSKey, config.BLSKey)
+	}
 
 	// Invariant: We delay the activation of durango during the TLS handshake to
 	// avoid gossiping any TLS certs that anyone else in the network may
@@ -301,12 +335,34 @@ func NewNetwork(
 		)),
 
 		trackedIPs:      make(map[ids.NodeID]*trackedIP),
+		pinnedPeers:     make(map[ids.NodeID]PinnedPeer),
 		ipTracker:       ipTracker,
+		reputations:     peer.NewReputations(config.ReputationConfig),
 		connectingPeers: peer.NewSet(),
 		connectedPeers:  peer.NewSet(),
 		router:          router,
 	}
 	n.peerConfig.Network = n
+
+	if config.AddressBookDB != nil {
+		n.addressBook = newAddressBook(config.AddressBookDB, config.AddressBookMaxAge)
+	}
+
+	for _, pinnedPeer := range config.PinnedPeers {
+		n.AddPinnedPeer(pinnedPeer)
+	}
+
+	if config.PrivateNode {
+		config.Validators.RegisterCallbackListener(constants.PrimaryNetworkID, &privateNodeGuard{
+			log:      log,
+			myNodeID: config.MyNodeID,
+			// The validator set may be locked while the callback is executed,
+			// so the network is closed asynchronously.
+			onStaking: func() {
+				go n.StartClose()
+			},
+		})
+	}
 	return n, nil
 }
 
@@ -383,6 +439,9 @@ func (n *network) HealthCheck(context.Context) (interface{}, error) {
 	// emit metrics about the lifetime of peer connections
 	n.metrics.updatePeerConnectionLifetimeMetrics()
 
+	// emit metrics about the peers consuming the most bandwidth
+	n.metrics.updatePeerBandwidthMetrics(n.peerBandwidths(), n.config.BandwidthMetricsNumPeers)
+
 	// Network layer is healthy
 	if healthy || !n.config.HealthConfig.Enabled {
 		return details, nil
@@ -452,8 +511,15 @@ func (n *network) Connected(nodeID ids.NodeID) {
 // AllowConnection returns true if this node should have a connection to the
 // provided nodeID. If the node is attempting to connect to the minimum number
 // of peers, then it should only connect if this node is a validator, or the
-// peer is a validator/beacon.
+// peer is a validator/beacon. A private node only connects to its pinned
+// peers. Banned peers are never connected to.
 func (n *network) AllowConnection(nodeID ids.NodeID) bool {
+	if n.reputations.IsBanned(nodeID) {
+		return false
+	}
+	if n.config.PrivateNode {
+		return n.isPinned(nodeID)
+	}
 	if !n.config.RequireValidatorToConnect {
 		return true
 	}
@@ -489,6 +555,45 @@ func (n *network) Disconnected(nodeID ids.NodeID) {
 	}
 }
 
+// Penalize records that [nodeID] committed [offense]. If this causes the peer
+// to be banned, the peer is disconnected and its nodeID and IP are refused
+// until the ban expires. Validators and pinned peers are never banned, as
+// disconnecting them could harm consensus.
+func (n *network) Penalize(nodeID ids.NodeID, offense peer.Offense) {
+	n.peersLock.RLock()
+	peer, ok := n.connectedPeers.GetByID(nodeID)
+	if !ok {
+		peer, ok = n.connectingPeers.GetByID(nodeID)
+	}
+	n.peersLock.RUnlock()
+
+	var ip net.IP
+	if ok {
+		ip = peer.RemoteIP()
+	}
+
+	n.peerConfig.Log.Debug("penalizing peer",
+		zap.Stringer("nodeID", nodeID),
+		zap.Stringer("offense", offense),
+	)
+	_, isValidator := n.config.Validators.GetValidator(constants.PrimaryNetworkID, nodeID)
+	canBan := !isValidator && !n.isPinned(nodeID)
+	if !n.reputations.Penalize(nodeID, ip, offense, canBan) {
+		return
+	}
+
+	n.peerConfig.Log.Info("banning peer",
+		zap.Stringer("nodeID", nodeID),
+		zap.Stringer("ip", ip),
+		zap.Stringer("offense", offense),
+		zap.Duration("duration", n.config.ReputationConfig.BanDuration),
+	)
+	n.metrics.peersBanned.Inc()
+	if ok {
+		peer.StartClose()
+	}
+}
+
 func (n *network) KnownPeers() ([]byte, []byte) {
 	return n.ipTracker.Bloom()
 }
@@ -505,6 +610,8 @@ func (n *network) Peers(except ids.NodeID, knownPeers *bloom.ReadFilter, salt []
 // Dispatch starts accepting connections from other nodes attempting to connect
 // to this node.
 func (n *network) Dispatch() error {
+	n.loadAddressBook()
+
 	go n.runTimers() // Periodically perform operations
 	go n.inboundConnUpgradeThrottler.Dispatch()
 	for { // Continuously accept new connections
@@ -540,7 +647,21 @@ func (n *network) Dispatch() error {
 				return
 			}
 
-			if !n.inboundConnUpgradeThrottler.ShouldUpgrade(ip) {
+			// Trusted peers may share an IP with a banned peer, for example
+			// when they are behind the same NAT. Validators behind such an
+			// IP remain reachable, as we dial validators ourselves.
+			isTrusted := n.isTrustedIP(ip)
+			if !isTrusted && n.reputations.IsIPBanned(ip.IP) {
+				n.peerConfig.Log.Debug("failed to upgrade connection",
+					zap.String("reason", "banned"),
+					zap.Stringer("peerIP", ip),
+				)
+				n.metrics.inboundConnBanned.Inc()
+				_ = conn.Close()
+				return
+			}
+
+			if !isTrusted && !n.inboundConnUpgradeThrottler.ShouldUpgrade(ip) {
 				n.peerConfig.Log.Debug("failed to upgrade connection",
 					zap.String("reason", "rate-limiting"),
 					zap.Stringer("peerIP", ip),
@@ -582,6 +703,12 @@ func (n *network) Dispatch() error {
 func (n *network) ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort) {
 	n.ipTracker.ManuallyTrack(nodeID)
 
+	if n.config.PrivateNode {
+		// Dialing a peer reveals our IP to it, so a private node only
+		// dials its pinned peers.
+		return
+	}
+
 	n.peersLock.Lock()
 	defer n.peersLock.Unlock()
 
@@ -601,7 +728,86 @@ func (n *network) ManuallyTrack(nodeID ids.NodeID, ip ips.IPPort) {
 	}
 }
 
+func (n *network) AddPinnedPeer(pinnedPeer PinnedPeer) {
+	n.ipTracker.Pin(pinnedPeer.NodeID)
+
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	n.pinnedPeers[pinnedPeer.NodeID] = pinnedPeer
+
+	if _, connected := n.connectedPeers.GetByID(pinnedPeer.NodeID); connected {
+		// If the connection is lost, we will redial the pinned IP.
+		return
+	}
+
+	tracked, isTracked := n.trackedIPs[pinnedPeer.NodeID]
+	switch {
+	case !isTracked:
+		tracked = newTrackedIP(pinnedPeer.IP)
+	case tracked.ip.Equal(pinnedPeer.IP):
+		// We are already attempting to connect to this IP.
+		return
+	default:
+		// Stop tracking the old IP and start tracking the pinned one.
+		tracked = tracked.trackNewIP(pinnedPeer.IP)
+	}
+	n.trackedIPs[pinnedPeer.NodeID] = tracked
+	n.dial(pinnedPeer.NodeID, tracked)
+}
+
+func (n *network) RemovePinnedPeer(nodeID ids.NodeID) bool {
+	n.peersLock.Lock()
+	defer n.peersLock.Unlock()
+
+	if _, ok := n.pinnedPeers[nodeID]; !ok {
+		return false
+	}
+	delete(n.pinnedPeers, nodeID)
+	n.ipTracker.Unpin(nodeID)
+
+	if n.ipTracker.WantsConnection(nodeID) {
+		return true
+	}
+
+	// Any outstanding dial attempts will be cleaned up by the dialing
+	// goroutine.
+	if peer, connected := n.connectedPeers.GetByID(nodeID); connected {
+		peer.StartClose()
+	}
+	return true
+}
+
+func (n *network) isPinned(nodeID ids.NodeID) bool {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	_, ok := n.pinnedPeers[nodeID]
+	return ok
+}
+
+// isTrustedIP returns true if [ip] is the IP of a trusted pinned peer. Only
+// the host is compared, as inbound connections are made from an ephemeral
+// port.
+func (n *network) isTrustedIP(ip ips.IPPort) bool {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	for _, pinnedPeer := range n.pinnedPeers {
+		if pinnedPeer.Trusted && pinnedPeer.IP.IP.Equal(ip.IP) {
+			return true
+		}
+	}
+	return false
+}
+
 func (n *network) track(ip *ips.ClaimedIPPort) error {
+	if n.config.PrivateNode {
+		// A private node only dials its pinned peers, whose IPs are
+		// configured rather than learned.
+		return nil
+	}
+
 	// To avoid signature verification when the IP isn't needed, we
 	// optimistically filter out IPs. This can result in us not tracking an IP
 	// that we otherwise would have. This case can only happen if the node
@@ -794,6 +1000,7 @@ func (n *network) disconnectedFromConnecting(nodeID ids.NodeID) {
 func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	n.ipTracker.Disconnected(nodeID)
 	n.router.Disconnected(nodeID)
+	n.reputations.Disconnected(nodeID)
 
 	n.peersLock.Lock()
 	defer n.peersLock.Unlock()
@@ -801,7 +1008,11 @@ func (n *network) disconnectedFromConnected(peer peer.Peer, nodeID ids.NodeID) {
 	n.connectedPeers.Remove(nodeID)
 
 	// The peer that is disconnecting from us finished the handshake
-	if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection {
+	if pinnedPeer, ok := n.pinnedPeers[nodeID]; ok {
+		tracked := newTrackedIP(pinnedPeer.IP)
+		n.trackedIPs[nodeID] = tracked
+		n.dial(nodeID, tracked)
+	} else if ip, wantsConnection := n.ipTracker.GetIP(nodeID); wantsConnection && !n.config.PrivateNode {
 		tracked := newTrackedIP(ip.IPPort)
 		n.trackedIPs[nodeID] = tracked
 		n.dial(nodeID, tracked)
@@ -852,11 +1063,13 @@ func (n *network) dial(nodeID ids.NodeID, ip *trackedIP) {
 			}
 
 			n.peersLock.Lock()
+			_, pinned := n.pinnedPeers[nodeID]
 			// If we no longer desire a connect to nodeID, we should cleanup
 			// trackedIPs and this goroutine. This prevents a memory leak when
 			// the tracked nodeID leaves the validator set and is never able to
-			// be connected to.
-			if !n.ipTracker.WantsConnection(nodeID) {
+			// be connected to. A private node only desires connections
+			// to its pinned peers.
+			if !n.ipTracker.WantsConnection(nodeID) || (n.config.PrivateNode && !pinned) {
 				// Typically [n.trackedIPs[nodeID]] will already equal [ip], but
 				// the reference to [ip] is refreshed to avoid any potential
 				// race conditions before removing the entry.
@@ -893,16 +1106,23 @@ func (n *network) dial(nodeID ids.NodeID, ip *trackedIP) {
 				n.config.MaxReconnectDelay,
 			)
 
+			// A banned peer's connection would be dropped after the upgrade,
+			// so we wait for the ban to expire before dialing it again.
+			if n.reputations.IsBanned(nodeID) {
+				continue
+			}
+
 			// If the network is configured to disallow private IPs and the
 			// provided IP is private, we skip all attempts to initiate a
-			// connection.
+			// connection. Pinned peers were explicitly configured, so they are
+			// always dialed.
 			//
 			// Invariant: We perform this check inside of the looping goroutine
 			// because this goroutine must clean up the trackedIPs entry if
 			// nodeID leaves the validator set. This is why we continue the loop
 			// rather than returning even though we will never initiate an
 			// outbound connection with this IP.
-			if !n.config.AllowPrivateIPs && ip.ip.IP.IsPrivate() {
+			if !n.config.AllowPrivateIPs && !pinned && ip.ip.IP.IsPrivate() {
 				n.peerConfig.Log.Verbo("skipping connection dial",
 					zap.String("reason", "outbound connections to private IPs are prohibited"),
 					zap.Stringer("nodeID", nodeID),
@@ -1058,14 +1278,44 @@ func (n *network) upgrade(conn net.Conn, upgrader peer.Upgrader) error {
 	return nil
 }
 
-func (n *network) PeerInfo(nodeIDs []ids.NodeID) []peer.Info {
+func (n *network) KnownPeerIPs() []*ips.ClaimedIPPort {
+	return n.ipTracker.GetIPs()
+}
+
+func (n *network) PeerInfo(nodeIDs []ids.NodeID, detailed bool) []peer.Info {
 	n.peersLock.RLock()
 	defer n.peersLock.RUnlock()
 
+	var peerInfo []peer.Info
 	if len(nodeIDs) == 0 {
-		return n.connectedPeers.AllInfo()
+		peerInfo = n.connectedPeers.AllInfo()
+	} else {
+		peerInfo = n.connectedPeers.Info(nodeIDs)
 	}
-	return n.connectedPeers.Info(nodeIDs)
+	for i := range peerInfo {
+		peerInfo[i].Score = n.reputations.Score(peerInfo[i].ID)
+		if !detailed {
+			continue
+		}
+		if peer, ok := n.connectedPeers.GetByID(peerInfo[i].ID); ok {
+			bandwidth := peer.Bandwidth()
+			peerInfo[i].Bandwidth = &bandwidth
+		}
+	}
+	return peerInfo
+}
+
+// peerBandwidths returns the bandwidth consumed by each connected peer.
+func (n *network) peerBandwidths() map[ids.NodeID]peer.Bandwidth {
+	n.peersLock.RLock()
+	defer n.peersLock.RUnlock()
+
+	bandwidths := make(map[ids.NodeID]peer.Bandwidth, n.connectedPeers.Len())
+	for i := 0; i < n.connectedPeers.Len(); i++ {
+		peer, _ := n.connectedPeers.GetByIndex(i)
+		bandwidths[peer.ID()] = peer.Bandwidth()
+	}
+	return bandwidths
 }
 
 func (n *network) StartClose() {
@@ -1078,6 +1328,8 @@ func (n *network) StartClose() {
 			)
 		}
 
+		n.persistAddressBook()
+
 		n.peersLock.Lock()
 		defer n.peersLock.Unlock()
 
@@ -1161,12 +1413,23 @@ func (n *network) runTimers() {
 	pullGossipPeerlists := time.NewTicker(n.config.PeerListPullGossipFreq)
 	resetPeerListBloom := time.NewTicker(n.config.PeerListBloomResetFreq)
 	updateUptimes := time.NewTicker(n.config.UptimeMetricFreq)
+	pruneReputations := time.NewTicker(reputationPruneFrequency)
 	defer func() {
 		pushGossipPeerlists.Stop()
 		resetPeerListBloom.Stop()
 		updateUptimes.Stop()
+		pruneReputations.Stop()
 	}()
 
+	// If the address book is disabled, [syncAddressBook] is left nil so that
+	// it never fires.
+	var syncAddressBook <-chan time.Time
+	if n.addressBook != nil {
+		syncAddressBookTicker := time.NewTicker(n.config.AddressBookSyncFreq)
+		defer syncAddressBookTicker.Stop()
+		syncAddressBook = syncAddressBookTicker.C
+	}
+
 	for {
 		select {
 		case <-n.onCloseCtx.Done():
@@ -1183,6 +1446,10 @@ func (n *network) runTimers() {
 			} else {
 				n.peerConfig.Log.Debug("reset ip tracker bloom filter")
 			}
+		case <-syncAddressBook:
+			n.persistAddressBook()
+		case <-pruneReputations.C:
+			n.reputations.Prune()
 		case <-updateUptimes.C:
 			primaryUptime, err := n.NodeUptime(constants.PrimaryNetworkID)
 			if err != nil {
@@ -1209,6 +1476,60 @@ func (n *network) runTimers() {
 	}
 }
 
+// loadAddressBook attempts to connect to the validator IPs that were persisted
+// before the last restart. IPs with invalid signatures are evicted.
+func (n *network) loadAddressBook() {
+	if n.addressBook == nil {
+		return
+	}
+
+	n.addressBookLock.Lock()
+	defer n.addressBookLock.Unlock()
+
+	claimedIPs, err := n.addressBook.Load(n.peerConfig.Clock.Time())
+	if err != nil {
+		n.peerConfig.Log.Error("failed to load address book",
+			zap.Error(err),
+		)
+		return
+	}
+
+	for _, ip := range claimedIPs {
+		if err := n.track(ip); err != nil {
+			n.peerConfig.Log.Debug("evicting invalid IP from address book",
+				zap.Stringer("nodeID", ip.NodeID),
+				zap.Error(err),
+			)
+			if err := n.addressBook.Remove(ip.NodeID); err != nil {
+				n.peerConfig.Log.Error("failed to evict IP from address book",
+					zap.Stringer("nodeID", ip.NodeID),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+	n.peerConfig.Log.Info("loaded address book",
+		zap.Int("numIPs", len(claimedIPs)),
+	)
+}
+
+// persistAddressBook writes the most recently known validator IPs to the
+// address book.
+func (n *network) persistAddressBook() {
+	if n.addressBook == nil {
+		return
+	}
+
+	n.addressBookLock.Lock()
+	defer n.addressBookLock.Unlock()
+
+	if err := n.addressBook.Put(n.ipTracker.GetIPs(), n.peerConfig.Clock.Time()); err != nil {
+		n.peerConfig.Log.Error("failed to persist address book",
+			zap.Error(err),
+		)
+	}
+}
+
 // pushGossipPeerLists gossips validators to peers in the network
 func (n *network) pushGossipPeerLists() {
 	peers := n.samplePeers(
@@ -15,6 +15,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 
+	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/message"
 	"github.com/ava-labs/avalanchego/network/dialer"
@@ -27,6 +28,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/staking"
 	"github.com/ava-labs/avalanchego/subnets"
+	"github.com/ava-labs/avalanchego/utils/bloom"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
@@ -730,3 +732,479 @@ func TestAllowConnectionAsAValidator(t *testing.T) {
 	}
 	wg.Wait()
 }
+
+func TestPinnedPeers(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	networks := make([]*network, len(configs))
+	for i, config := range configs {
+		msgCreator := newMessageCreator(t)
+		registry := prometheus.NewRegistry()
+
+		config := config
+
+		// Neither node is a validator, so they would not otherwise connect.
+		config.Beacons = validators.NewManager()
+		config.Validators = validators.NewManager()
+		config.RequireValidatorToConnect = true
+
+		net, err := NewNetwork(
+			config,
+			msgCreator,
+			registry,
+			logging.NoLog{},
+			listeners[i],
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		networks[i] = net.(*network)
+	}
+
+	// Both nodes must pin each other for the connection to be allowed.
+	networks[0].AddPinnedPeer(PinnedPeer{
+		NodeID:  nodeIDs[1],
+		IP:      configs[1].MyIPPort.IPPort(),
+		Trusted: true,
+	})
+	networks[1].AddPinnedPeer(PinnedPeer{
+		NodeID: nodeIDs[0],
+		IP:     configs[0].MyIPPort.IPPort(),
+	})
+	require.True(networks[0].isTrustedIP(configs[1].MyIPPort.IPPort()))
+	require.False(networks[1].isTrustedIP(configs[0].MyIPPort.IPPort()))
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(networks))
+	for _, net := range networks {
+		go func(net Network) {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}(net)
+	}
+
+	isConnected := func(net *network, nodeID ids.NodeID) bool {
+		net.peersLock.RLock()
+		defer net.peersLock.RUnlock()
+
+		_, connected := net.connectedPeers.GetByID(nodeID)
+		return connected
+	}
+	require.Eventually(
+		func() bool {
+			return isConnected(networks[1], nodeIDs[0])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	// Unpinning a peer that is no longer desired closes the connection.
+	require.True(networks[1].RemovePinnedPeer(nodeIDs[0]))
+	require.False(networks[1].RemovePinnedPeer(nodeIDs[0]))
+	require.Eventually(
+		func() bool {
+			return !isConnected(networks[1], nodeIDs[0])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestPrivateNode(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 3)
+
+	var (
+		privateNode = 0
+		sentry      = 1
+		other       = 2
+	)
+
+	// The private node isn't staking, as private node mode can't be
+	// used by a staking validator.
+	vdrs := validators.NewManager()
+	for _, nodeID := range nodeIDs[sentry:] {
+		require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.GenerateTestID(), 1))
+	}
+	networks := make([]*network, len(configs))
+	for i, config := range configs {
+		msgCreator := newMessageCreator(t)
+		registry := prometheus.NewRegistry()
+
+		config := config
+
+		config.Beacons = validators.NewManager()
+		config.Validators = vdrs
+		switch i {
+		case privateNode:
+			config.PrivateNode = true
+			config.PinnedPeers = []PinnedPeer{{
+				NodeID: nodeIDs[sentry],
+				IP:     configs[sentry].MyIPPort.IPPort(),
+			}}
+		case sentry:
+			config.PinnedPeers = []PinnedPeer{{
+				NodeID: nodeIDs[privateNode],
+				IP:     configs[privateNode].MyIPPort.IPPort(),
+			}}
+		}
+
+		net, err := NewNetwork(
+			config,
+			msgCreator,
+			registry,
+			logging.NoLog{},
+			listeners[i],
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		networks[i] = net.(*network)
+	}
+
+	// The other validator attempts to connect to both nodes, but only the
+	// sentry allows the connection.
+	networks[other].ManuallyTrack(nodeIDs[privateNode], configs[privateNode].MyIPPort.IPPort())
+	networks[other].ManuallyTrack(nodeIDs[sentry], configs[sentry].MyIPPort.IPPort())
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(networks))
+	for _, net := range networks {
+		go func(net Network) {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}(net)
+	}
+
+	getPeer := func(net *network, nodeID ids.NodeID) (peer.Peer, bool) {
+		net.peersLock.RLock()
+		defer net.peersLock.RUnlock()
+
+		return net.connectedPeers.GetByID(nodeID)
+	}
+	require.Eventually(
+		func() bool {
+			_, sentryConnected := getPeer(networks[sentry], nodeIDs[privateNode])
+			_, otherConnected := getPeer(networks[sentry], nodeIDs[other])
+			return sentryConnected && otherConnected
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	// The private node doesn't reveal its IP, so the sentry never
+	// advertises it.
+	privatePeer, _ := getPeer(networks[sentry], nodeIDs[privateNode])
+	require.True(privatePeer.IP().IP.IsUnspecified())
+	gossipableIPs := networks[sentry].ipTracker.GetGossipableIPs(
+		ids.EmptyNodeID,
+		bloom.EmptyFilter,
+		nil,
+		len(nodeIDs),
+	)
+	for _, ip := range gossipableIPs {
+		require.NotEqual(nodeIDs[privateNode], ip.NodeID)
+	}
+
+	_, connected := getPeer(networks[privateNode], nodeIDs[other])
+	require.False(connected)
+	_, connected = getPeer(networks[other], nodeIDs[privateNode])
+	require.False(connected)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestPrivateNodeClosesWhenStaking(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	config := configs[0]
+	config.Beacons = validators.NewManager()
+	config.Validators = validators.NewManager()
+	config.PrivateNode = true
+	config.PinnedPeers = []PinnedPeer{{
+		NodeID: nodeIDs[1],
+		IP:     configs[1].MyIPPort.IPPort(),
+	}}
+
+	net, err := NewNetwork(
+		config,
+		newMessageCreator(t),
+		prometheus.NewRegistry(),
+		logging.NoLog{},
+		listeners[0],
+		dialer,
+		&testHandler{
+			InboundHandler: nil,
+			ConnectedF:     nil,
+			DisconnectedF:  nil,
+		},
+	)
+	require.NoError(err)
+
+	done := make(chan error)
+	go func() {
+		done <- net.Dispatch()
+	}()
+
+	// Becoming a validator closes the network, which shuts down the node.
+	require.NoError(config.Validators.AddStaker(constants.PrimaryNetworkID, nodeIDs[0], nil, ids.GenerateTestID(), 1))
+	select {
+	case err := <-done:
+		require.NoError(err)
+	case <-time.After(10 * time.Second):
+		require.FailNow("network wasn't closed")
+	}
+}
+
+func TestPenalizeBansPeer(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	networks := make([]*network, len(configs))
+	for i, config := range configs {
+		msgCreator := newMessageCreator(t)
+		registry := prometheus.NewRegistry()
+
+		config := config
+
+		config.Beacons = validators.NewManager()
+		config.Validators = validators.NewManager()
+		config.ReputationConfig = peer.ReputationConfig{
+			BanThreshold:  1.5 * peer.InvalidMessage.Penalty(),
+			ScoreHalflife: time.Hour,
+			BanDuration:   time.Hour,
+		}
+
+		net, err := NewNetwork(
+			config,
+			msgCreator,
+			registry,
+			logging.NoLog{},
+			listeners[i],
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		networks[i] = net.(*network)
+	}
+
+	networks[1].ManuallyTrack(nodeIDs[0], configs[0].MyIPPort.IPPort())
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(networks))
+	for _, net := range networks {
+		go func(net Network) {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}(net)
+	}
+
+	isConnected := func(net *network, nodeID ids.NodeID) bool {
+		net.peersLock.RLock()
+		defer net.peersLock.RUnlock()
+
+		_, connected := net.connectedPeers.GetByID(nodeID)
+		return connected
+	}
+	require.Eventually(
+		func() bool {
+			return isConnected(networks[0], nodeIDs[1])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	networks[0].Penalize(nodeIDs[1], peer.InvalidMessage)
+	require.InDelta(peer.InvalidMessage.Penalty(), networks[0].PeerInfo(nodeIDs[1:], false)[0].Score, 1)
+	// The bandwidth is only copied when it is requested.
+	require.Nil(networks[0].PeerInfo(nodeIDs[1:], false)[0].Bandwidth)
+	require.NotNil(networks[0].PeerInfo(nodeIDs[1:], true)[0].Bandwidth)
+	require.True(networks[0].AllowConnection(nodeIDs[1]))
+
+	// Crossing the threshold disconnects and bans the peer.
+	networks[0].Penalize(nodeIDs[1], peer.InvalidMessage)
+	require.False(networks[0].AllowConnection(nodeIDs[1]))
+	require.True(networks[0].reputations.IsIPBanned(net.IPv6loopback))
+	require.Eventually(
+		func() bool {
+			return !isConnected(networks[0], nodeIDs[1])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	for _, net := range networks {
+		net.StartClose()
+	}
+	wg.Wait()
+}
+
+func TestPenalizeDoesNotBanExemptPeers(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, _, configs := newTestNetwork(t, 1)
+
+	var (
+		validatorID = ids.GenerateTestNodeID()
+		pinnedID    = ids.GenerateTestNodeID()
+		config      = configs[0]
+	)
+	config.Beacons = validators.NewManager()
+	config.Validators = validators.NewManager()
+	require.NoError(config.Validators.AddStaker(constants.PrimaryNetworkID, validatorID, nil, ids.GenerateTestID(), 1))
+	config.PinnedPeers = []PinnedPeer{
+		{
+			NodeID: pinnedID,
+			IP:     ips.IPPort{IP: net.IPv4(10, 0, 0, 100), Port: 1},
+		},
+	}
+	config.ReputationConfig = peer.ReputationConfig{
+		BanThreshold:  peer.InvalidMessage.Penalty(),
+		ScoreHalflife: time.Hour,
+		BanDuration:   time.Hour,
+	}
+
+	net, err := NewNetwork(
+		config,
+		newMessageCreator(t),
+		prometheus.NewRegistry(),
+		logging.NoLog{},
+		listeners[0],
+		dialer,
+		&testHandler{
+			InboundHandler: nil,
+			ConnectedF:     nil,
+			DisconnectedF:  nil,
+		},
+	)
+	require.NoError(err)
+
+	for _, nodeID := range []ids.NodeID{validatorID, pinnedID} {
+		net.Penalize(nodeID, peer.InvalidMessage)
+		require.True(net.AllowConnection(nodeID))
+	}
+	net.StartClose()
+}
+
+func TestAddressBookRedialsAfterRestart(t *testing.T) {
+	require := require.New(t)
+
+	dialer, listeners, nodeIDs, configs := newTestNetwork(t, 2)
+
+	vdrs := validators.NewManager()
+	for _, nodeID := range nodeIDs {
+		require.NoError(vdrs.AddStaker(constants.PrimaryNetworkID, nodeID, nil, ids.GenerateTestID(), 1))
+	}
+
+	db := memdb.New()
+	newNetwork := func(config *Config, listener *testListener) *network {
+		config.Beacons = validators.NewManager()
+		config.Validators = vdrs
+		config.AddressBookDB = db
+		config.AddressBookMaxAge = time.Hour
+		config.AddressBookSyncFreq = time.Hour
+
+		net, err := NewNetwork(
+			config,
+			newMessageCreator(t),
+			prometheus.NewRegistry(),
+			logging.NoLog{},
+			listener,
+			dialer,
+			&testHandler{
+				InboundHandler: nil,
+				ConnectedF:     nil,
+				DisconnectedF:  nil,
+			},
+		)
+		require.NoError(err)
+		return net.(*network)
+	}
+
+	isConnected := func(net *network, nodeID ids.NodeID) bool {
+		net.peersLock.RLock()
+		defer net.peersLock.RUnlock()
+
+		_, connected := net.connectedPeers.GetByID(nodeID)
+		return connected
+	}
+
+	wg := sync.WaitGroup{}
+	dispatch := func(net Network) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			require.NoError(net.Dispatch())
+		}()
+	}
+
+	// The test dialer isn't thread safe, so the listener of the restarted
+	// node must be registered before any network starts dialing.
+	restartedIP, restartedListener := dialer.NewListener()
+
+	// The other node only accepts connections, so the restarted node can only
+	// reconnect by dialing the persisted IP.
+	other := newNetwork(configs[1], listeners[1])
+	dispatch(other)
+
+	net := newNetwork(configs[0], listeners[0])
+	net.ManuallyTrack(nodeIDs[1], configs[1].MyIPPort.IPPort())
+	dispatch(net)
+	require.Eventually(
+		func() bool {
+			return isConnected(net, nodeIDs[1])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+	require.Len(net.KnownPeerIPs(), 1)
+
+	// Closing the network persists the known IPs.
+	net.StartClose()
+
+	restartedConfig := *configs[0]
+	restartedConfig.MyIPPort = restartedIP
+	restarted := newNetwork(&restartedConfig, restartedListener)
+	dispatch(restarted)
+	require.Eventually(
+		func() bool {
+			return isConnected(restarted, nodeIDs[1])
+		},
+		10*time.Second,
+		50*time.Millisecond,
+	)
+
+	other.StartClose()
+	restarted.StartClose()
+	wg.Wait()
+}
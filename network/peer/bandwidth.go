@@ -0,0 +1,94 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"sync"
+
+	"github.com/ava-labs/avalanchego/message"
+	"github.com/ava-labs/avalanchego/utils/json"
+)
+
+// MessageBandwidth is the amount of traffic that was exchanged with a peer.
+type MessageBandwidth struct {
+	InboundBytes     json.Uint64 `json:"inboundBytes"`
+	OutboundBytes    json.Uint64 `json:"outboundBytes"`
+	InboundMessages  json.Uint64 `json:"inboundMessages"`
+	OutboundMessages json.Uint64 `json:"outboundMessages"`
+}
+
+// Bandwidth is the traffic that was exchanged with a peer over the lifetime of
+// its connection.
+type Bandwidth struct {
+	// Total is the traffic of all message types.
+	Total MessageBandwidth `json:"total"`
+	// Ops maps the name of each message type that was exchanged to its
+	// traffic.
+	Ops map[string]MessageBandwidth `json:"ops"`
+}
+
+// bandwidthTracker counts the messages and bytes exchanged with a peer, broken
+// down by message type.
+type bandwidthTracker struct {
+	lock sync.Mutex
+	// total and ops are the traffic exchanged so far. Modifying them requires
+	// holding [lock].
+	total MessageBandwidth
+	ops   map[message.Op]*MessageBandwidth
+}
+
+func newBandwidthTracker() *bandwidthTracker {
+	return &bandwidthTracker{
+		ops: make(map[message.Op]*MessageBandwidth),
+	}
+}
+
+// Received records that a message of type [op] of [numBytes] was received.
+func (b *bandwidthTracker) Received(op message.Op, numBytes uint32) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	opBandwidth := b.get(op)
+	opBandwidth.InboundBytes += json.Uint64(numBytes)
+	opBandwidth.InboundMessages++
+	b.total.InboundBytes += json.Uint64(numBytes)
+	b.total.InboundMessages++
+}
+
+// Sent records that a message of type [op] of [numBytes] was sent.
+func (b *bandwidthTracker) Sent(op message.Op, numBytes uint32) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	opBandwidth := b.get(op)
+	opBandwidth.OutboundBytes += json.Uint64(numBytes)
+	opBandwidth.OutboundMessages++
+	b.total.OutboundBytes += json.Uint64(numBytes)
+	b.total.OutboundMessages++
+}
+
+// Bandwidth returns a snapshot of the traffic exchanged so far.
+func (b *bandwidthTracker) Bandwidth() Bandwidth {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	ops := make(map[string]MessageBandwidth, len(b.ops))
+	for op, opBandwidth := range b.ops {
+		ops[op.String()] = *opBandwidth
+	}
+	return Bandwidth{
+		Total: b.total,
+		Ops:   ops,
+	}
+}
+
+// Assumes [b.lock] is held.
+func (b *bandwidthTracker) get(op message.Op) *MessageBandwidth {
+	opBandwidth, ok := b.ops[op]
+	if !ok {
+		opBandwidth = &MessageBandwidth{}
+		b.ops[op] = opBandwidth
+	}
+	return opBandwidth
+}
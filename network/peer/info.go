@@ -23,4 +23,10 @@ type Info struct {
 	TrackedSubnets        set.Set[ids.ID]        `json:"trackedSubnets"`
 	SupportedACPs         set.Set[uint32]        `json:"supportedACPs"`
 	ObjectedACPs          set.Set[uint32]        `json:"objectedACPs"`
+	// Score is the peer's accumulated misbehavior. 0 means the peer hasn't
+	// misbehaved recently.
+	Score float64 `json:"score"`
+	// Bandwidth is the traffic exchanged with the peer over the lifetime of
+	// the connection. Only populated if detailed info was requested.
+	Bandwidth *Bandwidth `json:"bandwidth,omitempty"`
 }
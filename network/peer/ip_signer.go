@@ -5,6 +5,7 @@ package peer
 
 import (
 	"crypto"
+	"net"
 	"sync"
 
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
@@ -18,6 +19,8 @@ type IPSigner struct {
 	clock     mockable.Clock
 	tlsSigner crypto.Signer
 	blsSigner *bls.SecretKey
+	// If true, the host of [ip] is never signed
+	private bool
 
 	// Must be held while accessing [signedIP]
 	signedIPLock sync.RWMutex
@@ -38,6 +41,19 @@ func NewIPSigner(
 	}
 }
 
+// NewPrivateIPSigner returns an IPSigner that never reveals the host of [ip].
+// The unspecified IP is signed in its place, which peers will not advertise.
+// The port is still signed, as peers require it to be set.
+func NewPrivateIPSigner(
+	ip ips.DynamicIPPort,
+	tlsSigner crypto.Signer,
+	blsSigner *bls.SecretKey,
+) *IPSigner {
+	signer := NewIPSigner(ip, tlsSigner, blsSigner)
+	signer.private = true
+	return signer
+}
+
 // GetSignedIP returns the signedIP of the current value of the provided
 // dynamicIP. If the dynamicIP hasn't changed since the prior call to
 // GetSignedIP, then the same [SignedIP] will be returned.
@@ -50,6 +66,9 @@ func (s *IPSigner) GetSignedIP() (*SignedIP, error) {
 	signedIP := s.signedIP
 	s.signedIPLock.RUnlock()
 	ip := s.ip.IPPort()
+	if s.private {
+		ip.IP = net.IPv6unspecified
+	}
 	if signedIP != nil && signedIP.IPPort.Equal(ip) {
 		return signedIP, nil
 	}
@@ -56,3 +56,27 @@ func TestIPSigner(t *testing.T) {
 	require.Equal(uint64(11), signedIP3.Timestamp)
 	require.NotEqual(signedIP2.TLSSignature, signedIP3.TLSSignature)
 }
+
+func TestPrivateIPSigner(t *testing.T) {
+	require := require.New(t)
+
+	dynIP := ips.NewDynamicIPPort(
+		net.IPv4(1, 2, 3, 4),
+		9651,
+	)
+
+	tlsCert, err := staking.NewTLSCert()
+	require.NoError(err)
+
+	tlsKey := tlsCert.PrivateKey.(crypto.Signer)
+	blsKey, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	s := NewPrivateIPSigner(dynIP, tlsKey, blsKey)
+
+	signedIP, err := s.GetSignedIP()
+	require.NoError(err)
+	require.True(signedIP.IP.IsUnspecified())
+	require.Equal(uint16(9651), signedIP.Port)
+	require.NoError(signedIP.Verify(staking.CertificateFromX509(tlsCert.Leaf), time.Now()))
+}
@@ -29,6 +29,11 @@ type Network interface {
 	// for a given [Peer] object.
 	Disconnected(peerID ids.NodeID)
 
+	// Penalize lowers the reputation of the peer for committing [offense].
+	// If the peer's reputation falls too low, the peer is disconnected and
+	// banned.
+	Penalize(peerID ids.NodeID, offense Offense)
+
 	// KnownPeers returns the bloom filter of the known peers.
 	KnownPeers() (bloomFilter []byte, salt []byte)
 
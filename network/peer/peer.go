@@ -74,6 +74,10 @@ type Peer interface {
 	// handshake. It should only be called after [Ready] returns true.
 	IP() *SignedIP
 
+	// RemoteIP returns the IP address the peer is connected from, or nil if it
+	// couldn't be determined.
+	RemoteIP() net.IP
+
 	// Version returns the claimed node version this peer is running. It should
 	// only be called after [Ready] returns true.
 	Version() *version.Application
@@ -87,6 +91,10 @@ type Peer interface {
 	// [Ready] returns true.
 	ObservedUptime(subnetID ids.ID) (uint32, bool)
 
+	// Bandwidth returns the messages and bytes exchanged with the peer, broken
+	// down by message type.
+	Bandwidth() Bandwidth
+
 	// Send attempts to send [msg] to the peer. The peer takes ownership of
 	// [msg] for reference counting. This returns false if the message is
 	// guaranteed not to be delivered to the peer.
@@ -156,6 +164,9 @@ type peer struct {
 	// Subnet ID --> Our uptime for the given subnet as perceived by the peer
 	observedUptimes map[ids.ID]uint32
 
+	// bandwidth tracks the traffic exchanged with this peer.
+	bandwidth *bandwidthTracker
+
 	// True if this peer has sent us a valid Handshake message and
 	// is running a compatible version.
 	// Only modified on the connection's reader routine.
@@ -219,6 +230,7 @@ func Start(
 		onClosingCtxCancel: onClosingCtxCancel,
 		onClosed:           make(chan struct{}),
 		observedUptimes:    make(map[ids.ID]uint32),
+		bandwidth:          newBandwidthTracker(),
 		peerListChan:       make(chan struct{}, 1),
 		getPeerListChan:    make(chan struct{}, 1),
 	}
@@ -307,6 +319,18 @@ func (p *peer) IP() *SignedIP {
 	return p.ip
 }
 
+func (p *peer) RemoteIP() net.IP {
+	ip, err := ips.ToIPPort(p.conn.RemoteAddr().String())
+	if err != nil {
+		return nil
+	}
+	return ip.IP
+}
+
+func (p *peer) Bandwidth() Bandwidth {
+	return p.bandwidth.Bandwidth()
+}
+
 func (p *peer) Version() *version.Application {
 	return p.version
 }
@@ -424,6 +448,7 @@ func (p *peer) readMessages() {
 				zap.Stringer("nodeID", p.id),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, ThrottleViolation)
 			return
 		}
 
@@ -496,6 +521,7 @@ func (p *peer) readMessages() {
 			)
 
 			p.Metrics.FailedToParse.Inc()
+			p.Network.Penalize(p.id, ParseFailure)
 
 			// Couldn't parse the message. Read the next one.
 			onFinishedHandling()
@@ -506,6 +532,7 @@ func (p *peer) readMessages() {
 		now := p.Clock.Time()
 		p.storeLastReceived(now)
 		p.Metrics.Received(msg, msgLen)
+		p.bandwidth.Received(msg.Op(), msgLen)
 
 		// Handle the message. Note that when we are done handling this message,
 		// we must call [msg.OnFinishedHandling()].
@@ -643,6 +670,7 @@ func (p *peer) writeMessage(writer io.Writer, msg message.OutboundMessage) {
 	now := p.Clock.Time()
 	p.storeLastSent(now)
 	p.Metrics.Sent(msg)
+	p.bandwidth.Sent(msg.Op(), msgLen)
 }
 
 func (p *peer) sendNetworkMessages() {
@@ -905,6 +933,7 @@ func (p *peer) observeUptimes(primaryUptime uint32, subnetUptimes []*p2p.SubnetU
 			zap.Stringer("subnetID", constants.PrimaryNetworkID),
 			zap.Uint32("uptime", primaryUptime),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -917,6 +946,7 @@ func (p *peer) observeUptimes(primaryUptime uint32, subnetUptimes []*p2p.SubnetU
 				zap.Stringer("nodeID", p.id),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -937,6 +967,7 @@ func (p *peer) observeUptimes(primaryUptime uint32, subnetUptimes []*p2p.SubnetU
 				zap.Stringer("subnetID", subnetID),
 				zap.Uint32("uptime", uptime),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1012,6 +1043,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.Stringer("nodeID", p.id),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1040,6 +1072,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.Stringer("nodeID", p.id),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1068,6 +1101,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.Reflect("supportedACPs", p.supportedACPs),
 			zap.Reflect("objectedACPs", p.objectedACPs),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -1086,6 +1120,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "KnownPeers.Filter"),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1098,6 +1133,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("field", "KnownPeers.Salt"),
 				zap.Int("saltLen", saltLen),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1111,6 +1147,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "IP"),
 			zap.Int("ipLen", ipLen),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -1121,6 +1158,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			zap.String("field", "Port"),
 			zap.Uint32("port", msg.IpPort),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -1155,6 +1193,11 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 			)
 		}
 
+		// Like the clock skew check above, a signing time that is too far in
+		// the future isn't an offense, as honest peers may be out of sync.
+		if !errors.Is(err, errTimestampTooFarInFuture) {
+			p.Network.Penalize(p.id, InvalidMessage)
+		}
 		p.StartClose()
 		return
 	}
@@ -1168,6 +1211,7 @@ func (p *peer) handleHandshake(msg *p2p.Handshake) {
 				zap.String("signatureType", "bls"),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1227,6 +1271,7 @@ func (p *peer) handleGetPeerList(msg *p2p.GetPeerList) {
 			zap.String("field", "KnownPeers.Filter"),
 			zap.Error(err),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -1239,6 +1284,7 @@ func (p *peer) handleGetPeerList(msg *p2p.GetPeerList) {
 			zap.String("field", "KnownPeers.Salt"),
 			zap.Int("saltLen", saltLen),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 		return
 	}
@@ -1303,6 +1349,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 				zap.String("field", "Cert"),
 				zap.Error(err),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1315,6 +1362,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 				zap.String("field", "IP"),
 				zap.Int("ipLen", ipLen),
 			)
+			p.Network.Penalize(p.id, InvalidMessage)
 			p.StartClose()
 			return
 		}
@@ -1347,6 +1395,7 @@ func (p *peer) handlePeerList(msg *p2p.PeerList) {
 			zap.String("field", "claimedIP"),
 			zap.Error(err),
 		)
+		p.Network.Penalize(p.id, InvalidMessage)
 		p.StartClose()
 	}
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/ips"
+	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/math/meter"
 	"github.com/ava-labs/avalanchego/utils/resource"
@@ -270,6 +271,48 @@ func TestSend(t *testing.T) {
 	require.NoError(peer1.AwaitClosed(context.Background()))
 }
 
+func TestSendTracksBandwidth(t *testing.T) {
+	require := require.New(t)
+
+	peer0, peer1 := makeReadyTestPeers(t, set.Set[ids.ID]{})
+	mc := newMessageCreator(t)
+
+	outboundGetMsg, err := mc.Get(ids.Empty, 1, time.Second, ids.Empty, p2p.EngineType_ENGINE_TYPE_SNOWMAN)
+	require.NoError(err)
+	msgLen := json.Uint64(len(outboundGetMsg.Bytes()))
+
+	require.True(peer0.Send(context.Background(), outboundGetMsg))
+	<-peer1.inboundMsgChan
+
+	expectedBandwidth := MessageBandwidth{
+		InboundBytes:    msgLen,
+		InboundMessages: 1,
+	}
+	require.Equal(expectedBandwidth, peer1.Bandwidth().Ops[message.GetOp.String()])
+
+	// The sender records the message once it has been fully written, which
+	// may happen after the receiver has read it.
+	expectedBandwidth = MessageBandwidth{
+		OutboundBytes:    msgLen,
+		OutboundMessages: 1,
+	}
+	require.Eventually(
+		func() bool {
+			return peer0.Bandwidth().Ops[message.GetOp.String()] == expectedBandwidth
+		},
+		10*time.Second,
+		10*time.Millisecond,
+	)
+
+	bandwidth := peer1.Bandwidth()
+	require.GreaterOrEqual(bandwidth.Total.InboundBytes, msgLen)
+	require.Greater(bandwidth.Total.InboundMessages, json.Uint64(1))
+
+	peer1.StartClose()
+	require.NoError(peer0.AwaitClosed(context.Background()))
+	require.NoError(peer1.AwaitClosed(context.Background()))
+}
+
 func TestPingUptimes(t *testing.T) {
 	trackedSubnetID := ids.GenerateTestID()
 	untrackedSubnetID := ids.GenerateTestID()
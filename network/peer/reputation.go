@@ -0,0 +1,256 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"math"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
+)
+
+// Offense is a type of misbehavior that lowers a peer's reputation.
+//
+// Consensus responses that arrive after their request timed out are
+// intentionally not offenses, as they are expected from slow but honest peers.
+type Offense uint8
+
+const (
+	// ParseFailure is a message that couldn't be parsed.
+	ParseFailure Offense = iota
+	// InvalidMessage is a message with an invalid field or signature.
+	InvalidMessage
+	// Benched is the peer being benched on a chain for failing to respond to
+	// requests.
+	Benched
+	// ThrottleViolation is a message that exceeds the allowed inbound size.
+	ThrottleViolation
+	// HandlerError is a message that a chain's handler dropped because one of
+	// its fields is invalid.
+	HandlerError
+	// UnrequestedAppResponse is an app response to a request that was never
+	// sent or that already timed out. The penalty is small so that only peers
+	// that send many of them are banned.
+	UnrequestedAppResponse
+)
+
+// penalties is the amount a peer's score is increased by for each offense.
+var penalties = [...]float64{
+	ParseFailure:           10,
+	InvalidMessage:         25,
+	Benched:                5,
+	ThrottleViolation:      25,
+	HandlerError:           10,
+	UnrequestedAppResponse: 1,
+}
+
+// negligibleScore is the score below which a peer is considered to have no
+// recent offenses, at which point its score is no longer tracked.
+const negligibleScore = 0.01
+
+func (o Offense) String() string {
+	switch o {
+	case ParseFailure:
+		return "parse failure"
+	case InvalidMessage:
+		return "invalid message"
+	case Benched:
+		return "benched"
+	case ThrottleViolation:
+		return "throttle violation"
+	case HandlerError:
+		return "handler error"
+	case UnrequestedAppResponse:
+		return "unrequested app response"
+	default:
+		return "unknown offense"
+	}
+}
+
+// Penalty returns the amount a peer's score is increased by when it commits
+// this offense.
+func (o Offense) Penalty() float64 {
+	if int(o) >= len(penalties) {
+		return 0
+	}
+	return penalties[o]
+}
+
+type ReputationConfig struct {
+	// BanThreshold is the score at which a peer is disconnected and banned.
+	// If 0, peers are never banned. Peers that may not be banned still have
+	// their score tracked.
+	BanThreshold float64 `json:"banThreshold"`
+
+	// ScoreHalflife is the halflife of a peer's score. Larger values mean that
+	// offenses are remembered for longer. Should be > 0.
+	ScoreHalflife time.Duration `json:"scoreHalflife"`
+
+	// BanDuration is how long the nodeID and IP of a banned peer are refused.
+	BanDuration time.Duration `json:"banDuration"`
+}
+
+type score struct {
+	value       float64
+	lastUpdated time.Time
+}
+
+// Reputations tracks the misbehavior of peers. Every offense increases the
+// offending peer's score, which decays exponentially over time. Once a peer's
+// score reaches the ban threshold, its nodeID and IP are banned.
+type Reputations struct {
+	config ReputationConfig
+	clock  mockable.Clock
+
+	lock        sync.Mutex
+	scores      map[ids.NodeID]*score
+	bannedNodes map[ids.NodeID]time.Time
+	bannedIPs   map[string]time.Time
+}
+
+func NewReputations(config ReputationConfig) *Reputations {
+	return &Reputations{
+		config:      config,
+		scores:      make(map[ids.NodeID]*score),
+		bannedNodes: make(map[ids.NodeID]time.Time),
+		bannedIPs:   make(map[string]time.Time),
+	}
+}
+
+// Penalize records that [nodeID], connected from [ip], committed [offense].
+// [ip] may be nil if it isn't known. If [canBan] is false, the peer's score is
+// still increased but the peer is never banned. Returns true if this offense
+// caused the peer to be banned.
+func (r *Reputations) Penalize(nodeID ids.NodeID, ip net.IP, offense Offense, canBan bool) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Time()
+	if r.isBanned(nodeID, now) {
+		// The peer should already be disconnected, so there is no need to
+		// track any offenses that were in flight.
+		return false
+	}
+
+	s, ok := r.scores[nodeID]
+	if !ok {
+		s = &score{}
+		r.scores[nodeID] = s
+	}
+	s.value = r.decay(s, now) + offense.Penalty()
+	s.lastUpdated = now
+
+	if !canBan || r.config.BanThreshold <= 0 || s.value < r.config.BanThreshold {
+		return false
+	}
+
+	bannedUntil := now.Add(r.config.BanDuration)
+	r.bannedNodes[nodeID] = bannedUntil
+	if ip != nil {
+		r.bannedIPs[ip.String()] = bannedUntil
+	}
+	// The peer starts over once its ban expires.
+	delete(r.scores, nodeID)
+	return true
+}
+
+// Score returns the current score of [nodeID]. A score of 0 means the peer
+// hasn't misbehaved recently.
+func (r *Reputations) Score(nodeID ids.NodeID) float64 {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s, ok := r.scores[nodeID]
+	if !ok {
+		return 0
+	}
+	return r.decay(s, r.clock.Time())
+}
+
+// Disconnected stops tracking the score of [nodeID]. If [nodeID] is banned, it
+// remains banned.
+func (r *Reputations) Disconnected(nodeID ids.NodeID) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.scores, nodeID)
+}
+
+// Prune removes the bans that have expired and the scores that have decayed to
+// a negligible value, so that peers that no longer misbehave aren't tracked.
+func (r *Reputations) Prune() {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Time()
+	for nodeID, s := range r.scores {
+		if r.decay(s, now) < negligibleScore {
+			delete(r.scores, nodeID)
+		}
+	}
+	for nodeID, bannedUntil := range r.bannedNodes {
+		if !now.Before(bannedUntil) {
+			delete(r.bannedNodes, nodeID)
+		}
+	}
+	for ip, bannedUntil := range r.bannedIPs {
+		if !now.Before(bannedUntil) {
+			delete(r.bannedIPs, ip)
+		}
+	}
+}
+
+// IsBanned returns true if [nodeID] is currently banned.
+func (r *Reputations) IsBanned(nodeID ids.NodeID) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.isBanned(nodeID, r.clock.Time())
+}
+
+// IsIPBanned returns true if [ip] is currently banned.
+func (r *Reputations) IsIPBanned(ip net.IP) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := ip.String()
+	bannedUntil, ok := r.bannedIPs[key]
+	if !ok {
+		return false
+	}
+	if !r.clock.Time().Before(bannedUntil) {
+		delete(r.bannedIPs, key)
+		return false
+	}
+	return true
+}
+
+// Assumes [r.lock] is held.
+func (r *Reputations) isBanned(nodeID ids.NodeID, now time.Time) bool {
+	bannedUntil, ok := r.bannedNodes[nodeID]
+	if !ok {
+		return false
+	}
+	if !now.Before(bannedUntil) {
+		delete(r.bannedNodes, nodeID)
+		return false
+	}
+	return true
+}
+
+// decay returns the value of [s] at [now].
+func (r *Reputations) decay(s *score, now time.Time) float64 {
+	if r.config.ScoreHalflife <= 0 {
+		return s.value
+	}
+	elapsed := now.Sub(s.lastUpdated)
+	if elapsed <= 0 {
+		return s.value
+	}
+	halflives := float64(elapsed) / float64(r.config.ScoreHalflife)
+	return s.value * math.Exp2(-halflives)
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package peer
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestReputationsScoreDecays(t *testing.T) {
+	require := require.New(t)
+
+	r := NewReputations(ReputationConfig{
+		ScoreHalflife: time.Minute,
+	})
+	r.clock.Set(time.Unix(0, 0))
+
+	nodeID := ids.GenerateTestNodeID()
+	require.Zero(r.Score(nodeID))
+
+	require.False(r.Penalize(nodeID, nil, InvalidMessage, true))
+	require.Equal(InvalidMessage.Penalty(), r.Score(nodeID))
+
+	r.clock.Set(time.Unix(60, 0))
+	require.Equal(InvalidMessage.Penalty()/2, r.Score(nodeID))
+
+	require.False(r.Penalize(nodeID, nil, InvalidMessage, true))
+	require.Equal(3*InvalidMessage.Penalty()/2, r.Score(nodeID))
+
+	// Without a ban threshold, peers are never banned.
+	require.False(r.IsBanned(nodeID))
+}
+
+func TestReputationsBan(t *testing.T) {
+	require := require.New(t)
+
+	r := NewReputations(ReputationConfig{
+		BanThreshold:  2 * ParseFailure.Penalty(),
+		ScoreHalflife: time.Hour,
+		BanDuration:   time.Minute,
+	})
+	r.clock.Set(time.Unix(0, 0))
+
+	nodeID := ids.GenerateTestNodeID()
+	ip := net.IPv4(1, 2, 3, 4)
+
+	require.False(r.Penalize(nodeID, ip, ParseFailure, true))
+	require.False(r.IsBanned(nodeID))
+	require.False(r.IsIPBanned(ip))
+
+	require.True(r.Penalize(nodeID, ip, ParseFailure, true))
+	require.True(r.IsBanned(nodeID))
+	require.True(r.IsIPBanned(ip))
+	require.Zero(r.Score(nodeID))
+
+	// Offenses while banned are ignored.
+	require.False(r.Penalize(nodeID, ip, ParseFailure, true))
+	require.Zero(r.Score(nodeID))
+
+	r.clock.Set(time.Unix(60, 0))
+	require.False(r.IsBanned(nodeID))
+	require.False(r.IsIPBanned(ip))
+}
+
+func TestReputationsExemptPeersAreNotBanned(t *testing.T) {
+	require := require.New(t)
+
+	r := NewReputations(ReputationConfig{
+		BanThreshold:  ParseFailure.Penalty(),
+		ScoreHalflife: time.Hour,
+		BanDuration:   time.Minute,
+	})
+	r.clock.Set(time.Unix(0, 0))
+
+	nodeID := ids.GenerateTestNodeID()
+	ip := net.IPv4(1, 2, 3, 4)
+
+	require.False(r.Penalize(nodeID, ip, ParseFailure, false))
+	require.False(r.IsBanned(nodeID))
+	require.False(r.IsIPBanned(ip))
+	require.Equal(ParseFailure.Penalty(), r.Score(nodeID))
+}
+
+func TestReputationsPrune(t *testing.T) {
+	require := require.New(t)
+
+	r := NewReputations(ReputationConfig{
+		BanThreshold:  ParseFailure.Penalty(),
+		ScoreHalflife: time.Minute,
+		BanDuration:   time.Minute,
+	})
+	r.clock.Set(time.Unix(0, 0))
+
+	var (
+		bannedID    = ids.GenerateTestNodeID()
+		bannedIP    = net.IPv4(1, 2, 3, 4)
+		offenderID  = ids.GenerateTestNodeID()
+		recentID    = ids.GenerateTestNodeID()
+		unrelatedIP = net.IPv4(5, 6, 7, 8)
+	)
+	require.True(r.Penalize(bannedID, bannedIP, ParseFailure, true))
+	require.False(r.Penalize(offenderID, nil, ParseFailure, false))
+
+	// Nothing has expired yet.
+	r.Prune()
+	require.Len(r.bannedNodes, 1)
+	require.Len(r.bannedIPs, 1)
+	require.Len(r.scores, 1)
+
+	// Expired bans are removed even if they are never looked up, and scores
+	// that have decayed to a negligible value are no longer tracked.
+	r.clock.Set(time.Unix(0, 0).Add(time.Hour))
+	require.False(r.Penalize(recentID, unrelatedIP, UnrequestedAppResponse, false))
+	r.Prune()
+	require.Empty(r.bannedNodes)
+	require.Empty(r.bannedIPs)
+	require.Equal(map[ids.NodeID]*score{
+		recentID: {
+			value:       UnrequestedAppResponse.Penalty(),
+			lastUpdated: r.clock.Time(),
+		},
+	}, r.scores)
+
+	// Disconnected peers are no longer tracked.
+	r.Disconnected(recentID)
+	require.Empty(r.scores)
+}
@@ -25,6 +25,8 @@ func (testNetwork) Track([]*ips.ClaimedIPPort) error {
 
 func (testNetwork) Disconnected(ids.NodeID) {}
 
+func (testNetwork) Penalize(ids.NodeID, Offense) {}
+
 func (testNetwork) KnownPeers() ([]byte, []byte) {
 	return bloom.EmptyFilter.Marshal(), nil
 }
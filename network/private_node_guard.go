@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package network
+
+import (
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
+)
+
+var _ validators.SetCallbackListener = (*privateNodeGuard)(nil)
+
+// privateNodeGuard shuts down a private node that becomes a primary network
+// validator. Private nodes aren't validators: sentries don't relay consensus
+// messages, so a staking validator in this mode would appear offline to every
+// other validator and lose its uptime.
+type privateNodeGuard struct {
+	log      logging.Logger
+	myNodeID ids.NodeID
+	// onStaking is called when the local node is found in the validator set.
+	onStaking func()
+}
+
+func (g *privateNodeGuard) OnValidatorAdded(nodeID ids.NodeID, _ *bls.PublicKey, _ ids.ID, _ uint64) {
+	if nodeID != g.myNodeID {
+		return
+	}
+
+	g.log.Fatal("private nodes can't be validators, shutting down",
+		zap.Stringer("nodeID", nodeID),
+	)
+	g.onStaking()
+}
+
+func (*privateNodeGuard) OnValidatorRemoved(ids.NodeID, uint64) {}
+
+func (*privateNodeGuard) OnValidatorWeightChanged(ids.NodeID, uint64, uint64) {}
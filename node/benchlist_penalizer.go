@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package node
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/network"
+	"github.com/ava-labs/avalanchego/network/peer"
+	"github.com/ava-labs/avalanchego/snow/networking/benchlist"
+)
+
+var _ benchlist.Benchable = (*benchlistPenalizer)(nil)
+
+// benchlistPenalizer lowers the reputation of peers whenever they are benched,
+// so that peers which are repeatedly benched are visible in their score. Only
+// validators are benched, and validators are never banned, so being benched
+// never causes a peer to be disconnected.
+type benchlistPenalizer struct {
+	benchlist.Benchable
+	net network.Network
+}
+
+func (b *benchlistPenalizer) Benched(chainID ids.ID, nodeID ids.NodeID) {
+	b.Benchable.Benched(chainID, nodeID)
+	b.net.Penalize(nodeID, peer.Benched)
+}
@@ -28,6 +28,7 @@ import (
 type APIIndexerConfig struct {
 	IndexAPIEnabled      bool `json:"indexAPIEnabled"`
 	IndexAllowIncomplete bool `json:"indexAllowIncomplete"`
+	IndexBackfill        bool `json:"indexBackfill"`
 }
 
 type HTTPConfig struct {
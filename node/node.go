@@ -81,6 +81,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/rpcchainvm/runtime"
 
 	avmconfig "github.com/ava-labs/avalanchego/vms/avm/config"
+	vmindex "github.com/ava-labs/avalanchego/vms/components/index"
 	platformconfig "github.com/ava-labs/avalanchego/vms/platformvm/config"
 	coreth "github.com/ava-labs/coreth/plugin/evm"
 )
@@ -96,8 +97,9 @@ var (
 	genesisHashKey     = []byte("genesisID")
 	ungracefulShutdown = []byte("ungracefulShutdown")
 
-	indexerDBPrefix  = []byte{0x00}
-	keystoreDBPrefix = []byte("keystore")
+	indexerDBPrefix     = []byte{0x00}
+	keystoreDBPrefix    = []byte("keystore")
+	addressBookDBPrefix = []byte("address book")
 
 	errInvalidTLSKey = errors.New("invalid TLS key")
 	errShuttingDown  = errors.New("server shutting down")
@@ -227,6 +229,9 @@ func New(
 	if err := n.initVMs(); err != nil { // Initialize the VM registry.
 		return nil, fmt.Errorf("couldn't initialize VM registry: %w", err)
 	}
+	if err := n.initIndexer(); err != nil {
+		return nil, fmt.Errorf("couldn't initialize indexer: %w", err)
+	}
 	if err := n.initAdminAPI(); err != nil { // Start the Admin API
 		return nil, fmt.Errorf("couldn't initialize admin API: %w", err)
 	}
@@ -239,9 +244,6 @@ func New(
 	if err := n.initAPIAliases(n.Config.GenesisBytes); err != nil {
 		return nil, fmt.Errorf("couldn't initialize API aliases: %w", err)
 	}
-	if err := n.initIndexer(); err != nil {
-		return nil, fmt.Errorf("couldn't initialize indexer: %w", err)
-	}
 
 	n.health.Start(context.TODO(), n.Config.HealthCheckFreq)
 	n.initProfiler()
@@ -514,11 +516,6 @@ func (n *Node) initNetworking() error {
 		n.chainRouter = router.Trace(n.chainRouter, n.tracer)
 	}
 
-	// Configure benchlist
-	n.Config.BenchlistConfig.Validators = n.vdrs
-	n.Config.BenchlistConfig.Benchable = n.chainRouter
-	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
-
 	n.uptimeCalculator = uptime.NewLockedCalculator()
 
 	consensusRouter := n.chainRouter
@@ -596,6 +593,7 @@ func (n *Node) initNetworking() error {
 	n.Config.NetworkConfig.ResourceTracker = n.resourceTracker
 	n.Config.NetworkConfig.CPUTargeter = n.cpuTargeter
 	n.Config.NetworkConfig.DiskTargeter = n.diskTargeter
+	n.Config.NetworkConfig.AddressBookDB = prefixdb.New(addressBookDBPrefix, n.DB)
 
 	n.Net, err = network.NewNetwork(
 		&n.Config.NetworkConfig,
@@ -606,8 +604,18 @@ func (n *Node) initNetworking() error {
 		dialer.NewDialer(constants.NetworkType, n.Config.NetworkConfig.DialerConfig, n.Log),
 		consensusRouter,
 	)
+	if err != nil {
+		return err
+	}
 
-	return err
+	// Configure benchlist. Benched peers are also penalized by the network.
+	n.Config.BenchlistConfig.Validators = n.vdrs
+	n.Config.BenchlistConfig.Benchable = &benchlistPenalizer{
+		Benchable: n.chainRouter,
+		net:       n.Net,
+	}
+	n.benchlistManager = benchlist.NewManager(&n.Config.BenchlistConfig)
+	return nil
 }
 
 type NodeProcessContext struct {
@@ -836,6 +844,7 @@ func (n *Node) initIndexer() error {
 	n.indexer, err = indexer.NewIndexer(indexer.Config{
 		IndexingEnabled:      n.Config.IndexAPIEnabled,
 		AllowIncompleteIndex: n.Config.IndexAllowIncomplete,
+		Backfill:             n.Config.IndexBackfill,
 		DB:                   txIndexerDB,
 		Log:                  n.Log,
 		BlockAcceptorGroup:   n.BlockAcceptorGroup,
@@ -845,6 +854,10 @@ func (n *Node) initIndexer() error {
 		ShutdownF: func() {
 			n.Shutdown(0) // TODO put exit code here
 		},
+		ContainerParsers: map[ids.ID]vmindex.ContainerParserFactory{
+			constants.PlatformVMID: platformvm.NewContainerParser,
+			constants.AVMID:        avm.NewContainerParser,
+		},
 	})
 	if err != nil {
 		return fmt.Errorf("couldn't create index for txs: %w", err)
@@ -1039,6 +1052,9 @@ func (n *Node) initChainManager(avaxAssetID ids.ID) error {
 		n.Config.SybilProtectionEnabled,
 		n.Config.TrackedSubnets,
 		n.Shutdown,
+		func(nodeID ids.NodeID) {
+			n.Net.Penalize(nodeID, peer.UnrequestedAppResponse)
+		},
 		n.Config.RouterHealthConfig,
 		"requests",
 		n.MetricsRegisterer,
@@ -1264,7 +1280,7 @@ func (n *Node) initMetricsAPI() error {
 }
 
 // initAdminAPI initializes the Admin API service
-// Assumes n.log, n.chainManager, and n.ValidatorAPI already initialized
+// Assumes n.log, n.chainManager, n.indexer, and n.ValidatorAPI already initialized
 func (n *Node) initAdminAPI() error {
 	if !n.Config.AdminAPIEnabled {
 		n.Log.Info("skipping admin API initialization because it has been disabled")
@@ -1276,12 +1292,14 @@ func (n *Node) initAdminAPI() error {
 			Log:          n.Log,
 			DB:           n.DB,
 			ChainManager: n.chainManager,
+			Indexer:      n.indexer,
 			HTTPServer:   n.APIServer,
 			ProfileDir:   n.Config.ProfilerConfig.Dir,
 			LogFactory:   n.LogFactory,
 			NodeConfig:   n.Config,
 			VMManager:    n.VMManager,
 			VMRegistry:   n.VMRegistry,
+			Network:      n.Net,
 		},
 	)
 	if err != nil {
@@ -14,6 +14,8 @@ type FilterParam struct {
 	lock   sync.RWMutex
 	set    set.Set[string]
 	filter bloom.Filter
+	// all is true if every message should pass the filter
+	all bool
 }
 
 func NewFilterParam() *FilterParam {
@@ -28,6 +30,16 @@ func (f *FilterParam) NewSet() {
 
 	f.set = set.Set[string]{}
 	f.filter = nil
+	f.all = false
+}
+
+// SetAll makes every message pass the filter, until a new set or filter is
+// created.
+func (f *FilterParam) SetAll() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.all = true
 }
 
 func (f *FilterParam) Filter() bloom.Filter {
@@ -43,6 +55,7 @@ func (f *FilterParam) SetFilter(filter bloom.Filter) bloom.Filter {
 
 	f.filter = filter
 	f.set = nil
+	f.all = false
 	return f.filter
 }
 
@@ -50,6 +63,9 @@ func (f *FilterParam) Check(addr []byte) bool {
 	f.lock.RLock()
 	defer f.lock.RUnlock()
 
+	if f.all {
+		return true
+	}
 	if f.filter != nil && f.filter.Check(addr) {
 		return true
 	}
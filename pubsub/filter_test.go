@@ -11,6 +11,7 @@ import (
 	"github.com/ava-labs/avalanchego/api"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/pubsub/bloom"
+	"github.com/ava-labs/avalanchego/utils/cb58"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 )
@@ -37,6 +38,24 @@ func TestAddAddressesParseAddresses(t *testing.T) {
 	require.Equal(addrID[:], msg.addressIds[0])
 }
 
+func TestAddIDsParseIDs(t *testing.T) {
+	require := require.New(t)
+
+	id := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	msg := &AddIDs{IDs: []string{
+		id.String(),
+		nodeID.String(),
+	}}
+
+	require.NoError(msg.parseIDs())
+	require.Equal([][]byte{id[:], nodeID.Bytes()}, msg.idBytes)
+
+	msg = &AddIDs{IDs: []string{"invalid"}}
+	err := msg.parseIDs()
+	require.ErrorIs(err, cb58.ErrBase58Decoding)
+}
+
 func TestFilterParamUpdateMulti(t *testing.T) {
 	require := require.New(t)
 
@@ -71,6 +90,19 @@ func TestFilterParam(t *testing.T) {
 	require.False(fp.Check([]byte("bye")))
 }
 
+func TestFilterParamAll(t *testing.T) {
+	require := require.New(t)
+
+	fp := NewFilterParam()
+	require.False(fp.Check([]byte("hello")))
+
+	fp.SetAll()
+	require.True(fp.Check([]byte("hello")))
+
+	fp.NewSet()
+	require.False(fp.Check([]byte("hello")))
+}
+
 func TestNewBloom(t *testing.T) {
 	cm := &NewBloom{}
 	require.False(t, cm.IsParamsValid())
@@ -4,7 +4,10 @@
 package pubsub
 
 import (
+	"strings"
+
 	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 )
@@ -34,6 +37,17 @@ type AddAddresses struct {
 	addressIds [][]byte
 }
 
+// AddIDs command to add IDs, such as subnet IDs or node IDs
+type AddIDs struct {
+	IDs []string `json:"ids"`
+
+	// idBytes array of IDs, kept as a [][]byte for use in the bloom filter
+	idBytes [][]byte
+}
+
+// SubscribeAll command to receive every published message
+type SubscribeAll struct{}
+
 // Command execution command
 //
 // Deprecated: The pubsub server is deprecated.
@@ -41,6 +55,8 @@ type Command struct {
 	NewBloom     *NewBloom     `json:"newBloom,omitempty"`
 	NewSet       *NewSet       `json:"newSet,omitempty"`
 	AddAddresses *AddAddresses `json:"addAddresses,omitempty"`
+	AddIDs       *AddIDs       `json:"addIDs,omitempty"`
+	SubscribeAll *SubscribeAll `json:"subscribeAll,omitempty"`
 }
 
 func (c *Command) String() string {
@@ -51,6 +67,10 @@ func (c *Command) String() string {
 		return "newSet"
 	case c.AddAddresses != nil:
 		return "addAddresses"
+	case c.AddIDs != nil:
+		return "addIDs"
+	case c.SubscribeAll != nil:
+		return "subscribeAll"
 	default:
 		return "unknown"
 	}
@@ -75,3 +95,26 @@ func (c *AddAddresses) parseAddresses() error {
 	}
 	return nil
 }
+
+// parseIDs converts the IDs to their byte format. Node IDs must be prefixed
+// with [ids.NodeIDPrefix].
+func (c *AddIDs) parseIDs() error {
+	c.idBytes = make([][]byte, len(c.IDs))
+	for i, idStr := range c.IDs {
+		if strings.HasPrefix(idStr, ids.NodeIDPrefix) {
+			nodeID, err := ids.NodeIDFromString(idStr)
+			if err != nil {
+				return err
+			}
+			c.idBytes[i] = nodeID.Bytes()
+			continue
+		}
+
+		id, err := ids.FromString(idStr)
+		if err != nil {
+			return err
+		}
+		c.idBytes[i] = id[:]
+	}
+	return nil
+}
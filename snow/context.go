@@ -65,6 +65,9 @@ type Registerer interface {
 type ConsensusContext struct {
 	*Context
 
+	// VMID is the ID of the VM that this chain runs.
+	VMID ids.ID
+
 	// Registers all common and snowman consensus metrics. Unlike the avalanche
 	// consensus engine metrics, we do not prefix the name with the engine name,
 	// as snowman is used for all chains by default.
@@ -123,6 +123,11 @@ type handler struct {
 
 	// Tracks the peers that are currently connected to this subnet
 	peerTracker commontracker.Peers
+
+	// onInvalidMessage is called with the sender of every message that is
+	// dropped because one of its fields is invalid. If it is nil then it is
+	// skipped.
+	onInvalidMessage func(nodeID ids.NodeID)
 }
 
 // Initialize this consensus handler
@@ -137,20 +142,22 @@ func New(
 	subnetConnector validators.SubnetConnector,
 	subnet subnets.Subnet,
 	peerTracker commontracker.Peers,
+	onInvalidMessage func(nodeID ids.NodeID),
 ) (Handler, error) {
 	h := &handler{
-		ctx:             ctx,
-		validators:      validators,
-		msgFromVMChan:   msgFromVMChan,
-		preemptTimeouts: subnet.OnBootstrapCompleted(),
-		gossipFrequency: gossipFrequency,
-		timeouts:        make(chan struct{}, 1),
-		closingChan:     make(chan struct{}),
-		closed:          make(chan struct{}),
-		resourceTracker: resourceTracker,
-		subnetConnector: subnetConnector,
-		subnet:          subnet,
-		peerTracker:     peerTracker,
+		ctx:              ctx,
+		validators:       validators,
+		msgFromVMChan:    msgFromVMChan,
+		preemptTimeouts:  subnet.OnBootstrapCompleted(),
+		gossipFrequency:  gossipFrequency,
+		timeouts:         make(chan struct{}, 1),
+		closingChan:      make(chan struct{}),
+		closed:           make(chan struct{}),
+		resourceTracker:  resourceTracker,
+		subnetConnector:  subnetConnector,
+		subnet:           subnet,
+		peerTracker:      peerTracker,
+		onInvalidMessage: onInvalidMessage,
 	}
 	h.asyncMessagePool.SetLimit(threadPoolSize)
 
@@ -570,6 +577,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "SummaryIDs"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return engine.GetAcceptedStateSummaryFailed(ctx, nodeID, msg.RequestId)
 		}
 
@@ -593,6 +601,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return engine.GetAcceptedFrontierFailed(ctx, nodeID, msg.RequestId)
 		}
 
@@ -611,6 +620,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerIDs"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return nil
 		}
 
@@ -626,6 +636,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerIDs"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return engine.GetAcceptedFailed(ctx, nodeID, msg.RequestId)
 		}
 
@@ -644,6 +655,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return nil
 		}
 
@@ -665,6 +677,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return nil
 		}
 
@@ -689,6 +702,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "ContainerID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return nil
 		}
 
@@ -704,6 +718,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "PreferredID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return engine.QueryFailed(ctx, nodeID, msg.RequestId)
 		}
 
@@ -730,6 +745,7 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 				zap.String("field", "AcceptedID"),
 				zap.Error(err),
 			)
+			h.invalidMessage(nodeID)
 			return engine.QueryFailed(ctx, nodeID, msg.RequestId)
 		}
 
@@ -764,6 +780,13 @@ func (h *handler) handleSyncMsg(ctx context.Context, msg Message) error {
 	}
 }
 
+// invalidMessage reports that [nodeID] sent a message with an invalid field.
+func (h *handler) invalidMessage(nodeID ids.NodeID) {
+	if h.onInvalidMessage != nil {
+		h.onInvalidMessage(nodeID)
+	}
+}
+
 func (h *handler) handleAsyncMsg(ctx context.Context, msg Message) {
 	h.asyncMessagePool.Go(func() error {
 		if err := h.executeAsyncMsg(ctx, msg); err != nil {
@@ -6,6 +6,7 @@ package handler
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -63,6 +64,7 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -131,6 +133,95 @@ func TestHandlerDropsTimedOutMessages(t *testing.T) {
 	}
 }
 
+// invalidGetAccepted is a GetAccepted message with a malformed container ID
+type invalidGetAccepted struct {
+	message.InboundMessage
+}
+
+func (m invalidGetAccepted) Message() fmt.Stringer {
+	msg := m.InboundMessage.Message().(*p2p.GetAccepted)
+	return &p2p.GetAccepted{
+		ChainId:      msg.ChainId,
+		RequestId:    msg.RequestId,
+		Deadline:     msg.Deadline,
+		ContainerIds: [][]byte{{0x01}},
+		EngineType:   msg.EngineType,
+	}
+}
+
+func TestHandlerReportsInvalidMessages(t *testing.T) {
+	require := require.New(t)
+
+	invalidMessages := make(chan ids.NodeID, 1)
+	snowCtx := snowtest.Context(t, snowtest.CChainID)
+	ctx := snowtest.ConsensusContext(snowCtx)
+
+	vdrs := validators.NewManager()
+	nodeID := ids.GenerateTestNodeID()
+	require.NoError(vdrs.AddStaker(ctx.SubnetID, nodeID, nil, ids.Empty, 1))
+
+	resourceTracker, err := tracker.NewResourceTracker(
+		prometheus.NewRegistry(),
+		resource.NoUsage,
+		meter.ContinuousFactory{},
+		time.Second,
+	)
+	require.NoError(err)
+	handlerIntf, err := New(
+		ctx,
+		vdrs,
+		nil,
+		time.Second,
+		testThreadPoolSize,
+		resourceTracker,
+		validators.UnhandledSubnetConnector,
+		subnets.New(ctx.NodeID, subnets.Config{}),
+		commontracker.NewPeers(),
+		func(nodeID ids.NodeID) {
+			invalidMessages <- nodeID
+		},
+	)
+	require.NoError(err)
+	handler := handlerIntf.(*handler)
+
+	bootstrapper := &common.BootstrapperTest{
+		EngineTest: common.EngineTest{
+			T: t,
+		},
+	}
+	bootstrapper.Default(false)
+	bootstrapper.ContextF = func() *snow.ConsensusContext {
+		return ctx
+	}
+	bootstrapper.StartF = func(context.Context, uint32) error {
+		return nil
+	}
+	handler.SetEngineManager(&EngineManager{
+		Snowman: &Engine{
+			Bootstrapper: bootstrapper,
+		},
+	})
+	ctx.State.Set(snow.EngineState{
+		Type:  p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+		State: snow.Bootstrapping, // assumed bootstrap is ongoing
+	})
+
+	handler.Push(context.Background(), Message{
+		InboundMessage: invalidGetAccepted{
+			InboundMessage: message.InboundGetAccepted(ids.Empty, 1, time.Minute, nil, nodeID, p2p.EngineType_ENGINE_TYPE_SNOWMAN),
+		},
+		EngineType: p2p.EngineType_ENGINE_TYPE_SNOWMAN,
+	})
+	handler.Start(context.Background(), false)
+
+	select {
+	case reportedNodeID := <-invalidMessages:
+		require.Equal(nodeID, reportedNodeID)
+	case <-time.After(5 * time.Second):
+		require.FailNow("invalid message wasn't reported")
+	}
+}
+
 func TestHandlerClosesOnError(t *testing.T) {
 	require := require.New(t)
 
@@ -158,6 +249,7 @@ func TestHandlerClosesOnError(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -249,6 +341,7 @@ func TestHandlerDropsGossipDuringBootstrapping(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 	handler := handlerIntf.(*handler)
@@ -328,6 +421,7 @@ func TestHandlerDispatchInternal(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -403,6 +497,7 @@ func TestHandlerSubnetConnector(t *testing.T) {
 		connector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -573,6 +668,7 @@ func TestDynamicEngineTypeDispatch(t *testing.T) {
 				validators.UnhandledSubnetConnector,
 				subnets.New(ids.EmptyNodeID, subnets.Config{}),
 				commontracker.NewPeers(),
+				nil,
 			)
 			require.NoError(err)
 
@@ -645,6 +741,7 @@ func TestHandlerStartError(t *testing.T) {
 		nil,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -79,6 +79,7 @@ func TestHealthCheckSubnet(t *testing.T) {
 				validators.UnhandledSubnetConnector,
 				sb,
 				peerTracker,
+				nil,
 			)
 			require.NoError(err)
 
@@ -79,6 +79,7 @@ type ChainRouter struct {
 	criticalChains         set.Set[ids.ID]
 	sybilProtectionEnabled bool
 	onFatal                func(exitCode int)
+	onUnrequestedApp       func(nodeID ids.NodeID)
 	metrics                *routerMetrics
 	// Parameters for doing health checks
 	healthConfig HealthConfig
@@ -100,6 +101,7 @@ func (cr *ChainRouter) Initialize(
 	sybilProtectionEnabled bool,
 	trackedSubnets set.Set[ids.ID],
 	onFatal func(exitCode int),
+	onUnrequestedApp func(nodeID ids.NodeID),
 	healthConfig HealthConfig,
 	metricsNamespace string,
 	metricsRegisterer prometheus.Registerer,
@@ -112,6 +114,7 @@ func (cr *ChainRouter) Initialize(
 	cr.criticalChains = criticalChains
 	cr.sybilProtectionEnabled = sybilProtectionEnabled
 	cr.onFatal = onFatal
+	cr.onUnrequestedApp = onUnrequestedApp
 	cr.timedRequests = linkedhashmap.New[ids.RequestID, requestEntry]()
 	cr.peers = make(map[ids.NodeID]*peer)
 	cr.healthConfig = healthConfig
@@ -334,7 +337,11 @@ func (cr *ChainRouter) HandleInbound(ctx context.Context, msg message.InboundMes
 
 	uniqueRequestID, req := cr.clearRequest(op, nodeID, sourceChainID, destinationChainID, requestID)
 	if req == nil {
-		// We didn't request this message.
+		// We didn't request this message. Unrequested AppErrors are handled
+		// above, as they can't be distinguished from duplicated timeouts.
+		if op == message.AppResponseOp && cr.onUnrequestedApp != nil {
+			cr.onUnrequestedApp(nodeID)
+		}
 		msg.OnFinishedHandling()
 		return
 	}
@@ -78,6 +78,7 @@ func TestShutdown(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -102,6 +103,7 @@ func TestShutdown(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(chainCtx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -218,6 +220,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		metrics,
@@ -240,6 +243,7 @@ func TestShutdownTimesOut(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -356,6 +360,7 @@ func TestRouterTimeout(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -400,6 +405,7 @@ func TestRouterTimeout(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -719,6 +725,7 @@ func TestRouterHonorsRequestedEngine(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -942,6 +949,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -975,6 +983,7 @@ func TestValidatorOnlyMessageDrops(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		sb,
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1099,6 +1108,7 @@ func TestConnectedSubnet(t *testing.T) {
 		true,
 		trackedSubnets,
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -1211,6 +1221,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -1249,6 +1260,7 @@ func TestValidatorOnlyAllowedNodeMessageDrops(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		sb,
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -1430,6 +1442,28 @@ func TestAppRequest(t *testing.T) {
 	}
 }
 
+// Tests that only unrequested app responses are reported
+func TestUnrequestedAppResponse(t *testing.T) {
+	require := require.New(t)
+
+	chainRouter, _ := newChainRouterTest(t)
+
+	var unrequested []ids.NodeID
+	chainRouter.onUnrequestedApp = func(nodeID ids.NodeID) {
+		unrequested = append(unrequested, nodeID)
+	}
+
+	var (
+		ctx    = context.Background()
+		nodeID = ids.GenerateTestNodeID()
+	)
+	chainRouter.HandleInbound(ctx, message.InboundAppResponse(ids.Empty, 1, []byte("response"), nodeID))
+	require.Equal([]ids.NodeID{nodeID}, unrequested)
+
+	chainRouter.HandleInbound(ctx, message.InboundChits(ids.Empty, 1, ids.Empty, ids.Empty, ids.Empty, nodeID))
+	require.Equal([]ids.NodeID{nodeID}, unrequested)
+}
+
 // Tests that a response, peer error, or a timeout clears the timeout and calls
 // the handler
 func TestCrossChainAppRequest(t *testing.T) {
@@ -1541,6 +1575,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *common.EngineTest) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -1569,6 +1604,7 @@ func newChainRouterTest(t *testing.T) (*ChainRouter, *common.EngineTest) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(t, err)
 
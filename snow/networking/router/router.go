@@ -35,6 +35,7 @@ type Router interface {
 		sybilProtectionEnabled bool,
 		trackedSubnets set.Set[ids.ID],
 		onFatal func(exitCode int),
+		onUnrequestedApp func(nodeID ids.NodeID),
 		healthConfig HealthConfig,
 		metricsNamespace string,
 		metricsRegisterer prometheus.Registerer,
@@ -46,6 +46,7 @@ func (r *tracedRouter) Initialize(
 	sybilProtectionEnabled bool,
 	trackedSubnets set.Set[ids.ID],
 	onFatal func(exitCode int),
+	onUnrequestedApp func(nodeID ids.NodeID),
 	healthConfig HealthConfig,
 	metricsNamespace string,
 	metricsRegisterer prometheus.Registerer,
@@ -59,6 +60,7 @@ func (r *tracedRouter) Initialize(
 		sybilProtectionEnabled,
 		trackedSubnets,
 		onFatal,
+		onUnrequestedApp,
 		healthConfig,
 		metricsNamespace,
 		metricsRegisterer,
@@ -91,6 +91,7 @@ func TestTimeout(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		router.HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -128,6 +129,7 @@ func TestTimeout(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -357,6 +359,7 @@ func TestReliableMessages(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		router.HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -394,6 +397,7 @@ func TestReliableMessages(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -503,6 +507,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		router.HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -540,6 +545,7 @@ func TestReliableMessagesToMyself(t *testing.T) {
 		validators.UnhandledSubnetConnector,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		commontracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
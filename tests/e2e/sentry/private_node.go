@@ -0,0 +1,150 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sentry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/api/info"
+	"github.com/ava-labs/avalanchego/config"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/tests/fixture/e2e"
+	"github.com/ava-labs/avalanchego/tests/fixture/tmpnet"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/units"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	ginkgo "github.com/onsi/ginkgo/v2"
+)
+
+var _ = ginkgo.Describe("[Private Node]", func() {
+	require := require.New(ginkgo.GinkgoT())
+
+	const weight = 2_000 * units.Avax
+
+	ginkgo.It("should only connect to its sentries and never reveal its IP", func() {
+		network := e2e.Env.GetNetwork()
+
+		ginkgo.By("creating a sentry node")
+		sentry := e2e.AddEphemeralNode(network, tmpnet.FlagsMap{})
+		e2e.WaitForHealthy(sentry)
+
+		ginkgo.By("creating a private node behind the sentry")
+		privateNode := e2e.AddEphemeralNode(network, tmpnet.FlagsMap{
+			config.NetworkPrivateNodeKey:  true,
+			config.NetworkTrustedPeersKey: fmt.Sprintf("%s@%s", sentry.NodeID, sentry.StakingAddress),
+		})
+		e2e.WaitForHealthy(privateNode)
+
+		ginkgo.By("checking that the private node is only connected to the sentry")
+		peers, err := info.NewClient(privateNode.URI).Peers(e2e.DefaultContext())
+		require.NoError(err)
+		require.Len(peers, 1)
+		require.Equal(sentry.NodeID, peers[0].ID)
+
+		ginkgo.By("checking that the sentry doesn't know the private node's IP")
+		peers, err = info.NewClient(sentry.URI).Peers(e2e.DefaultContext())
+		require.NoError(err)
+		var foundPrivateNode bool
+		for _, peer := range peers {
+			if peer.ID != privateNode.NodeID {
+				continue
+			}
+			foundPrivateNode = true
+
+			// The private node signs the unspecified IP, which isn't
+			// reported as a public IP.
+			require.Empty(peer.PublicIP)
+		}
+		require.True(foundPrivateNode)
+
+		ginkgo.By("checking that no other node is connected to the private node")
+		for _, node := range network.Nodes {
+			requireNotPeer(node, privateNode.NodeID)
+		}
+	})
+
+	ginkgo.It("should shut down if it becomes a primary network validator", func() {
+		network := e2e.Env.GetNetwork()
+
+		ginkgo.By("checking that the network has a compatible minimum stake duration", func() {
+			minStakeDuration := cast.ToDuration(network.DefaultFlags[config.MinStakeDurationKey])
+			require.Equal(tmpnet.DefaultMinStakeDuration, minStakeDuration)
+		})
+
+		ginkgo.By("creating a sentry node")
+		sentry := e2e.AddEphemeralNode(network, tmpnet.FlagsMap{})
+		e2e.WaitForHealthy(sentry)
+
+		ginkgo.By("creating a private node behind the sentry")
+		privateNode := e2e.AddEphemeralNode(network, tmpnet.FlagsMap{
+			config.NetworkPrivateNodeKey:  true,
+			config.NetworkTrustedPeersKey: fmt.Sprintf("%s@%s", sentry.NodeID, sentry.StakingAddress),
+		})
+		e2e.WaitForHealthy(privateNode)
+
+		ginkgo.By("retrieving the private node's id and pop")
+		privateInfoClient := info.NewClient(privateNode.URI)
+		nodeID, nodePOP, err := privateInfoClient.GetNodeID(e2e.DefaultContext())
+		require.NoError(err)
+
+		ginkgo.By("adding the private node as a primary network validator", func() {
+			keychain := e2e.Env.NewKeychain(1)
+			pWallet := e2e.NewWallet(keychain, e2e.Env.GetRandomNodeURI()).P()
+
+			rewardKey, err := secp256k1.NewPrivateKey()
+			require.NoError(err)
+			rewardsOwner := &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{rewardKey.Address()},
+			}
+
+			_, err = pWallet.IssueAddPermissionlessValidatorTx(
+				&txs.SubnetValidator{
+					Validator: txs.Validator{
+						NodeID: nodeID,
+						End:    uint64(time.Now().Add(tmpnet.DefaultMinStakeDuration + time.Minute).Unix()),
+						Wght:   weight,
+					},
+					Subnet: constants.PrimaryNetworkID,
+				},
+				nodePOP,
+				pWallet.AVAXAssetID(),
+				rewardsOwner,
+				rewardsOwner,
+				reward.PercentDenominator,
+				e2e.WithDefaultContext(),
+			)
+			require.NoError(err)
+		})
+
+		ginkgo.By("checking that the private node shuts down once it is staking")
+		e2e.Eventually(
+			func() bool {
+				_, err := privateInfoClient.GetNodeVersion(e2e.DefaultContext())
+				return err != nil
+			},
+			e2e.DefaultTimeout,
+			e2e.DefaultPollingInterval,
+			"private node didn't shut down after it started staking",
+		)
+	})
+})
+
+func requireNotPeer(node *tmpnet.Node, nodeID ids.NodeID) {
+	require := require.New(ginkgo.GinkgoT())
+
+	peers, err := info.NewClient(node.URI).Peers(e2e.DefaultContext())
+	require.NoError(err)
+	for _, peer := range peers {
+		require.NotEqual(nodeID, peer.ID)
+	}
+}
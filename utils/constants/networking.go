@@ -67,6 +67,18 @@ const (
 	// a timeout of 0 should generally not be provided.
 	DefaultNetworkTCPProxyReadTimeout = 3 * time.Second
 
+	// Address book
+	DefaultNetworkAddressBookMaxAge   = 7 * 24 * time.Hour
+	DefaultNetworkAddressBookSyncFreq = time.Minute
+
+	// Peer reputation
+	DefaultNetworkPeerBanThreshold  = 0
+	DefaultNetworkPeerScoreHalflife = time.Minute
+	DefaultNetworkPeerBanDuration   = 10 * time.Minute
+
+	// Bandwidth metrics
+	DefaultNetworkBandwidthMetricsNumPeers = 10
+
 	// Benchlist
 	DefaultBenchlistFailThreshold      = 10
 	DefaultBenchlistDuration           = 15 * time.Minute
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package json
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/rpc/v2/json2"
+)
+
+const jsonRPCVersion = "2.0"
+
+var _ http.ResponseWriter = (*responseRecorder)(nil)
+
+type batchHandler struct {
+	handler      http.Handler
+	maxBatchSize int
+	maxBodySize  int64
+}
+
+// NewBatchHandler returns a handler that serves JSON-RPC 2.0 batch requests by
+// passing each call of the batch to [handler] and replying with an array of
+// the responses. Requests that aren't batches are passed to [handler]
+// unmodified. Batches of more than [maxBatchSize] calls and requests whose
+// body is larger than [maxBodySize] bytes are rejected.
+func NewBatchHandler(handler http.Handler, maxBatchSize int, maxBodySize int64) http.Handler {
+	return &batchHandler{
+		handler:      handler,
+		maxBatchSize: maxBatchSize,
+		maxBodySize:  maxBodySize,
+	}
+}
+
+func (h *batchHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.Body == nil {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, h.maxBodySize))
+	var maxBytesErr *http.MaxBytesError
+	switch {
+	case errors.As(err, &maxBytesErr):
+		writeError(w, json2.E_INVALID_REQ, fmt.Sprintf("request body exceeds the maximum of %d bytes", maxBytesErr.Limit))
+		return
+	case err != nil:
+		writeError(w, json2.E_PARSE, fmt.Sprintf("couldn't read request: %s", err))
+		return
+	}
+	if !isBatch(body) {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	var calls []json.RawMessage
+	if err := json.Unmarshal(body, &calls); err != nil {
+		writeError(w, json2.E_PARSE, fmt.Sprintf("couldn't parse batch request: %s", err))
+		return
+	}
+	switch {
+	case len(calls) == 0:
+		writeError(w, json2.E_INVALID_REQ, "batch request is empty")
+		return
+	case len(calls) > h.maxBatchSize:
+		writeError(w, json2.E_INVALID_REQ, fmt.Sprintf("batch request has %d calls, which exceeds the maximum of %d", len(calls), h.maxBatchSize))
+		return
+	}
+
+	responses := make([]json.RawMessage, 0, len(calls))
+	for _, call := range calls {
+		response, ok := h.serveCall(r, call)
+		if ok {
+			responses = append(responses, response)
+		}
+	}
+
+	// If all of the calls were notifications, nothing is returned
+	if len(responses) == 0 {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	writeJSON(w, responses)
+}
+
+// serveCall passes [call] to the wrapped handler as its own request and
+// returns the response to it. Returns false if [call] doesn't have a response,
+// which is the case for notifications.
+func (h *batchHandler) serveCall(r *http.Request, call json.RawMessage) (json.RawMessage, bool) {
+	var request struct {
+		ID *json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(call, &request); err != nil {
+		return newErrorResponse(nil, json2.E_INVALID_REQ, "call must be an object"), true
+	}
+
+	callRequest := r.Clone(r.Context())
+	callRequest.Body = io.NopCloser(bytes.NewReader(call))
+	callRequest.ContentLength = int64(len(call))
+
+	recorder := &responseRecorder{
+		header: make(http.Header),
+		status: http.StatusOK,
+	}
+	h.handler.ServeHTTP(recorder, callRequest)
+
+	response := bytes.TrimSpace(recorder.body.Bytes())
+	switch {
+	case request.ID == nil:
+		return nil, false
+	case !json.Valid(response):
+		// The handler failed before producing a JSON-RPC response, so the
+		// error is reported as the response to this call.
+		message := strings.TrimSpace(string(response))
+		if message == "" {
+			message = http.StatusText(recorder.status)
+		}
+		return newErrorResponse(request.ID, json2.E_INTERNAL, message), true
+	default:
+		return response, true
+	}
+}
+
+// isBatch returns true if [body] is a JSON array
+func isBatch(body []byte) bool {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	return len(body) > 0 && body[0] == '['
+}
+
+type errorResponse struct {
+	Version string           `json:"jsonrpc"`
+	Error   *json2.Error     `json:"error"`
+	ID      *json.RawMessage `json:"id"`
+}
+
+func newErrorResponse(id *json.RawMessage, code json2.ErrorCode, message string) json.RawMessage {
+	if id == nil {
+		null := json.RawMessage(Null)
+		id = &null
+	}
+	// Marshalling the response can't fail
+	response, _ := json.Marshal(&errorResponse{
+		Version: jsonRPCVersion,
+		Error: &json2.Error{
+			Code:    code,
+			Message: message,
+		},
+		ID: id,
+	})
+	return response
+}
+
+func writeError(w http.ResponseWriter, code json2.ErrorCode, message string) {
+	writeJSON(w, newErrorResponse(nil, code, message))
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// responseRecorder records the response to a call of a batch request
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (r *responseRecorder) Header() http.Header {
+	return r.header
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	return r.body.Write(b)
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+}
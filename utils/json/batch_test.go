@@ -0,0 +1,131 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package json
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/rpc/v2"
+	"github.com/stretchr/testify/require"
+)
+
+var errEchoFailed = errors.New("echo failed")
+
+type EchoArgs struct {
+	Message string `json:"message"`
+}
+
+type EchoReply struct {
+	Message string `json:"message"`
+}
+
+type echoService struct{}
+
+func (*echoService) Echo(_ *http.Request, args *EchoArgs, reply *EchoReply) error {
+	if args.Message == "" {
+		return errEchoFailed
+	}
+	reply.Message = args.Message
+	return nil
+}
+
+const testMaxBodySize = 512
+
+func newEchoHandler(t *testing.T, maxBatchSize int) http.Handler {
+	server := rpc.NewServer()
+	server.RegisterCodec(NewCodec(), "application/json")
+	require.NoError(t, server.RegisterService(&echoService{}, "test"))
+	return NewBatchHandler(server, maxBatchSize, testMaxBodySize)
+}
+
+func serve(handler http.Handler, body string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+type testResponse struct {
+	Result *EchoReply `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+	ID *int `json:"id"`
+}
+
+func TestBatchHandler(t *testing.T) {
+	require := require.New(t)
+
+	handler := newEchoHandler(t, 3)
+
+	// Requests that aren't batches are served as is
+	w := serve(handler, `{"jsonrpc":"2.0","method":"test.echo","params":{"message":"a"},"id":1}`)
+	var response testResponse
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+	require.Equal("a", response.Result.Message)
+
+	// Each call of a batch gets its own response
+	w = serve(handler, `[
+		{"jsonrpc":"2.0","method":"test.echo","params":{"message":"a"},"id":1},
+		{"jsonrpc":"2.0","method":"test.echo","params":{"message":""},"id":2},
+		{"jsonrpc":"2.0","method":"test.echo","params":{"message":"c"}}
+	]`)
+	var responses []testResponse
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &responses))
+	require.Len(responses, 2)
+	require.Equal(1, *responses[0].ID)
+	require.Equal("a", responses[0].Result.Message)
+	require.Equal(2, *responses[1].ID)
+	require.Equal(errEchoFailed.Error(), responses[1].Error.Message)
+}
+
+func TestBatchHandlerRejectsBatch(t *testing.T) {
+	tests := []struct {
+		name         string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "empty",
+			body:         `[]`,
+			expectedCode: -32600,
+		},
+		{
+			name: "too large",
+			body: `[
+				{"jsonrpc":"2.0","method":"test.echo","params":{"message":"a"},"id":1},
+				{"jsonrpc":"2.0","method":"test.echo","params":{"message":"b"},"id":2}
+			]`,
+			expectedCode: -32600,
+		},
+		{
+			name:         "body too large",
+			body:         `[{"jsonrpc":"2.0","method":"test.echo","params":{"message":"` + strings.Repeat("a", testMaxBodySize) + `"},"id":1}]`,
+			expectedCode: -32600,
+		},
+		{
+			name:         "invalid JSON",
+			body:         `[{"jsonrpc":"2.0"`,
+			expectedCode: -32700,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			w := serve(newEchoHandler(t, 1), test.body)
+			var response testResponse
+			require.NoError(json.Unmarshal(w.Body.Bytes(), &response))
+			require.Nil(response.ID)
+			require.Equal(test.expectedCode, response.Error.Code)
+		})
+	}
+}
@@ -6,13 +6,33 @@ package rpc
 import (
 	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
 	rpc "github.com/gorilla/rpc/v2/json2"
 )
 
+var errMissingResponse = errors.New("missing response")
+
+// Request is a call of a batch request. The result of the call is unmarshalled
+// into [Reply].
+type Request struct {
+	Method string
+	Params interface{}
+	Reply  interface{}
+}
+
+type batchCall struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params"`
+	ID      uint64      `json:"id"`
+}
+
 func SendJSONRequest(
 	ctx context.Context,
 	uri *url.URL,
@@ -26,6 +46,95 @@ func SendJSONRequest(
 		return fmt.Errorf("failed to encode client params: %w", err)
 	}
 
+	return sendJSON(ctx, uri, requestBodyBytes, func(body io.Reader) error {
+		return rpc.DecodeClientResponse(body, reply)
+	}, options...)
+}
+
+// SendJSONBatchRequest sends [requests] as a single JSON-RPC batch request.
+// The returned errors are the errors of each of the calls, in the order of
+// [requests]. If the batch couldn't be sent, only the returned error is set.
+func SendJSONBatchRequest(
+	ctx context.Context,
+	uri *url.URL,
+	requests []Request,
+	options ...Option,
+) ([]error, error) {
+	// Servers reject empty batches
+	if len(requests) == 0 {
+		return nil, nil
+	}
+
+	calls := make([]batchCall, len(requests))
+	for i, request := range requests {
+		calls[i] = batchCall{
+			Version: "2.0",
+			Method:  request.Method,
+			Params:  request.Params,
+			ID:      uint64(i),
+		}
+	}
+	requestBodyBytes, err := json.Marshal(calls)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode client params: %w", err)
+	}
+
+	errs := make([]error, len(requests))
+	err = sendJSON(ctx, uri, requestBodyBytes, func(body io.Reader) error {
+		responseBytes, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+
+		var responses []json.RawMessage
+		if err := json.Unmarshal(responseBytes, &responses); err != nil {
+			// The server replies with a single error if the batch is
+			// rejected
+			if rejectErr := rpc.DecodeClientResponse(bytes.NewReader(responseBytes), &struct{}{}); rejectErr != nil {
+				return rejectErr
+			}
+			return fmt.Errorf("unexpected batch response: %w", err)
+		}
+
+		received := make([]bool, len(requests))
+		for _, response := range responses {
+			var header struct {
+				ID *uint64 `json:"id"`
+			}
+			if err := json.Unmarshal(response, &header); err != nil {
+				return err
+			}
+			// Responses without an ID can't be matched to a call
+			if header.ID == nil {
+				continue
+			}
+			id := *header.ID
+			if id >= uint64(len(requests)) {
+				return fmt.Errorf("unexpected response ID %d", id)
+			}
+			received[id] = true
+			errs[id] = rpc.DecodeClientResponse(bytes.NewReader(response), requests[id].Reply)
+		}
+		for i, ok := range received {
+			if !ok {
+				errs[i] = errMissingResponse
+			}
+		}
+		return nil
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	return errs, nil
+}
+
+func sendJSON(
+	ctx context.Context,
+	uri *url.URL,
+	requestBodyBytes []byte,
+	decode func(body io.Reader) error,
+	options ...Option,
+) error {
 	ops := NewOptions(options)
 	uri.RawQuery = ops.queryParams.Encode()
 
@@ -54,7 +163,7 @@ func SendJSONRequest(
 		return fmt.Errorf("received status code: %d", resp.StatusCode)
 	}
 
-	if err := rpc.DecodeClientResponse(resp.Body, reply); err != nil {
+	if err := decode(resp.Body); err != nil {
 		// Drop any error during close to report the original error
 		_ = resp.Body.Close()
 		return fmt.Errorf("failed to decode client response: %w", err)
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package rpc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/gorilla/rpc/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/utils/json"
+	"github.com/ava-labs/avalanchego/utils/units"
+)
+
+var errEchoFailed = errors.New("echo failed")
+
+type EchoArgs struct {
+	Message string `json:"message"`
+}
+
+type EchoReply struct {
+	Message string `json:"message"`
+}
+
+type echoService struct{}
+
+func (*echoService) Echo(_ *http.Request, args *EchoArgs, reply *EchoReply) error {
+	if args.Message == "" {
+		return errEchoFailed
+	}
+	reply.Message = args.Message
+	return nil
+}
+
+func TestSendJSONBatchRequest(t *testing.T) {
+	require := require.New(t)
+
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	require.NoError(server.RegisterService(&echoService{}, "test"))
+	httpServer := httptest.NewServer(json.NewBatchHandler(server, 2, units.MiB))
+	defer httpServer.Close()
+
+	uri, err := url.Parse(httpServer.URL)
+	require.NoError(err)
+
+	replies := make([]EchoReply, 2)
+	requests := []Request{
+		{
+			Method: "test.echo",
+			Params: &EchoArgs{Message: "a"},
+			Reply:  &replies[0],
+		},
+		{
+			Method: "test.echo",
+			Params: &EchoArgs{},
+			Reply:  &replies[1],
+		},
+	}
+	errs, err := SendJSONBatchRequest(context.Background(), uri, requests)
+	require.NoError(err)
+	require.Len(errs, 2)
+	require.NoError(errs[0])
+	require.Equal("a", replies[0].Message)
+	require.ErrorContains(errs[1], errEchoFailed.Error())
+
+	// Batches that are too large are rejected as a whole
+	requests = append(requests, requests[0])
+	_, err = SendJSONBatchRequest(context.Background(), uri, requests)
+	require.ErrorContains(err, "exceeds the maximum")
+}
+
+// singleRequester only supports sending one request at a time.
+type singleRequester struct {
+	EndpointRequester
+}
+
+func TestSendBatchRequestFallsBackToSingleRequests(t *testing.T) {
+	require := require.New(t)
+
+	// The server doesn't support batch requests, so the requests must be sent
+	// one at a time.
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	require.NoError(server.RegisterService(&echoService{}, "test"))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	requester := singleRequester{
+		EndpointRequester: NewEndpointRequester(httpServer.URL),
+	}
+	replies := make([]EchoReply, 2)
+	requests := []Request{
+		{
+			Method: "test.echo",
+			Params: &EchoArgs{Message: "a"},
+			Reply:  &replies[0],
+		},
+		{
+			Method: "test.echo",
+			Params: &EchoArgs{},
+			Reply:  &replies[1],
+		},
+	}
+	errs, err := SendBatchRequest(context.Background(), requester, requests)
+	require.NoError(err)
+	require.Len(errs, 2)
+	require.NoError(errs[0])
+	require.Equal("a", replies[0].Message)
+	require.ErrorContains(errs[1], errEchoFailed.Error())
+}
+
+func TestSendBatchRequestSplitsBatches(t *testing.T) {
+	require := require.New(t)
+
+	server := rpc.NewServer()
+	server.RegisterCodec(json.NewCodec(), "application/json")
+	require.NoError(server.RegisterService(&echoService{}, "test"))
+	httpServer := httptest.NewServer(json.NewBatchHandler(server, MaxBatchSize, units.MiB))
+	defer httpServer.Close()
+
+	requester := NewEndpointRequester(httpServer.URL)
+
+	// Empty batches aren't sent, as the server would reject them
+	errs, err := SendBatchRequest(context.Background(), requester, nil)
+	require.NoError(err)
+	require.Empty(errs)
+
+	// Batches that are larger than the server's limit are split
+	replies := make([]EchoReply, MaxBatchSize+1)
+	requests := make([]Request, len(replies))
+	for i := range requests {
+		requests[i] = Request{
+			Method: "test.echo",
+			Params: &EchoArgs{Message: "a"},
+			Reply:  &replies[i],
+		}
+	}
+	errs, err = SendBatchRequest(context.Background(), requester, requests)
+	require.NoError(err)
+	require.Len(errs, len(requests))
+	for i, err := range errs {
+		require.NoError(err)
+		require.Equal("a", replies[i].Message)
+	}
+}
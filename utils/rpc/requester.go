@@ -8,12 +8,60 @@ import (
 	"net/url"
 )
 
-var _ EndpointRequester = (*avalancheEndpointRequester)(nil)
+var (
+	_ EndpointRequester = (*avalancheEndpointRequester)(nil)
+	_ BatchRequester    = (*avalancheEndpointRequester)(nil)
+)
 
 type EndpointRequester interface {
 	SendRequest(ctx context.Context, method string, params interface{}, reply interface{}, options ...Option) error
 }
 
+// BatchRequester is an optional interface of an EndpointRequester that can
+// send multiple calls in a single request.
+type BatchRequester interface {
+	// SendBatchRequest sends [requests] in a single batch request and returns
+	// the error of each of them.
+	SendBatchRequest(ctx context.Context, requests []Request, options ...Option) ([]error, error)
+}
+
+// MaxBatchSize is the maximum number of calls that SendBatchRequest sends in a
+// single batch request. It is the default maximum batch size of the node's
+// built-in APIs.
+const MaxBatchSize = 1000
+
+// SendBatchRequest sends [requests] using [requester] and returns the error of
+// each of them. If [requester] is a BatchRequester, the requests are sent in
+// batch requests of at most MaxBatchSize calls. Otherwise, they are sent one at
+// a time.
+func SendBatchRequest(
+	ctx context.Context,
+	requester EndpointRequester,
+	requests []Request,
+	options ...Option,
+) ([]error, error) {
+	batchRequester, ok := requester.(BatchRequester)
+	if !ok {
+		errs := make([]error, len(requests))
+		for i, request := range requests {
+			errs[i] = requester.SendRequest(ctx, request.Method, request.Params, request.Reply, options...)
+		}
+		return errs, nil
+	}
+
+	errs := make([]error, 0, len(requests))
+	for len(requests) > 0 {
+		batchSize := min(len(requests), MaxBatchSize)
+		batchErrs, err := batchRequester.SendBatchRequest(ctx, requests[:batchSize], options...)
+		if err != nil {
+			return nil, err
+		}
+		errs = append(errs, batchErrs...)
+		requests = requests[batchSize:]
+	}
+	return errs, nil
+}
+
 type avalancheEndpointRequester struct {
 	uri string
 }
@@ -45,3 +93,21 @@ func (e *avalancheEndpointRequester) SendRequest(
 		options...,
 	)
 }
+
+func (e *avalancheEndpointRequester) SendBatchRequest(
+	ctx context.Context,
+	requests []Request,
+	options ...Option,
+) ([]error, error) {
+	uri, err := url.Parse(e.uri)
+	if err != nil {
+		return nil, err
+	}
+
+	return SendJSONBatchRequest(
+		ctx,
+		uri,
+		requests,
+		options...,
+	)
+}
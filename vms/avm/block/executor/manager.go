@@ -43,6 +43,11 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx verifies [tx] in the same way as VerifyTx, but on top of the
+	// currently preferred state rather than the last accepted state, and
+	// returns the state that would result from executing it.
+	SimulateTx(tx *txs.Tx) (state.Diff, error)
+
 	// VerifyUniqueInputs returns nil iff no blocks in the inclusive
 	// ancestry of [blkID] consume an input in [inputs].
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -143,8 +148,18 @@ func (m *manager) NewBlock(blk block.Block) snowman.Block {
 }
 
 func (m *manager) VerifyTx(tx *txs.Tx) error {
+	_, err := m.executeTx(m.lastAccepted, tx)
+	return err
+}
+
+func (m *manager) SimulateTx(tx *txs.Tx) (state.Diff, error) {
+	return m.executeTx(m.preferred, tx)
+}
+
+// executeTx verifies [tx] and executes it on top of the state of [parentID].
+func (m *manager) executeTx(parentID ids.ID, tx *txs.Tx) (state.Diff, error) {
 	if !m.backend.Bootstrapped {
-		return ErrChainNotSynced
+		return nil, ErrChainNotSynced
 	}
 
 	err := tx.Unsigned.Visit(&executor.SyntacticVerifier{
@@ -152,12 +167,12 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		Tx:      tx,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	stateDiff, err := state.NewDiff(m.lastAccepted, m)
+	stateDiff, err := state.NewDiff(parentID, m)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = tx.Unsigned.Visit(&executor.SemanticVerifier{
@@ -166,7 +181,7 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		Tx:      tx,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	executor := &executor.Executor{
@@ -174,7 +189,10 @@ func (m *manager) VerifyTx(tx *txs.Tx) error {
 		State: stateDiff,
 		Tx:    tx,
 	}
-	return tx.Unsigned.Visit(executor)
+	if err := tx.Unsigned.Visit(executor); err != nil {
+		return nil, err
+	}
+	return stateDiff, nil
 }
 
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
@@ -17,6 +17,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/avm/txs/executor"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
 )
 
 var (
@@ -255,6 +256,50 @@ func TestManagerVerifyTx(t *testing.T) {
 	}
 }
 
+func TestManagerSimulateTx(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+
+	unsigned := txs.NewMockUnsignedTx(ctrl)
+	// Syntactic verification, semantic verification and execution pass
+	unsigned.EXPECT().Visit(gomock.Any()).Return(nil).Times(3)
+	tx := &txs.Tx{
+		Unsigned: unsigned,
+	}
+
+	// The tx is simulated on top of the preferred block, rather than the last
+	// accepted block.
+	var (
+		preferredID = ids.GenerateTestID()
+		utxoID      = ids.GenerateTestID()
+		utxo        = &avax.UTXO{}
+	)
+	preferredState := state.NewMockDiff(ctrl)
+	preferredState.EXPECT().GetLastAccepted().Return(ids.GenerateTestID())
+	preferredState.EXPECT().GetTimestamp().Return(time.Time{})
+	preferredState.EXPECT().GetUTXO(utxoID).Return(utxo, nil)
+
+	m := &manager{
+		backend: &executor.Backend{
+			Bootstrapped: true,
+		},
+		blkIDToState: map[ids.ID]*blockState{
+			preferredID: {
+				onAcceptState: preferredState,
+			},
+		},
+		lastAccepted: ids.GenerateTestID(),
+		preferred:    preferredID,
+	}
+
+	stateDiff, err := m.SimulateTx(tx)
+	require.NoError(err)
+
+	gotUTXO, err := stateDiff.GetUTXO(utxoID)
+	require.NoError(err)
+	require.Equal(utxo, gotUTXO)
+}
+
 func TestVerifyUniqueInputs(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
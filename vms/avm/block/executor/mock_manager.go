@@ -143,6 +143,21 @@ func (mr *MockManagerMockRecorder) SetPreference(blkID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPreference", reflect.TypeOf((*MockManager)(nil).SetPreference), blkID)
 }
 
+// SimulateTx mocks base method.
+func (m *MockManager) SimulateTx(tx *txs.Tx) (state.Diff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SimulateTx", tx)
+	ret0, _ := ret[0].(state.Diff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SimulateTx indicates an expected call of SimulateTx.
+func (mr *MockManagerMockRecorder) SimulateTx(tx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SimulateTx", reflect.TypeOf((*MockManager)(nil).SimulateTx), tx)
+}
+
 // VerifyTx mocks base method.
 func (m *MockManager) VerifyTx(tx *txs.Tx) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,296 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	walletbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
+)
+
+const (
+	BaseTxType   = "baseTx"
+	ExportTxType = "export"
+	ImportTxType = "import"
+)
+
+var (
+	errUnknownBuildTxType = errors.New("unknown tx type")
+	errMissingTxParams    = errors.New("missing tx params")
+	errUnknownInputType   = errors.New("unknown input type")
+	errUnknownOutputType  = errors.New("unknown output type")
+	errMissingInputUTXO   = errors.New("missing input UTXO")
+
+	_ walletbuilder.Backend = (*buildTxBackend)(nil)
+)
+
+// BuildTxOwner is the owner of an output that a built tx should create
+type BuildTxOwner struct {
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// BuildTxOutput is an output that a built tx should create
+type BuildTxOutput struct {
+	// AssetID can be an ID or an alias
+	AssetID string         `json:"assetID"`
+	Amount  avajson.Uint64 `json:"amount"`
+	BuildTxOwner
+}
+
+// BuildBaseTxParams are the params of a [BaseTxType] tx
+type BuildBaseTxParams struct {
+	Outputs []BuildTxOutput `json:"outputs"`
+}
+
+// BuildExportTxParams are the params of a [ExportTxType] tx
+type BuildExportTxParams struct {
+	DestinationChain string          `json:"destinationChain"`
+	Outputs          []BuildTxOutput `json:"outputs"`
+}
+
+// BuildImportTxParams are the params of a [ImportTxType] tx
+type BuildImportTxParams struct {
+	SourceChain string       `json:"sourceChain"`
+	To          BuildTxOwner `json:"to"`
+}
+
+// BuildTxSigner describes the signatures that must be provided for an input
+// of a built tx. Signers are returned in the order that their credentials must
+// be included in the signed tx.
+type BuildTxSigner struct {
+	// UTXO consumed by the input
+	UTXOID string `json:"utxoID"`
+	// Indices of the owners that must sign.
+	SigIndices []avajson.Uint32 `json:"sigIndices"`
+	// Addresses of the owners that must sign, in the same order as
+	// [SigIndices].
+	Addresses []string `json:"addresses"`
+}
+
+// buildTxBackend provides the wallet builder with access to the last
+// accepted state of the VM.
+type buildTxBackend struct {
+	walletbuilder.Context
+
+	vm    *VM
+	addrs set.Set[ids.ShortID]
+	// utxos contains every UTXO returned to the builder, by inputID.
+	utxos map[ids.ID]*avax.UTXO
+}
+
+func newBuildTxBackend(vm *VM, addrs set.Set[ids.ShortID]) *buildTxBackend {
+	return &buildTxBackend{
+		Context: walletbuilder.NewContext(
+			vm.ctx.NetworkID,
+			vm.ctx.ChainID,
+			vm.feeAssetID,
+			vm.TxFee,
+			vm.CreateAssetTxFee,
+		),
+		vm:    vm,
+		addrs: addrs,
+		utxos: make(map[ids.ID]*avax.UTXO),
+	}
+}
+
+func (b *buildTxBackend) UTXOs(_ context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	var (
+		utxos []*avax.UTXO
+		err   error
+	)
+	if sourceChainID == b.vm.ctx.ChainID {
+		utxos, err = avax.GetAllUTXOs(b.vm.state, b.addrs)
+	} else {
+		utxos, _, _, err = b.vm.GetAtomicUTXOs(
+			sourceChainID,
+			b.addrs,
+			ids.ShortEmpty,
+			ids.Empty,
+			int(maxPageSize),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get UTXOs: %w", err)
+	}
+
+	for _, utxo := range utxos {
+		b.utxos[utxo.InputID()] = utxo
+	}
+	return utxos, nil
+}
+
+// buildTx builds the unsigned tx of type [txType] described by [params].
+func (s *Service) buildTx(
+	txBuilder walletbuilder.Builder,
+	txType string,
+	params json.RawMessage,
+	options []common.Option,
+) (txs.UnsignedTx, error) {
+	if len(params) == 0 {
+		return nil, errMissingTxParams
+	}
+
+	switch txType {
+	case BaseTxType:
+		var p BuildBaseTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		outputs, err := s.parseBuildTxOutputs(p.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewBaseTx(outputs, options...)
+	case ExportTxType:
+		var p BuildExportTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		chainID, err := s.vm.ctx.BCLookup.Lookup(p.DestinationChain)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing destinationChain %q: %w", p.DestinationChain, err)
+		}
+		outputs, err := s.parseBuildTxOutputs(p.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewExportTx(chainID, outputs, options...)
+	case ImportTxType:
+		var p BuildImportTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		chainID, err := s.vm.ctx.BCLookup.Lookup(p.SourceChain)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing sourceChain %q: %w", p.SourceChain, err)
+		}
+		to, err := s.parseBuildTxOwner(&p.To)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewImportTx(chainID, to, options...)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownBuildTxType, txType)
+	}
+}
+
+func (s *Service) parseBuildTxOwner(owner *BuildTxOwner) (*secp256k1fx.OutputOwners, error) {
+	addrs, err := avax.ParseServiceAddresses(s.vm, owner.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	// Output owners must have sorted addresses to be valid.
+	sortedAddrs := addrs.List()
+	utils.Sort(sortedAddrs)
+	return &secp256k1fx.OutputOwners{
+		Locktime:  uint64(owner.Locktime),
+		Threshold: uint32(owner.Threshold),
+		Addrs:     sortedAddrs,
+	}, nil
+}
+
+func (s *Service) parseBuildTxOutputs(apiOutputs []BuildTxOutput) ([]*avax.TransferableOutput, error) {
+	outputs := make([]*avax.TransferableOutput, len(apiOutputs))
+	for i, apiOutput := range apiOutputs {
+		assetID, err := s.vm.lookupAssetID(apiOutput.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := s.parseBuildTxOwner(&apiOutput.BuildTxOwner)
+		if err != nil {
+			return nil, err
+		}
+		outputs[i] = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          uint64(apiOutput.Amount),
+				OutputOwners: *owner,
+			},
+		}
+	}
+	return outputs, nil
+}
+
+// getBuildTxSigners returns the signatures needed to issue [utx], in the order
+// that their credentials must be included in the signed tx.
+func (s *Service) getBuildTxSigners(backend *buildTxBackend, utx txs.UnsignedTx) ([]BuildTxSigner, error) {
+	var ins []*avax.TransferableInput
+	switch utx := utx.(type) {
+	case *txs.BaseTx:
+		ins = utx.Ins
+	case *txs.ExportTx:
+		ins = utx.Ins
+	case *txs.ImportTx:
+		ins = make([]*avax.TransferableInput, 0, len(utx.Ins)+len(utx.ImportedIns))
+		ins = append(ins, utx.Ins...)
+		ins = append(ins, utx.ImportedIns...)
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnknownBuildTxType, utx)
+	}
+
+	signers := make([]BuildTxSigner, len(ins))
+	for i, in := range ins {
+		utxoID := in.InputID()
+		utxo, ok := backend.utxos[utxoID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errMissingInputUTXO, utxoID)
+		}
+
+		input, ok := in.In.(*secp256k1fx.TransferInput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errUnknownInputType, in.In)
+		}
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errUnknownOutputType, utxo.Out)
+		}
+
+		signer := BuildTxSigner{
+			UTXOID:     in.UTXOID.String(),
+			SigIndices: make([]avajson.Uint32, len(input.SigIndices)),
+			Addresses:  make([]string, len(input.SigIndices)),
+		}
+		for j, sigIndex := range input.SigIndices {
+			if int(sigIndex) >= len(out.Addrs) {
+				return nil, fmt.Errorf("signature index %d out of range", sigIndex)
+			}
+			addr, err := s.vm.FormatLocalAddress(out.Addrs[sigIndex])
+			if err != nil {
+				return nil, fmt.Errorf("problem formatting address: %w", err)
+			}
+			signer.SigIndices[j] = avajson.Uint32(sigIndex)
+			signer.Addresses[j] = addr
+		}
+		signers[i] = signer
+	}
+	return signers, nil
+}
+
+// buildTxOptions returns the wallet options for a tx that sends its change to
+// [changeAddr].
+func buildTxOptions(changeAddr ids.ShortID) []common.Option {
+	if changeAddr == ids.ShortEmpty {
+		return nil
+	}
+	return []common.Option{
+		common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{changeAddr},
+		}),
+	}
+}
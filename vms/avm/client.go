@@ -17,6 +17,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting/address"
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
+
+	stdjson "encoding/json"
 )
 
 var _ Client = (*client)(nil)
@@ -30,6 +32,9 @@ type Client interface {
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
 	// GetHeight returns the height of the last accepted block.
 	GetHeight(ctx context.Context, options ...rpc.Option) (uint64, error)
+	// GetMempool returns the txs that are waiting in the mempool, from oldest
+	// to newest
+	GetMempool(ctx context.Context, options ...rpc.Option) ([]MempoolTx, error)
 	// GetTxStatus returns the status of [txID]
 	//
 	// Deprecated: GetTxStatus only returns Accepted or Unknown, GetTx should be
@@ -43,6 +48,23 @@ type Client interface {
 	ConfirmTx(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (choices.Status, error)
 	// GetTx returns the byte representation of [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
+	// GetTxs returns the byte representations of [txIDs], fetched in batch
+	// requests
+	GetTxs(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([][]byte, error)
+	// BuildTx builds an unsigned tx of type [txType], described by [params],
+	// that is funded by [from]. It returns the unsigned tx bytes and the
+	// signatures needed to issue the tx.
+	BuildTx(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		txType string,
+		params interface{},
+		options ...rpc.Option,
+	) ([]byte, []BuildTxSigner, error)
+	// SimulateTx verifies the transaction against the preferred state, without
+	// issuing it, and returns the changes that it would make
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetUTXOs returns the byte representation of the UTXOs controlled by [addrs]
 	GetUTXOs(
 		ctx context.Context,
@@ -63,8 +85,73 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetUTXOPages returns the page of UTXOs described by each of [queries],
+	// fetched in batch requests
+	GetUTXOPages(ctx context.Context, queries []UTXOsQuery, options ...rpc.Option) ([]UTXOsPage, error)
+	// GetExportedUTXOs returns the byte representation of the UTXOs controlled
+	// by [addrs] that were exported to [destinationChain] and haven't been
+	// imported yet
+	GetExportedUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		destinationChain string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetAtomicUTXOStatus returns the status of [utxoID], which was exported
+	// to or from [chain]
+	GetAtomicUTXOStatus(ctx context.Context, utxoID ids.ID, chain string, options ...rpc.Option) (*api.GetAtomicUTXOStatusReply, error)
 	// GetAssetDescription returns a description of [assetID]
 	GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error)
+	// GetBalancesByLockState returns the balances held by [addrs], grouped by
+	// asset and by whether [addrs] can spend them now
+	GetBalancesByLockState(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]LockStateBalance, error)
+	// GetNFTs returns at most [limit] NFTs owned by [addrs], starting after
+	// [startAddress] and [startUTXOID]. If [assetID] is non-empty, only NFTs
+	// of [assetID] are returned. If [groupID] is non-nil, only NFTs of
+	// [groupID] are returned. Payloads are hex encoded. [addrs] must be
+	// non-empty. Fewer than [limit] NFTs may be returned even if more remain,
+	// unless the reply is complete.
+	GetNFTs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		assetID string,
+		groupID *uint32,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) (*GetNFTsReply, error)
+	// GetProperties returns at most [limit] properties owned by [addrs],
+	// starting after [startAddress] and [startUTXOID]. If [assetID] is
+	// non-empty, only properties of [assetID] are returned. [addrs] must be
+	// non-empty. Fewer than [limit] properties may be returned even if more
+	// remain, unless the reply is complete.
+	GetProperties(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		assetID string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) (*GetPropertiesReply, error)
+	// GetAssetHolders returns at most [limit] owners of [assetID] at [height],
+	// starting at the owner ID [cursor]. If [height] is nil, the last accepted
+	// height is used.
+	GetAssetHolders(
+		ctx context.Context,
+		assetID string,
+		height *uint64,
+		cursor ids.ID,
+		limit uint64,
+		options ...rpc.Option,
+	) (*GetAssetHoldersReply, error)
+	// GetAssetSupply returns the supply of [assetID] at [height]. If [height]
+	// is nil, the last accepted height is used.
+	GetAssetSupply(ctx context.Context, assetID string, height *uint64, options ...rpc.Option) (*GetAssetSupplyReply, error)
 	// GetBalance returns the balance of [assetID] held by [addr].
 	// If [includePartial], balance includes partial owned (i.e. in a multisig) funds.
 	//
@@ -216,6 +303,28 @@ type Client interface {
 	) (ids.ID, error)
 }
 
+// UTXOsQuery is a page of UTXOs to fetch with GetUTXOPages
+type UTXOsQuery struct {
+	// Addresses that control the UTXOs
+	Addrs []ids.ShortID
+	// Chain the atomic UTXOs were exported from. If empty, the UTXOs on this
+	// chain are fetched.
+	SourceChain string
+	// Maximum number of UTXOs to fetch
+	Limit uint32
+	// Index to start fetching UTXOs from
+	StartAddress ids.ShortID
+	StartUTXOID  ids.ID
+}
+
+// UTXOsPage is a page of UTXOs fetched with GetUTXOPages
+type UTXOsPage struct {
+	UTXOs [][]byte
+	// Index to continue fetching UTXOs from
+	EndAddress ids.ShortID
+	EndUTXOID  ids.ID
+}
+
 // implementation for an AVM client for interacting with avm [chain]
 type client struct {
 	requester rpc.EndpointRequester
@@ -264,6 +373,43 @@ func (c *client) GetHeight(ctx context.Context, options ...rpc.Option) (uint64,
 	return uint64(res.Height), err
 }
 
+func (c *client) GetMempool(ctx context.Context, options ...rpc.Option) ([]MempoolTx, error) {
+	res := &GetMempoolReply{}
+	err := c.requester.SendRequest(ctx, "avm.getMempool", struct{}{}, res, options...)
+	return res.Txs, err
+}
+
+func (c *client) BuildTx(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	txType string,
+	params interface{},
+	options ...rpc.Option,
+) ([]byte, []BuildTxSigner, error) {
+	paramsBytes, err := stdjson.Marshal(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args := &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+		TxType:        txType,
+		Params:        paramsBytes,
+		Encoding:      formatting.Hex,
+	}
+	if changeAddr != ids.ShortEmpty {
+		args.ChangeAddr = changeAddr.String()
+	}
+
+	res := &BuildTxReply{}
+	if err := c.requester.SendRequest(ctx, "avm.buildTx", args, res, options...); err != nil {
+		return nil, nil, err
+	}
+	txBytes, err := formatting.Decode(res.Encoding, res.UnsignedTx)
+	return txBytes, res.Signers, err
+}
+
 func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
 	txStr, err := formatting.Encode(formatting.Hex, txBytes)
 	if err != nil {
@@ -277,6 +423,19 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "avm.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (choices.Status, error) {
 	res := &GetTxStatusReply{}
 	err := c.requester.SendRequest(ctx, "avm.getTxStatus", &api.JSONTxID{
@@ -317,6 +476,41 @@ func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option)
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+func (c *client) GetTxs(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([][]byte, error) {
+	if len(txIDs) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]rpc.Request, len(txIDs))
+	replies := make([]api.FormattedTx, len(txIDs))
+	for i, txID := range txIDs {
+		requests[i] = rpc.Request{
+			Method: "avm.getTx",
+			Params: &api.GetTxArgs{
+				TxID:     txID,
+				Encoding: formatting.Hex,
+			},
+			Reply: &replies[i],
+		}
+	}
+	errs, err := rpc.SendBatchRequest(ctx, c.requester, requests, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([][]byte, len(txIDs))
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get tx %s: %w", txIDs[i], err)
+		}
+		txs[i], err = formatting.Decode(replies[i].Encoding, replies[i].Tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
 func (c *client) GetUTXOs(
 	ctx context.Context,
 	addrs []ids.ShortID,
@@ -352,20 +546,85 @@ func (c *client) GetAtomicUTXOs(
 		return nil, ids.ShortID{}, ids.Empty, err
 	}
 
-	utxos := make([][]byte, len(res.UTXOs))
-	for i, utxo := range res.UTXOs {
-		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+	return parseUTXOsReply(res)
+}
+
+func (c *client) GetUTXOPages(ctx context.Context, queries []UTXOsQuery, options ...rpc.Option) ([]UTXOsPage, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]rpc.Request, len(queries))
+	replies := make([]api.GetUTXOsReply, len(queries))
+	for i, query := range queries {
+		requests[i] = rpc.Request{
+			Method: "avm.getUTXOs",
+			Params: &api.GetUTXOsArgs{
+				Addresses:   ids.ShortIDsToStrings(query.Addrs),
+				SourceChain: query.SourceChain,
+				Limit:       json.Uint32(query.Limit),
+				StartIndex: api.Index{
+					Address: query.StartAddress.String(),
+					UTXO:    query.StartUTXOID.String(),
+				},
+				Encoding: formatting.Hex,
+			},
+			Reply: &replies[i],
+		}
+	}
+	errs, err := rpc.SendBatchRequest(ctx, c.requester, requests, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]UTXOsPage, len(queries))
+	for i, err := range errs {
 		if err != nil {
-			return nil, ids.ShortID{}, ids.Empty, err
+			return nil, fmt.Errorf("couldn't get UTXOs of query %d: %w", i, err)
+		}
+		page := &pages[i]
+		page.UTXOs, page.EndAddress, page.EndUTXOID, err = parseUTXOsReply(&replies[i])
+		if err != nil {
+			return nil, err
 		}
-		utxos[i] = utxoBytes
 	}
-	endAddr, err := address.ParseToID(res.EndIndex.Address)
+	return pages, nil
+}
+
+func (c *client) GetExportedUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	destinationChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	res := &api.GetUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getExportedUTXOs", &api.GetExportedUTXOsArgs{
+		Addresses:        ids.ShortIDsToStrings(addrs),
+		DestinationChain: destinationChain,
+		Limit:            json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+		Encoding: formatting.Hex,
+	}, res, options...)
 	if err != nil {
 		return nil, ids.ShortID{}, ids.Empty, err
 	}
-	endUTXOID, err := ids.FromString(res.EndIndex.UTXO)
-	return utxos, endAddr, endUTXOID, err
+
+	return parseUTXOsReply(res)
+}
+
+func (c *client) GetAtomicUTXOStatus(ctx context.Context, utxoID ids.ID, chain string, options ...rpc.Option) (*api.GetAtomicUTXOStatusReply, error) {
+	res := &api.GetAtomicUTXOStatusReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAtomicUTXOStatus", &api.GetAtomicUTXOStatusArgs{
+		UTXOID: utxoID,
+		Chain:  chain,
+	}, res, options...)
+	return res, err
 }
 
 func (c *client) GetAssetDescription(ctx context.Context, assetID string, options ...rpc.Option) (*GetAssetDescriptionReply, error) {
@@ -376,6 +635,88 @@ func (c *client) GetAssetDescription(ctx context.Context, assetID string, option
 	return res, err
 }
 
+func (c *client) GetBalancesByLockState(ctx context.Context, addrs []ids.ShortID, options ...rpc.Option) ([]LockStateBalance, error) {
+	res := &GetBalancesByLockStateReply{}
+	err := c.requester.SendRequest(ctx, "avm.getBalancesByLockState", &api.JSONAddresses{
+		Addresses: ids.ShortIDsToStrings(addrs),
+	}, res, options...)
+	return res.Balances, err
+}
+
+func (c *client) GetNFTs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	assetID string,
+	groupID *uint32,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (*GetNFTsReply, error) {
+	res := &GetNFTsReply{}
+	err := c.requester.SendRequest(ctx, "avm.getNFTs", &GetNFTsArgs{
+		Addresses: ids.ShortIDsToStrings(addrs),
+		AssetID:   assetID,
+		GroupID:   (*json.Uint32)(groupID),
+		Limit:     json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetProperties(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	assetID string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) (*GetPropertiesReply, error) {
+	res := &GetPropertiesReply{}
+	err := c.requester.SendRequest(ctx, "avm.getProperties", &GetPropertiesArgs{
+		Addresses: ids.ShortIDsToStrings(addrs),
+		AssetID:   assetID,
+		Limit:     json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetAssetHolders(
+	ctx context.Context,
+	assetID string,
+	height *uint64,
+	cursor ids.ID,
+	limit uint64,
+	options ...rpc.Option,
+) (*GetAssetHoldersReply, error) {
+	res := &GetAssetHoldersReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssetHolders", &GetAssetHoldersArgs{
+		AssetID: assetID,
+		Height:  (*json.Uint64)(height),
+		Cursor:  cursor,
+		Limit:   json.Uint64(limit),
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetAssetSupply(ctx context.Context, assetID string, height *uint64, options ...rpc.Option) (*GetAssetSupplyReply, error) {
+	res := &GetAssetSupplyReply{}
+	err := c.requester.SendRequest(ctx, "avm.getAssetSupply", &GetAssetSupplyArgs{
+		AssetID: assetID,
+		Height:  (*json.Uint64)(height),
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetBalance(
 	ctx context.Context,
 	addr ids.ShortID,
@@ -766,3 +1107,22 @@ func (c *client) Export(
 	}, res, options...)
 	return res.TxID, err
 }
+
+// parseUTXOsReply returns the UTXOs in [res] and the index to continue fetching
+// UTXOs from.
+func parseUTXOsReply(res *api.GetUTXOsReply) ([][]byte, ids.ShortID, ids.ID, error) {
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxo := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, ids.ShortID{}, ids.Empty, err
+		}
+		utxos[i] = utxoBytes
+	}
+	endAddr, err := address.ParseToID(res.EndIndex.Address)
+	if err != nil {
+		return nil, ids.ShortID{}, ids.Empty, err
+	}
+	endUTXOID, err := ids.FromString(res.EndIndex.UTXO)
+	return utxos, endAddr, endUTXOID, err
+}
@@ -13,6 +13,7 @@ var DefaultConfig = Config{
 	Network:              network.DefaultConfig,
 	IndexTransactions:    false,
 	IndexAllowIncomplete: false,
+	IndexAssets:          false,
 	ChecksumsEnabled:     false,
 }
 
@@ -20,6 +21,7 @@ type Config struct {
 	Network              network.Config `json:"network"`
 	IndexTransactions    bool           `json:"index-transactions"`
 	IndexAllowIncomplete bool           `json:"index-allow-incomplete"`
+	IndexAssets          bool           `json:"index-assets"`
 	ChecksumsEnabled     bool           `json:"checksums-enabled"`
 }
 
@@ -33,6 +33,17 @@ func TestParseConfig(t *testing.T) {
 				ChecksumsEnabled:     true,
 			},
 		},
+		{
+			name:        "manually specified asset indexing enabled",
+			configBytes: []byte(`{"index-assets":true}`),
+			expectedConfig: Config{
+				Network:              network.DefaultConfig,
+				IndexTransactions:    DefaultConfig.IndexTransactions,
+				IndexAllowIncomplete: DefaultConfig.IndexAllowIncomplete,
+				IndexAssets:          true,
+				ChecksumsEnabled:     DefaultConfig.ChecksumsEnabled,
+			},
+		},
 		{
 			name:        "manually specified network value",
 			configBytes: []byte(`{"network":{"max-validator-set-staleness":1}}`),
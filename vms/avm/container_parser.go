@@ -0,0 +1,71 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/version"
+	"github.com/ava-labs/avalanchego/vms/avm/block"
+	"github.com/ava-labs/avalanchego/vms/avm/fxs"
+	"github.com/ava-labs/avalanchego/vms/components/index"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+var _ index.ContainerParser = (*containerParser)(nil)
+
+type containerParser struct {
+	ctx    *snow.Context
+	parser block.Parser
+}
+
+// NewContainerParser returns a parser of the blocks and txs that were accepted
+// on the chain of [ctx].
+func NewContainerParser(ctx *snow.Context) (index.ContainerParser, error) {
+	parser, err := block.NewParser(
+		version.GetDurangoTime(ctx.NetworkID),
+		[]fxs.Fx{
+			&secp256k1fx.Fx{},
+			&nftfx.Fx{},
+			&propertyfx.Fx{},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &containerParser{
+		ctx:    ctx,
+		parser: parser,
+	}, nil
+}
+
+func (p *containerParser) ParseBlock(blkBytes []byte) (uint64, []index.DecodedTx, error) {
+	blk, err := p.parser.ParseBlock(blkBytes)
+	if err != nil {
+		return 0, nil, fmt.Errorf("couldn't parse block: %w", err)
+	}
+	blk.InitCtx(p.ctx)
+
+	blkTxs := blk.Txs()
+	decodedTxs := make([]index.DecodedTx, len(blkTxs))
+	for i, tx := range blkTxs {
+		decodedTxs[i], err = index.NewDecodedTx(tx.ID(), tx.Unsigned, tx)
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+	return blk.Height(), decodedTxs, nil
+}
+
+func (p *containerParser) ParseTx(txBytes []byte) (index.DecodedTx, error) {
+	tx, err := p.parser.ParseTx(txBytes)
+	if err != nil {
+		return index.DecodedTx{}, fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	tx.Unsigned.InitCtx(p.ctx)
+	return index.NewDecodedTx(tx.ID(), tx.Unsigned, tx)
+}
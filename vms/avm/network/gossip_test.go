@@ -62,7 +62,7 @@ func TestGossipMempoolAdd(t *testing.T) {
 	metrics := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 1)
 
-	baseMempool, err := mempool.New("", metrics, toEngine)
+	baseMempool, err := mempool.New("", metrics, ids.Empty, toEngine)
 	require.NoError(err)
 
 	parser, err := txs.NewParser(time.Time{}, nil)
@@ -99,7 +99,7 @@ func TestGossipMempoolAddVerified(t *testing.T) {
 	metrics := prometheus.NewRegistry()
 	toEngine := make(chan common.Message, 1)
 
-	baseMempool, err := mempool.New("", metrics, toEngine)
+	baseMempool, err := mempool.New("", metrics, ids.Empty, toEngine)
 	require.NoError(err)
 
 	parser, err := txs.NewParser(time.Time{}, nil)
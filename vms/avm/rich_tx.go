@@ -0,0 +1,185 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+var _ txs.Visitor = (*txFunds)(nil)
+
+// RichTx is a tx annotated with the UTXOs that it spends, the funds that it
+// produces, and the fee that it burns
+type RichTx struct {
+	Tx      *txs.Tx           `json:"tx"`
+	Inputs  []avax.RichInput  `json:"inputs"`
+	Outputs []avax.RichOutput `json:"outputs"`
+	Burned  []avax.RichAmount `json:"burned"`
+}
+
+// txFunds collects the inputs and outputs of a tx that move funds
+type txFunds struct {
+	ins              []*avax.TransferableInput
+	importedIns      []*avax.TransferableInput
+	sourceChain      ids.ID
+	outs             []*avax.TransferableOutput
+	exportedOuts     []*avax.TransferableOutput
+	destinationChain ids.ID
+}
+
+func (f *txFunds) BaseTx(tx *txs.BaseTx) error {
+	f.ins = tx.Ins
+	f.outs = tx.Outs
+	return nil
+}
+
+func (f *txFunds) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) OperationTx(tx *txs.OperationTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) ImportTx(tx *txs.ImportTx) error {
+	f.importedIns = tx.ImportedIns
+	f.sourceChain = tx.SourceChain
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) ExportTx(tx *txs.ExportTx) error {
+	f.exportedOuts = tx.ExportedOuts
+	f.destinationChain = tx.DestinationChain
+	return f.BaseTx(&tx.BaseTx)
+}
+
+// getRichTx annotates [tx], which must have been initialized for JSON
+// marshalling. Assumes the context lock is held.
+func (s *Service) getRichTx(tx *txs.Tx) (*RichTx, error) {
+	funds := &txFunds{}
+	if err := tx.Unsigned.Visit(funds); err != nil {
+		return nil, err
+	}
+
+	var (
+		chainID = s.vm.ctx.ChainID
+		symbols = make(map[ids.ID]string)
+		richTx  = &RichTx{
+			Tx:      tx,
+			Inputs:  make([]avax.RichInput, 0, len(funds.ins)+len(funds.importedIns)),
+			Outputs: make([]avax.RichOutput, 0, len(funds.outs)+len(funds.exportedOuts)),
+		}
+	)
+	for _, in := range funds.ins {
+		richIn, err := s.getRichInput(in, chainID, symbols)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Inputs = append(richTx.Inputs, richIn)
+	}
+	for _, in := range funds.importedIns {
+		richIn, err := s.getRichInput(in, funds.sourceChain, symbols)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Inputs = append(richTx.Inputs, richIn)
+	}
+	for _, out := range funds.outs {
+		richOut, err := s.getRichOutput(out, chainID, symbols)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Outputs = append(richTx.Outputs, richOut)
+	}
+	for _, out := range funds.exportedOuts {
+		richOut, err := s.getRichOutput(out, funds.destinationChain, symbols)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Outputs = append(richTx.Outputs, richOut)
+	}
+
+	var err error
+	richTx.Burned, err = avax.GetBurned(richTx.Inputs, richTx.Outputs)
+	return richTx, err
+}
+
+// getRichInput annotates [in], which spends a UTXO on [sourceChain]. Only the
+// UTXOs of this chain are resolved.
+func (s *Service) getRichInput(in *avax.TransferableInput, sourceChain ids.ID, symbols map[ids.ID]string) (avax.RichInput, error) {
+	assetID := in.AssetID()
+	richIn := avax.RichInput{
+		UTXOID:      in.UTXOID.String(),
+		SourceChain: sourceChain,
+		AssetID:     assetID,
+		AssetSymbol: s.getAssetSymbol(assetID, symbols),
+		Amount:      avajson.Uint64(in.In.Amount()),
+	}
+	if sourceChain != s.vm.ctx.ChainID {
+		return richIn, nil
+	}
+
+	// Spent UTXOs are removed from the state, so they are read from the tx
+	// that produced them.
+	producingTx, err := s.vm.state.GetTx(in.TxID)
+	if err == database.ErrNotFound {
+		// UTXOs that were created in genesis may not have a producing tx
+		return richIn, nil
+	}
+	if err != nil {
+		return avax.RichInput{}, fmt.Errorf("couldn't get tx %s: %w", in.TxID, err)
+	}
+	utxos := producingTx.UTXOs()
+	if int(in.OutputIndex) >= len(utxos) {
+		return richIn, nil
+	}
+	owners, ok := getOwners(utxos[in.OutputIndex].Out)
+	if !ok {
+		return richIn, nil
+	}
+	richIn.Owner, err = avax.NewRichOwner(s.vm, sourceChain, owners)
+	return richIn, err
+}
+
+// getRichOutput annotates [out], which is on [destinationChain]
+func (s *Service) getRichOutput(out *avax.TransferableOutput, destinationChain ids.ID, symbols map[ids.ID]string) (avax.RichOutput, error) {
+	assetID := out.AssetID()
+	richOut := avax.RichOutput{
+		DestinationChain: destinationChain,
+		AssetID:          assetID,
+		AssetSymbol:      s.getAssetSymbol(assetID, symbols),
+		Amount:           avajson.Uint64(out.Out.Amount()),
+	}
+	owners, ok := getOwners(out.Out)
+	if !ok {
+		return richOut, nil
+	}
+	var err error
+	richOut.Owner, err = avax.NewRichOwner(s.vm, destinationChain, owners)
+	return richOut, err
+}
+
+// getAssetSymbol returns the symbol of [assetID], or the empty string if the
+// asset isn't known. Symbols are cached in [symbols].
+func (s *Service) getAssetSymbol(assetID ids.ID, symbols map[ids.ID]string) string {
+	if symbol, ok := symbols[assetID]; ok {
+		return symbol
+	}
+
+	var symbol string
+	if tx, err := s.vm.state.GetTx(assetID); err == nil {
+		if createAssetTx, ok := tx.Unsigned.(*txs.CreateAssetTx); ok {
+			symbol = createAssetTx.Symbol
+		}
+	}
+	symbols[assetID] = symbol
+	return symbol
+}
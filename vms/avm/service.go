@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"math"
 	"net/http"
+	"slices"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -21,15 +23,18 @@ import (
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/avm/state"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/components/keystore"
 	"github.com/ava-labs/avalanchego/vms/components/verify"
 	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
 	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
+	walletbuilder "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
 )
 
 const (
@@ -38,6 +43,9 @@ const (
 
 	// Max number of items allowed in a page
 	maxPageSize uint64 = 1024
+
+	// Max number of UTXOs searched by a single GetNFTs or GetProperties call
+	maxSearchedUTXOs = 16 * maxPageSize
 )
 
 var (
@@ -49,9 +57,13 @@ var (
 	errInvalidMintAmount  = errors.New("amount minted must be positive")
 	errNilTxID            = errors.New("nil transaction ID")
 	errNoAddresses        = errors.New("no addresses provided")
+	errRichTxNotJSON      = errors.New("rich txs are only supported with the json encoding")
 	errNoKeys             = errors.New("from addresses have no keys or funds")
 	errMissingPrivateKey  = errors.New("argument 'privateKey' not given")
 	errNotLinearized      = errors.New("chain is not linearized")
+	errAssetIndexDisabled = errors.New("asset index is disabled")
+	errAssetNotIndexed    = errors.New("asset isn't indexed")
+	errHeightNotAccepted  = errors.New("height hasn't been accepted")
 )
 
 // FormattedAssetID defines a JSON formatted struct containing an assetID as a string
@@ -217,6 +229,193 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, reply *api.JSO
 	return err
 }
 
+// SimulateTxReply is the response from calling SimulateTx
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// Error is the reason that the tx failed verification against the
+	// preferred state. If empty, the tx can be executed on top of it.
+	Error string `json:"error,omitempty"`
+	// UTXOs that the tx consumes
+	Consumed []APISimulatedUTXO `json:"consumed"`
+	// UTXOs that the tx produces
+	Produced []APISimulatedUTXO `json:"produced"`
+	// Amount of each asset that is consumed but not produced
+	Burned map[ids.ID]avajson.Uint64 `json:"burned"`
+}
+
+// SimulateTx verifies a tx against the currently preferred state, without
+// issuing it, and returns the changes that it would make. This is the same
+// base that the platform chain simulates txs against.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "simulateTx"),
+		logging.UserString("tx", args.Tx),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := s.vm.parser.ParseTx(txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	reply.TxID = tx.ID()
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.chainManager == nil {
+		return errNotLinearized
+	}
+	preferredState, err := state.NewDiff(s.vm.chainManager.Preferred(), s.vm.chainManager)
+	if err != nil {
+		return fmt.Errorf("couldn't get preferred state: %w", err)
+	}
+	if _, err := s.vm.chainManager.SimulateTx(tx); err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+
+	reply.Consumed, err = getSimulatedConsumed(preferredState, tx)
+	if err != nil {
+		return fmt.Errorf("couldn't get consumed UTXOs: %w", err)
+	}
+	utxos := tx.UTXOs()
+	reply.Produced = make([]APISimulatedUTXO, len(utxos))
+	for i, utxo := range utxos {
+		reply.Produced[i] = newAPISimulatedUTXO(utxo)
+	}
+	reply.Burned, err = getBurned(reply.Consumed, reply.Produced)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate burned amounts: %w", err)
+	}
+	return nil
+}
+
+// BuildTxArgs are the arguments for calling BuildTx
+type BuildTxArgs struct {
+	// Addresses whose UTXOs may be spent by the tx
+	api.JSONFromAddrs
+	// Address to send change to. Defaults to one of the [From] addresses.
+	api.JSONChangeAddr
+	// Type of the tx to build, e.g. "baseTx" or "export"
+	TxType string `json:"txType"`
+	// Parameters of the tx, in the format expected by [TxType]
+	Params   json.RawMessage     `json:"params"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// BuildTxReply is the response from calling BuildTx
+type BuildTxReply struct {
+	// Unsigned tx bytes. Each signer must sign the hash of these bytes.
+	UnsignedTx string              `json:"unsignedTx"`
+	Encoding   formatting.Encoding `json:"encoding"`
+	// Signatures needed to issue the tx, in the order that their credentials
+	// must be included in the signed tx
+	Signers []BuildTxSigner `json:"signers"`
+}
+
+// BuildTx builds an unsigned tx funded by the UTXOs of the provided addresses,
+// selecting UTXOs the same way as the wallet.
+func (s *Service) BuildTx(_ *http.Request, args *BuildTxArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "buildTx"),
+		zap.String("txType", args.TxType),
+	)
+
+	if len(args.From) == 0 {
+		return errNoAddresses
+	}
+	addrs, err := avax.ParseServiceAddresses(s.vm, args.From)
+	if err != nil {
+		return err
+	}
+
+	var changeAddr ids.ShortID
+	if args.ChangeAddr != "" {
+		changeAddr, err = avax.ParseServiceAddress(s.vm, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	backend := newBuildTxBackend(s.vm, addrs)
+	utx, err := s.buildTx(walletbuilder.New(addrs, backend), args.TxType, args.Params, buildTxOptions(changeAddr))
+	if err != nil {
+		return fmt.Errorf("couldn't build tx: %w", err)
+	}
+
+	reply.Signers, err = s.getBuildTxSigners(backend, utx)
+	if err != nil {
+		return fmt.Errorf("couldn't get signers: %w", err)
+	}
+
+	unsignedBytes, err := s.vm.parser.Codec().Marshal(txs.CodecVersion, &utx)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal tx: %w", err)
+	}
+	reply.UnsignedTx, err = formatting.Encode(args.Encoding, unsignedBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
+// MempoolTx describes a tx that is waiting in the mempool
+type MempoolTx struct {
+	TxID ids.ID         `json:"txID"`
+	Size avajson.Uint64 `json:"size"`
+	// Fee is the amount of AVAX that the tx burns
+	Fee avajson.Uint64 `json:"fee"`
+	// Age is the number of seconds that the tx has been in the mempool
+	Age avajson.Uint64 `json:"age"`
+}
+
+// GetMempoolReply defines the GetMempool replies returned from the API
+type GetMempoolReply struct {
+	// Txs are sorted from oldest to newest
+	Txs []MempoolTx `json:"txs"`
+}
+
+// GetMempool returns the txs that are waiting in the mempool
+func (s *Service) GetMempool(_ *http.Request, _ *struct{}, reply *GetMempoolReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getMempool"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.mempool == nil {
+		return errNotLinearized
+	}
+
+	now := s.vm.clock.Time()
+	infos := s.vm.mempool.TxInfos()
+	reply.Txs = make([]MempoolTx, len(infos))
+	for i, info := range infos {
+		var age time.Duration
+		if now.After(info.Added) {
+			age = now.Sub(info.Added)
+		}
+		reply.Txs[i] = MempoolTx{
+			TxID: info.TxID,
+			Size: avajson.Uint64(info.Size),
+			Fee:  avajson.Uint64(info.Burned),
+			Age:  avajson.Uint64(age / time.Second),
+		}
+	}
+	return nil
+}
+
 // GetTxStatusReply defines the GetTxStatus replies returned from the API
 type GetTxStatusReply struct {
 	Status choices.Status `json:"status"`
@@ -337,6 +536,9 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxRe
 	if args.TxID == ids.Empty {
 		return errNilTxID
 	}
+	if args.Rich && args.Encoding != formatting.JSON {
+		return errRichTxNotJSON
+	}
 
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
@@ -355,7 +557,14 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, reply *api.GetTxRe
 			typeToFxIndex: s.vm.typeToFxIndex,
 			fxs:           s.vm.fxs,
 		})
-		result = tx
+		if err != nil {
+			return err
+		}
+		if args.Rich {
+			result, err = s.getRichTx(tx)
+		} else {
+			result = tx
+		}
 	} else {
 		result, err = formatting.Encode(args.Encoding, tx.Bytes())
 	}
@@ -458,6 +667,124 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 		}
 	}
 
+	// Like tx verification, spendability uses the local clock.
+	now := s.vm.clock.Unix()
+	reply.Spendability = make([]*api.Spendability, len(utxos))
+	for i, utxo := range utxos {
+		owners, ok := getOwners(utxo.Out)
+		if !ok {
+			continue
+		}
+		reply.Spendability[i], err = avax.GetSpendability(s.vm, owners, addrSet, now)
+		if err != nil {
+			return err
+		}
+	}
+
+	endAddress, err := s.vm.FormatLocalAddress(endAddr)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+
+	reply.EndIndex.Address = endAddress
+	reply.EndIndex.UTXO = endUTXOID.String()
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.Encoding = args.Encoding
+	return nil
+}
+
+// getOwners returns the owners of [out], if [out] is an output of one of the
+// fxs that are owned by a secp256k1fx.OutputOwners
+func getOwners(out verify.State) (*secp256k1fx.OutputOwners, bool) {
+	switch out := out.(type) {
+	case *secp256k1fx.TransferOutput:
+		return &out.OutputOwners, true
+	case *secp256k1fx.MintOutput:
+		return &out.OutputOwners, true
+	case *nftfx.TransferOutput:
+		return &out.OutputOwners, true
+	case *nftfx.MintOutput:
+		return &out.OutputOwners, true
+	case *propertyfx.OwnedOutput:
+		return &out.OutputOwners, true
+	case *propertyfx.MintOutput:
+		return &out.OutputOwners, true
+	default:
+		return nil, false
+	}
+}
+
+// GetExportedUTXOs returns the UTXOs that this chain exported to the
+// destination chain that haven't been imported yet
+func (s *Service) GetExportedUTXOs(_ *http.Request, args *api.GetExportedUTXOsArgs, reply *api.GetUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getExportedUTXOs"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	destinationChain, err := s.vm.ctx.BCLookup.Lookup(args.DestinationChain)
+	if err != nil {
+		return fmt.Errorf("problem parsing destination chainID %q: %w", args.DestinationChain, err)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	startAddr := ids.ShortEmpty
+	startUTXO := ids.Empty
+	if args.StartIndex.Address != "" || args.StartIndex.UTXO != "" {
+		startAddr, err = avax.ParseServiceAddress(s.vm, args.StartIndex.Address)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index address %q: %w", args.StartIndex.Address, err)
+		}
+		startUTXO, err = ids.FromString(args.StartIndex.UTXO)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index utxo: %w", err)
+		}
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || int(maxPageSize) < limit {
+		limit = int(maxPageSize)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, endAddr, endUTXOID, err := s.vm.GetExportedUTXOs(
+		destinationChain,
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+	)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	reply.UTXOs = make([]string, len(utxos))
+	codec := s.vm.parser.Codec()
+	for i, utxo := range utxos {
+		b, err := codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("problem marshalling UTXO: %w", err)
+		}
+		reply.UTXOs[i], err = formatting.Encode(args.Encoding, b)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as string: %w", utxo.InputID(), err)
+		}
+	}
+
 	endAddress, err := s.vm.FormatLocalAddress(endAddr)
 	if err != nil {
 		return fmt.Errorf("problem formatting address: %w", err)
@@ -470,6 +797,133 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, reply *api.G
 	return nil
 }
 
+// GetAtomicUTXOStatus returns whether a UTXO that was exported to or from the
+// given chain is pending import or was imported by this chain
+func (s *Service) GetAtomicUTXOStatus(_ *http.Request, args *api.GetAtomicUTXOStatusArgs, reply *api.GetAtomicUTXOStatusReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAtomicUTXOStatus"),
+		zap.Stringer("utxoID", args.UTXOID),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := s.vm.ctx.BCLookup.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("problem parsing chainID %q: %w", args.Chain, err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	status, err := s.vm.GetAtomicUTXOStatus(chainID, args.UTXOID)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO status: %w", err)
+	}
+	if status == avax.AtomicUTXOUnknown {
+		importTxID, err := s.vm.state.GetImportTxID(args.UTXOID)
+		if err == nil {
+			status = avax.AtomicUTXOImported
+			reply.ImportTxID = &importTxID
+		} else if !errors.Is(err, database.ErrNotFound) {
+			return fmt.Errorf("problem retrieving import tx: %w", err)
+		}
+	}
+	reply.Status = status.String()
+	reply.ImportsIndexed = s.vm.state.ImportedUTXOsIndexed()
+	return nil
+}
+
+// LockStateBalance is the balance of an asset held by a set of addresses,
+// grouped by whether the addresses can spend it
+type LockStateBalance struct {
+	AssetID ids.ID `json:"assetID"`
+	// Unlocked is the amount the addresses can spend now
+	Unlocked avajson.Uint64 `json:"unlocked"`
+	// Locked is the amount that can't be spent until its locktime
+	Locked avajson.Uint64 `json:"locked"`
+	// RequiresOtherSigners is the amount that isn't locked but can't be spent
+	// without signatures from addresses that weren't provided
+	RequiresOtherSigners avajson.Uint64 `json:"requiresOtherSigners"`
+}
+
+// GetBalancesByLockStateReply is the response from a call to
+// GetBalancesByLockState
+type GetBalancesByLockStateReply struct {
+	Balances []LockStateBalance `json:"balances"`
+}
+
+// GetBalancesByLockState returns the balances held by a set of addresses,
+// grouped by asset and by whether the addresses can spend them now
+func (s *Service) GetBalancesByLockState(_ *http.Request, args *api.JSONAddresses, reply *GetBalancesByLockStateReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getBalancesByLockState"),
+		logging.UserStrings("addresses", args.Addresses),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, err := avax.GetAllUTXOs(s.vm.state, addrSet)
+	if err != nil {
+		return fmt.Errorf("couldn't get addresses' UTXOs: %w", err)
+	}
+
+	now := s.vm.clock.Unix()
+	balances := make(map[ids.ID]*LockStateBalance)
+	for _, utxo := range utxos {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+
+		assetID := utxo.AssetID()
+		balance, ok := balances[assetID]
+		if !ok {
+			balance = &LockStateBalance{
+				AssetID: assetID,
+			}
+			balances[assetID] = balance
+		}
+
+		var amount *avajson.Uint64
+		switch avax.GetLockState(&out.OutputOwners, addrSet, now) {
+		case avax.Unlocked:
+			amount = &balance.Unlocked
+		case avax.Locked:
+			amount = &balance.Locked
+		default:
+			amount = &balance.RequiresOtherSigners
+		}
+		newAmount, err := safemath.Add64(uint64(*amount), out.Amt)
+		if err != nil {
+			newAmount = math.MaxUint64
+		}
+		*amount = avajson.Uint64(newAmount)
+	}
+
+	reply.Balances = make([]LockStateBalance, 0, len(balances))
+	for _, balance := range balances {
+		reply.Balances = append(reply.Balances, *balance)
+	}
+	slices.SortFunc(reply.Balances, func(a, b LockStateBalance) int {
+		return a.AssetID.Compare(b.AssetID)
+	})
+	return nil
+}
+
 // GetAssetDescriptionArgs are arguments for passing into GetAssetDescription requests
 type GetAssetDescriptionArgs struct {
 	AssetID string `json:"assetID"`
@@ -516,6 +970,480 @@ func (s *Service) GetAssetDescription(_ *http.Request, args *GetAssetDescription
 	return nil
 }
 
+// AssetHolder is an owner of a positive balance of an asset
+type AssetHolder struct {
+	// OwnerID identifies the owner in the asset index
+	OwnerID ids.ID `json:"ownerID"`
+	Owner
+	Balance avajson.Uint64 `json:"balance"`
+}
+
+// GetAssetHoldersArgs are arguments for passing into GetAssetHolders requests
+type GetAssetHoldersArgs struct {
+	AssetID string `json:"assetID"`
+	// Height defaults to the height of the last accepted block if omitted
+	Height *avajson.Uint64 `json:"height"`
+	// Cursor is the owner ID to start at, as returned by a previous call
+	Cursor ids.ID `json:"cursor"`
+	// Limit defaults to the maximum page size if omitted or zero
+	Limit avajson.Uint64 `json:"limit"`
+}
+
+// GetAssetHoldersReply defines the GetAssetHolders replies returned from the
+// API
+type GetAssetHoldersReply struct {
+	Height  avajson.Uint64 `json:"height"`
+	Holders []AssetHolder  `json:"holders"`
+	// Cursor is the owner ID to start the next page at, or the empty ID if
+	// there are no more holders
+	Cursor ids.ID `json:"cursor"`
+}
+
+// GetAssetHolders returns the owners of a positive balance of an asset at a
+// given height. Requires the asset index to be enabled.
+func (s *Service) GetAssetHolders(_ *http.Request, args *GetAssetHoldersArgs, reply *GetAssetHoldersReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssetHolders"),
+		logging.UserString("assetID", args.AssetID),
+		zap.Stringer("cursor", args.Cursor),
+		zap.Uint64("limit", uint64(args.Limit)),
+	)
+
+	limit := uint64(args.Limit)
+	if limit > maxPageSize {
+		return fmt.Errorf("limit > maximum allowed (%d)", maxPageSize)
+	} else if limit == 0 {
+		limit = maxPageSize
+	}
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.assetIndex == nil {
+		return errAssetIndexDisabled
+	}
+
+	height, err := s.assetIndexHeight(args.Height)
+	if err != nil {
+		return err
+	}
+
+	holders, cursor, err := s.vm.assetIndex.GetHolders(assetID, height, args.Cursor, int(limit))
+	if err != nil {
+		return fmt.Errorf("couldn't get holders of asset %s: %w", assetID, err)
+	}
+
+	reply.Height = avajson.Uint64(height)
+	reply.Holders = make([]AssetHolder, len(holders))
+	for i, holder := range holders {
+		owner, err := s.formatOwner(holder.Owner)
+		if err != nil {
+			return err
+		}
+		reply.Holders[i] = AssetHolder{
+			OwnerID: holder.OwnerID,
+			Owner:   owner,
+			Balance: avajson.Uint64(holder.Balance),
+		}
+	}
+	reply.Cursor = cursor
+	return nil
+}
+
+// GetAssetSupplyArgs are arguments for passing into GetAssetSupply requests
+type GetAssetSupplyArgs struct {
+	AssetID string `json:"assetID"`
+	// Height defaults to the height of the last accepted block if omitted
+	Height *avajson.Uint64 `json:"height"`
+}
+
+// GetAssetSupplyReply defines the GetAssetSupply replies returned from the API
+type GetAssetSupplyReply struct {
+	Height avajson.Uint64 `json:"height"`
+	// Minted is the amount of the asset that was created on this chain
+	Minted avajson.Uint64 `json:"minted"`
+	// Burned is the amount of the asset that was paid as fees
+	Burned avajson.Uint64 `json:"burned"`
+	// Locked is the amount of the asset held by owners whose locktime hasn't
+	// passed
+	Locked avajson.Uint64 `json:"locked"`
+	// Imported is the amount of the asset imported from other chains
+	Imported avajson.Uint64 `json:"imported"`
+	// Exported is the amount of the asset exported to other chains
+	Exported avajson.Uint64 `json:"exported"`
+}
+
+// GetAssetSupply returns the supply of an asset at a given height. Requires the
+// asset index to be enabled.
+func (s *Service) GetAssetSupply(_ *http.Request, args *GetAssetSupplyArgs, reply *GetAssetSupplyReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getAssetSupply"),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	assetID, err := s.vm.lookupAssetID(args.AssetID)
+	if err != nil {
+		return err
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	if s.vm.assetIndex == nil {
+		return errAssetIndexDisabled
+	}
+
+	height, err := s.assetIndexHeight(args.Height)
+	if err != nil {
+		return err
+	}
+
+	// Balances are locked if their locktime is after the time of the block at
+	// [height].
+	timestamp := uint64(s.vm.clock.Unix())
+	if s.vm.chainManager != nil {
+		blkID, err := s.vm.state.GetBlockIDAtHeight(height)
+		if err != nil {
+			return fmt.Errorf("couldn't get block at height %d: %w", height, err)
+		}
+		blk, err := s.vm.state.GetBlock(blkID)
+		if err != nil {
+			return fmt.Errorf("couldn't get block %s: %w", blkID, err)
+		}
+		timestamp = uint64(blk.Timestamp().Unix())
+	}
+
+	supply, err := s.vm.assetIndex.GetSupply(assetID, height, timestamp)
+	if err == database.ErrNotFound {
+		return fmt.Errorf("%w: %s at height %d", errAssetNotIndexed, assetID, height)
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get supply of asset %s: %w", assetID, err)
+	}
+
+	reply.Height = avajson.Uint64(height)
+	reply.Minted = avajson.Uint64(supply.Minted)
+	reply.Burned = avajson.Uint64(supply.Burned)
+	reply.Locked = avajson.Uint64(supply.Locked)
+	reply.Imported = avajson.Uint64(supply.Imported)
+	reply.Exported = avajson.Uint64(supply.Exported)
+	return nil
+}
+
+// assetIndexHeight returns [height] if it was provided. Otherwise, returns the
+// height of the last accepted block, or 0 if the chain isn't linearized.
+//
+// Assumes [s.vm.ctx.Lock] is held.
+func (s *Service) assetIndexHeight(height *avajson.Uint64) (uint64, error) {
+	if s.vm.chainManager == nil {
+		// Every tx accepted before the chain was linearized is indexed at
+		// height 0.
+		return 0, nil
+	}
+
+	lastAcceptedID := s.vm.state.GetLastAccepted()
+	lastAccepted, err := s.vm.state.GetBlock(lastAcceptedID)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	lastAcceptedHeight := lastAccepted.Height()
+	if height == nil {
+		return lastAcceptedHeight, nil
+	}
+	if uint64(*height) > lastAcceptedHeight {
+		return 0, fmt.Errorf("%w: %d > %d", errHeightNotAccepted, *height, lastAcceptedHeight)
+	}
+	return uint64(*height), nil
+}
+
+// Owner is the owner of an output
+type Owner struct {
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// NFT is an unspent nftfx.TransferOutput
+type NFT struct {
+	UTXOID  avax.UTXOID    `json:"utxoID"`
+	AssetID ids.ID         `json:"assetID"`
+	GroupID avajson.Uint32 `json:"groupID"`
+	// Payload is encoded with the requested encoding
+	Payload string `json:"payload"`
+	Owner
+}
+
+// GetNFTsArgs are arguments for passing into GetNFTs requests
+type GetNFTsArgs struct {
+	// Addresses that own the NFTs. Required, because UTXOs are only indexed
+	// by address.
+	Addresses []string `json:"addresses"`
+	// AssetID of the NFTs. If omitted, NFTs of every asset are returned.
+	AssetID string `json:"assetID"`
+	// GroupID of the NFTs. If omitted, NFTs of every group are returned.
+	GroupID *avajson.Uint32 `json:"groupID"`
+	// Limit defaults to the maximum page size if omitted or zero
+	Limit avajson.Uint32 `json:"limit"`
+	// StartIndex is the EndIndex returned by a previous call
+	StartIndex api.Index           `json:"startIndex"`
+	Encoding   formatting.Encoding `json:"encoding"`
+}
+
+// GetNFTsReply defines the GetNFTs replies returned from the API
+type GetNFTsReply struct {
+	NumFetched avajson.Uint64 `json:"numFetched"`
+	NFTs       []NFT          `json:"nfts"`
+	EndIndex   api.Index      `json:"endIndex"`
+	// Complete is true if every UTXO of the addresses was searched. Otherwise,
+	// more NFTs may be returned by passing EndIndex as the StartIndex of the
+	// next call, even if NumFetched is less than the limit.
+	Complete bool                `json:"complete"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// GetNFTs returns the NFTs owned by a set of addresses, optionally filtered by
+// asset and group. Looking up NFTs by asset or group alone isn't supported.
+func (s *Service) GetNFTs(_ *http.Request, args *GetNFTsArgs, reply *GetNFTsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getNFTs"),
+		logging.UserStrings("addresses", args.Addresses),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	filterAsset := args.AssetID != ""
+	var (
+		assetID ids.ID
+		err     error
+	)
+	if filterAsset {
+		assetID, err = s.vm.lookupAssetID(args.AssetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	utxos, endIndex, complete, err := s.getFilteredUTXOs(
+		args.Addresses,
+		args.StartIndex,
+		int(args.Limit),
+		func(utxo *avax.UTXO) bool {
+			out, ok := utxo.Out.(*nftfx.TransferOutput)
+			if !ok {
+				return false
+			}
+			if filterAsset && utxo.AssetID() != assetID {
+				return false
+			}
+			return args.GroupID == nil || out.GroupID == uint32(*args.GroupID)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	reply.NFTs = make([]NFT, len(utxos))
+	for i, utxo := range utxos {
+		out := utxo.Out.(*nftfx.TransferOutput)
+		owner, err := s.formatOwner(&out.OutputOwners)
+		if err != nil {
+			return err
+		}
+		payload, err := formatting.Encode(args.Encoding, out.Payload)
+		if err != nil {
+			return fmt.Errorf("couldn't encode payload of UTXO %s: %w", utxo.InputID(), err)
+		}
+		reply.NFTs[i] = NFT{
+			UTXOID:  utxo.UTXOID,
+			AssetID: utxo.AssetID(),
+			GroupID: avajson.Uint32(out.GroupID),
+			Payload: payload,
+			Owner:   owner,
+		}
+	}
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.EndIndex = endIndex
+	reply.Complete = complete
+	reply.Encoding = args.Encoding
+	return nil
+}
+
+// Property is an unspent propertyfx.OwnedOutput
+type Property struct {
+	UTXOID  avax.UTXOID `json:"utxoID"`
+	AssetID ids.ID      `json:"assetID"`
+	Owner
+}
+
+// GetPropertiesArgs are arguments for passing into GetProperties requests
+type GetPropertiesArgs struct {
+	// Addresses that own the properties. Required, because UTXOs are only
+	// indexed by address.
+	Addresses []string `json:"addresses"`
+	// AssetID of the properties. If omitted, properties of every asset are
+	// returned.
+	AssetID string `json:"assetID"`
+	// Limit defaults to the maximum page size if omitted or zero
+	Limit avajson.Uint32 `json:"limit"`
+	// StartIndex is the EndIndex returned by a previous call
+	StartIndex api.Index `json:"startIndex"`
+}
+
+// GetPropertiesReply defines the GetProperties replies returned from the API
+type GetPropertiesReply struct {
+	NumFetched avajson.Uint64 `json:"numFetched"`
+	Properties []Property     `json:"properties"`
+	EndIndex   api.Index      `json:"endIndex"`
+	// Complete is true if every UTXO of the addresses was searched. Otherwise,
+	// more properties may be returned by passing EndIndex as the StartIndex
+	// of the next call, even if NumFetched is less than the limit.
+	Complete bool `json:"complete"`
+}
+
+// GetProperties returns the properties owned by a set of addresses,
+// optionally filtered by asset. Looking up properties by asset alone isn't
+// supported.
+func (s *Service) GetProperties(_ *http.Request, args *GetPropertiesArgs, reply *GetPropertiesReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "avm"),
+		zap.String("method", "getProperties"),
+		logging.UserStrings("addresses", args.Addresses),
+		logging.UserString("assetID", args.AssetID),
+	)
+
+	filterAsset := args.AssetID != ""
+	var (
+		assetID ids.ID
+		err     error
+	)
+	if filterAsset {
+		assetID, err = s.vm.lookupAssetID(args.AssetID)
+		if err != nil {
+			return err
+		}
+	}
+
+	utxos, endIndex, complete, err := s.getFilteredUTXOs(
+		args.Addresses,
+		args.StartIndex,
+		int(args.Limit),
+		func(utxo *avax.UTXO) bool {
+			_, ok := utxo.Out.(*propertyfx.OwnedOutput)
+			return ok && (!filterAsset || utxo.AssetID() == assetID)
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	reply.Properties = make([]Property, len(utxos))
+	for i, utxo := range utxos {
+		out := utxo.Out.(*propertyfx.OwnedOutput)
+		owner, err := s.formatOwner(&out.OutputOwners)
+		if err != nil {
+			return err
+		}
+		reply.Properties[i] = Property{
+			UTXOID:  utxo.UTXOID,
+			AssetID: utxo.AssetID(),
+			Owner:   owner,
+		}
+	}
+	reply.NumFetched = avajson.Uint64(len(utxos))
+	reply.EndIndex = endIndex
+	reply.Complete = complete
+	return nil
+}
+
+// getFilteredUTXOs returns at most [limit] UTXOs referencing [addresses] for
+// which [filter] returns true, starting after [startIndex], the index to start
+// the next page at and whether every UTXO was searched. At most
+// [maxSearchedUTXOs] UTXOs are searched, so that the lock isn't held for too
+// long.
+func (s *Service) getFilteredUTXOs(
+	addresses []string,
+	startIndex api.Index,
+	limit int,
+	filter func(*avax.UTXO) bool,
+) ([]*avax.UTXO, api.Index, bool, error) {
+	if len(addresses) == 0 {
+		return nil, api.Index{}, false, errNoAddresses
+	}
+	if len(addresses) > maxGetUTXOsAddrs {
+		return nil, api.Index{}, false, fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(addresses), maxGetUTXOsAddrs)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.vm, addresses)
+	if err != nil {
+		return nil, api.Index{}, false, err
+	}
+
+	startAddr := ids.ShortEmpty
+	startUTXO := ids.Empty
+	if startIndex.Address != "" || startIndex.UTXO != "" {
+		startAddr, err = avax.ParseServiceAddress(s.vm, startIndex.Address)
+		if err != nil {
+			return nil, api.Index{}, false, fmt.Errorf("couldn't parse start index address %q: %w", startIndex.Address, err)
+		}
+		startUTXO, err = ids.FromString(startIndex.UTXO)
+		if err != nil {
+			return nil, api.Index{}, false, fmt.Errorf("couldn't parse start index utxo: %w", err)
+		}
+	}
+
+	if limit <= 0 || int(maxPageSize) < limit {
+		limit = int(maxPageSize)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, endAddr, endUTXOID, complete, err := avax.GetFilteredPaginatedUTXOs(
+		s.vm.state,
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+		int(maxSearchedUTXOs),
+		filter,
+	)
+	if err != nil {
+		return nil, api.Index{}, false, fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	endAddress, err := s.vm.FormatLocalAddress(endAddr)
+	if err != nil {
+		return nil, api.Index{}, false, fmt.Errorf("problem formatting address: %w", err)
+	}
+	return utxos, api.Index{
+		Address: endAddress,
+		UTXO:    endUTXOID.String(),
+	}, complete, nil
+}
+
+func (s *Service) formatOwner(owner *secp256k1fx.OutputOwners) (Owner, error) {
+	addrs := make([]string, len(owner.Addrs))
+	for i, addr := range owner.Addrs {
+		var err error
+		addrs[i], err = s.vm.FormatLocalAddress(addr)
+		if err != nil {
+			return Owner{}, fmt.Errorf("couldn't format address %s: %w", addr, err)
+		}
+	}
+	return Owner{
+		Locktime:  avajson.Uint64(owner.Locktime),
+		Threshold: avajson.Uint32(owner.Threshold),
+		Addresses: addrs,
+	}, nil
+}
+
 // GetBalanceArgs are arguments for passing into GetBalance requests
 type GetBalanceArgs struct {
 	Address        string `json:"address"`
@@ -23,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/choices"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
@@ -69,6 +70,152 @@ func TestServiceIssueTx(t *testing.T) {
 	require.Equal(tx.ID(), txReply.TxID)
 }
 
+func TestServiceGetMempool(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	reply := &GetMempoolReply{}
+	require.NoError(env.service.GetMempool(nil, nil, reply))
+	require.Empty(reply.Txs)
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	_, err := env.vm.issueTxFromRPC(tx)
+	require.NoError(err)
+
+	reply = &GetMempoolReply{}
+	require.NoError(env.service.GetMempool(nil, nil, reply))
+	require.Equal([]MempoolTx{{
+		TxID: tx.ID(),
+		Size: avajson.Uint64(len(tx.Bytes())),
+		Fee:  avajson.Uint64(startBalance),
+	}}, reply.Txs)
+}
+
+func TestServiceBuildTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	assetID := env.genesisTx.ID()
+	fromAddrStr, err := env.vm.FormatLocalAddress(keys[0].Address())
+	require.NoError(err)
+	toAddrStr, err := env.vm.FormatLocalAddress(keys[1].Address())
+	require.NoError(err)
+	otherToAddrStr, err := env.vm.FormatLocalAddress(keys[2].Address())
+	require.NoError(err)
+
+	// Outputs with multiple addresses must have their addresses sorted,
+	// regardless of the order they were provided in.
+	params, err := json.Marshal(&BuildBaseTxParams{
+		Outputs: []BuildTxOutput{{
+			AssetID: assetID.String(),
+			Amount:  500,
+			BuildTxOwner: BuildTxOwner{
+				Threshold: 1,
+				Addresses: []string{toAddrStr, otherToAddrStr},
+			},
+		}},
+	})
+	require.NoError(err)
+
+	args := &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fromAddrStr}},
+		TxType:        BaseTxType,
+		Params:        params,
+		Encoding:      formatting.Hex,
+	}
+	reply := &BuildTxReply{}
+	require.NoError(env.service.BuildTx(nil, args, reply))
+	require.Equal(formatting.Hex, reply.Encoding)
+	require.NotEmpty(reply.Signers)
+	for _, signer := range reply.Signers {
+		require.Equal([]string{fromAddrStr}, signer.Addresses)
+	}
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.UnsignedTx)
+	require.NoError(err)
+	var utx txs.UnsignedTx
+	_, err = env.vm.parser.Codec().Unmarshal(unsignedBytes, &utx)
+	require.NoError(err)
+	for _, out := range utx.(*txs.BaseTx).Outs {
+		require.True(utils.IsSortedAndUnique(out.Out.(*secp256k1fx.TransferOutput).Addrs))
+	}
+
+	tx := &txs.Tx{Unsigned: utx}
+	signers := make([][]*secp256k1.PrivateKey, len(reply.Signers))
+	for i := range signers {
+		signers[i] = []*secp256k1.PrivateKey{keys[0]}
+	}
+	require.NoError(tx.SignSECP256K1Fx(env.vm.parser.Codec(), signers))
+
+	issueAndAccept(require, env.vm, env.issuer, tx)
+
+	args.TxType = "unknown"
+	err = env.service.BuildTx(nil, args, reply)
+	require.ErrorIs(err, errUnknownBuildTxType)
+}
+
+func TestServiceSimulateTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	assetID := tx.Unsigned.(*txs.BaseTx).Ins[0].AssetID()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+	reply := &SimulateTxReply{}
+	require.NoError(env.service.SimulateTx(nil, args, reply))
+	require.Equal(tx.ID(), reply.TxID)
+	require.Empty(reply.Error)
+	require.Equal(
+		[]APISimulatedUTXO{{
+			UTXOID:  tx.Unsigned.(*txs.BaseTx).Ins[0].UTXOID.String(),
+			AssetID: assetID,
+			Amount:  avajson.Uint64(startBalance),
+		}},
+		reply.Consumed,
+	)
+	require.Empty(reply.Produced)
+	require.Equal(map[ids.ID]avajson.Uint64{assetID: avajson.Uint64(startBalance)}, reply.Burned)
+
+	// Simulating the tx doesn't consume its inputs.
+	issueAndAccept(require, env.vm, env.issuer, tx)
+
+	// Once the inputs are consumed, the verification error is reported.
+	reply = &SimulateTxReply{}
+	require.NoError(env.service.SimulateTx(nil, args, reply))
+	require.NotEmpty(reply.Error)
+	require.Empty(reply.Consumed)
+}
+
 func TestServiceGetTxStatus(t *testing.T) {
 	require := require.New(t)
 
@@ -104,6 +251,313 @@ func TestServiceGetTxStatus(t *testing.T) {
 }
 
 // Test the GetBalance method when argument Strict is true
+func TestServiceGetAssetSupply(t *testing.T) {
+	require := require.New(t)
+
+	vmDynamicConfig := DefaultConfig
+	vmDynamicConfig.IndexAssets = true
+	env := setup(t, &envConfig{
+		vmDynamicConfig: &vmDynamicConfig,
+	})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	assetID := env.genesisTx.ID()
+	var minted uint64
+	for _, utxo := range env.genesisTx.UTXOs() {
+		if out, ok := utxo.Out.(*secp256k1fx.TransferOutput); ok {
+			minted += out.Amt
+		}
+	}
+
+	genesisHolders := &GetAssetHoldersReply{}
+	require.NoError(env.service.GetAssetHolders(nil, &GetAssetHoldersArgs{
+		AssetID: assetID.String(),
+	}, genesisHolders))
+	require.Zero(genesisHolders.Height)
+	require.NotEmpty(genesisHolders.Holders)
+	require.Equal(ids.Empty, genesisHolders.Cursor)
+
+	// The tx burns [startBalance]
+	tx := newTx(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.parser, "AVAX")
+	issueAndAccept(require, env.vm, env.issuer, tx)
+
+	supplyReply := &GetAssetSupplyReply{}
+	require.NoError(env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: assetID.String(),
+	}, supplyReply))
+	require.Equal(avajson.Uint64(1), supplyReply.Height)
+	require.Equal(avajson.Uint64(minted), supplyReply.Minted)
+	require.Equal(avajson.Uint64(startBalance), supplyReply.Burned)
+
+	height := avajson.Uint64(0)
+	supplyReply = &GetAssetSupplyReply{}
+	require.NoError(env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: assetID.String(),
+		Height:  &height,
+	}, supplyReply))
+	require.Equal(avajson.Uint64(minted), supplyReply.Minted)
+	require.Zero(supplyReply.Burned)
+
+	// The holders at genesis don't change
+	holdersReply := &GetAssetHoldersReply{}
+	require.NoError(env.service.GetAssetHolders(nil, &GetAssetHoldersArgs{
+		AssetID: assetID.String(),
+		Height:  &height,
+	}, holdersReply))
+	require.Equal(genesisHolders, holdersReply)
+
+	height = 2
+	err := env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: assetID.String(),
+		Height:  &height,
+	}, supplyReply)
+	require.ErrorIs(err, errHeightNotAccepted)
+}
+
+func TestServiceGetAssetSupplyDisabled(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	env.vm.ctx.Lock.Unlock()
+
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	err := env.service.GetAssetSupply(nil, &GetAssetSupplyArgs{
+		AssetID: env.genesisTx.ID().String(),
+	}, &GetAssetSupplyReply{})
+	require.ErrorIs(err, errAssetIndexDisabled)
+}
+
+func TestServiceGetNFTsAndProperties(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{
+		additionalFxs: []*common.Fx{{
+			ID: propertyfx.ID,
+			Fx: &propertyfx.Fx{},
+		}},
+	})
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+	owners := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}
+
+	// Two NFTs of [nftAssetID] in groups 0 and 1, one NFT of another asset
+	// and a property
+	nftAssetID := ids.GenerateTestID()
+	propertyAssetID := ids.GenerateTestID()
+	newUTXO := func(assetID ids.ID, out verify.State) *avax.UTXO {
+		utxo := &avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: assetID},
+			Out:    out,
+		}
+		env.vm.state.AddUTXO(utxo)
+		return utxo
+	}
+	group0UTXO := newUTXO(nftAssetID, &nftfx.TransferOutput{
+		GroupID:      0,
+		Payload:      []byte{0},
+		OutputOwners: owners,
+	})
+	newUTXO(nftAssetID, &nftfx.TransferOutput{
+		GroupID:      1,
+		Payload:      []byte{1},
+		OutputOwners: owners,
+	})
+	newUTXO(ids.GenerateTestID(), &nftfx.TransferOutput{
+		GroupID:      0,
+		Payload:      []byte{2},
+		OutputOwners: owners,
+	})
+	propertyUTXO := newUTXO(propertyAssetID, &propertyfx.OwnedOutput{
+		OutputOwners: owners,
+	})
+	newUTXO(ids.GenerateTestID(), &secp256k1fx.TransferOutput{
+		Amt:          1,
+		OutputOwners: owners,
+	})
+	require.NoError(env.vm.state.Commit())
+
+	env.vm.ctx.Lock.Unlock()
+
+	nftsReply := &GetNFTsReply{}
+	require.NoError(env.service.GetNFTs(nil, &GetNFTsArgs{
+		Addresses: []string{addrStr},
+		Encoding:  formatting.Hex,
+	}, nftsReply))
+	require.Len(nftsReply.NFTs, 3)
+	require.True(nftsReply.Complete)
+
+	// Paginate over the NFTs
+	nftsReply = &GetNFTsReply{}
+	require.NoError(env.service.GetNFTs(nil, &GetNFTsArgs{
+		Addresses: []string{addrStr},
+		Limit:     2,
+		Encoding:  formatting.Hex,
+	}, nftsReply))
+	require.Len(nftsReply.NFTs, 2)
+	require.False(nftsReply.Complete)
+	nextNFTsReply := &GetNFTsReply{}
+	require.NoError(env.service.GetNFTs(nil, &GetNFTsArgs{
+		Addresses:  []string{addrStr},
+		Limit:      2,
+		StartIndex: nftsReply.EndIndex,
+		Encoding:   formatting.Hex,
+	}, nextNFTsReply))
+	require.Len(nextNFTsReply.NFTs, 1)
+	require.True(nextNFTsReply.Complete)
+
+	groupID := avajson.Uint32(0)
+	nftsReply = &GetNFTsReply{}
+	require.NoError(env.service.GetNFTs(nil, &GetNFTsArgs{
+		Addresses: []string{addrStr},
+		AssetID:   nftAssetID.String(),
+		GroupID:   &groupID,
+		Encoding:  formatting.Hex,
+	}, nftsReply))
+	expectedPayload, err := formatting.Encode(formatting.Hex, []byte{0})
+	require.NoError(err)
+	require.Equal([]NFT{{
+		UTXOID:  group0UTXO.UTXOID,
+		AssetID: nftAssetID,
+		GroupID: 0,
+		Payload: expectedPayload,
+		Owner: Owner{
+			Threshold: 1,
+			Addresses: []string{addrStr},
+		},
+	}}, nftsReply.NFTs)
+
+	propertiesReply := &GetPropertiesReply{}
+	require.NoError(env.service.GetProperties(nil, &GetPropertiesArgs{
+		Addresses: []string{addrStr},
+	}, propertiesReply))
+	require.Equal([]Property{{
+		UTXOID:  propertyUTXO.UTXOID,
+		AssetID: propertyAssetID,
+		Owner: Owner{
+			Threshold: 1,
+			Addresses: []string{addrStr},
+		},
+	}}, propertiesReply.Properties)
+	require.True(propertiesReply.Complete)
+
+	// NFTs can't be looked up by asset alone
+	err = env.service.GetNFTs(nil, &GetNFTsArgs{
+		AssetID:  nftAssetID.String(),
+		Encoding: formatting.Hex,
+	}, &GetNFTsReply{})
+	require.ErrorIs(err, errNoAddresses)
+}
+
+func TestServiceGetBalancesByLockState(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	addr := ids.GenerateTestShortID()
+	addrStr, err := env.vm.FormatLocalAddress(addr)
+	require.NoError(err)
+	otherAddr := ids.GenerateTestShortID()
+	otherAddrStr, err := env.vm.FormatLocalAddress(otherAddr)
+	require.NoError(err)
+
+	assetID := ids.GenerateTestID()
+	now := env.vm.clock.Unix()
+	owners := []secp256k1fx.OutputOwners{
+		{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		},
+		{
+			Locktime:  now + 1,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{addr},
+		},
+		{
+			Threshold: 2,
+			Addrs:     []ids.ShortID{addr, otherAddr},
+		},
+	}
+	for i, owner := range owners {
+		owner.Sort()
+		env.vm.state.AddUTXO(&avax.UTXO{
+			UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+			Asset:  avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          uint64(i + 1),
+				OutputOwners: owner,
+			},
+		})
+	}
+	require.NoError(env.vm.state.Commit())
+
+	env.vm.ctx.Lock.Unlock()
+
+	balancesReply := &GetBalancesByLockStateReply{}
+	require.NoError(env.service.GetBalancesByLockState(nil, &api.JSONAddresses{
+		Addresses: []string{addrStr},
+	}, balancesReply))
+	require.Equal([]LockStateBalance{{
+		AssetID:              assetID,
+		Unlocked:             1,
+		Locked:               2,
+		RequiresOtherSigners: 3,
+	}}, balancesReply.Balances)
+
+	// The multisig UTXO can be spent by both addresses
+	balancesReply = &GetBalancesByLockStateReply{}
+	require.NoError(env.service.GetBalancesByLockState(nil, &api.JSONAddresses{
+		Addresses: []string{addrStr, otherAddrStr},
+	}, balancesReply))
+	require.Equal([]LockStateBalance{{
+		AssetID:  assetID,
+		Unlocked: 4,
+		Locked:   2,
+	}}, balancesReply.Balances)
+
+	// Every UTXO is returned with its spendability
+	utxosReply := &api.GetUTXOsReply{}
+	require.NoError(env.service.GetUTXOs(nil, &api.GetUTXOsArgs{
+		Addresses: []string{addrStr},
+		Encoding:  formatting.Hex,
+	}, utxosReply))
+	require.Len(utxosReply.Spendability, len(utxosReply.UTXOs))
+	spendableByLocktime := make(map[uint64]int)
+	for _, spendability := range utxosReply.Spendability {
+		require.NotNil(spendability)
+		if spendability.Spendable {
+			spendableByLocktime[uint64(spendability.Locktime)]++
+		}
+	}
+	require.Equal(map[uint64]int{0: 1}, spendableByLocktime)
+}
+
 func TestServiceGetBalanceStrict(t *testing.T) {
 	require := require.New(t)
 
@@ -611,6 +1065,68 @@ func TestServiceGetTxJSON_BaseTx(t *testing.T) {
 	require.Equal(expectedReplyTxString, string(replyTxBytes))
 }
 
+func TestServiceGetRichTx_BaseTx(t *testing.T) {
+	require := require.New(t)
+
+	env := setup(t, &envConfig{})
+	env.vm.ctx.Lock.Unlock()
+	defer func() {
+		env.vm.ctx.Lock.Lock()
+		require.NoError(env.vm.Shutdown(context.Background()))
+		env.vm.ctx.Lock.Unlock()
+	}()
+
+	newTx := newAvaxBaseTxWithOutputs(t, env.genesisBytes, env.vm.ctx.ChainID, env.vm.TxFee, env.vm.parser)
+	issueAndAccept(require, env.vm, env.issuer, newTx)
+
+	// Rich txs are only supported with the JSON encoding
+	err := env.service.GetTx(nil, &api.GetTxArgs{
+		TxID:     newTx.ID(),
+		Encoding: formatting.Hex,
+		Rich:     true,
+	}, &api.GetTxReply{})
+	require.ErrorIs(err, errRichTxNotJSON)
+
+	reply := api.GetTxReply{}
+	require.NoError(env.service.GetTx(nil, &api.GetTxArgs{
+		TxID:     newTx.ID(),
+		Encoding: formatting.JSON,
+		Rich:     true,
+	}, &reply))
+	require.Equal(formatting.JSON, reply.Encoding)
+
+	var richTx struct {
+		Inputs  []avax.RichInput  `json:"inputs"`
+		Outputs []avax.RichOutput `json:"outputs"`
+		Burned  []avax.RichAmount `json:"burned"`
+	}
+	require.NoError(json.Unmarshal(reply.Tx, &richTx))
+
+	utx := newTx.Unsigned.(*txs.BaseTx)
+	assetID := utx.Ins[0].AssetID()
+	createAssetTx, err := env.vm.state.GetTx(assetID)
+	require.NoError(err)
+	symbol := createAssetTx.Unsigned.(*txs.CreateAssetTx).Symbol
+
+	// The spent UTXO is resolved from the genesis tx that created it
+	require.Len(richTx.Inputs, 1)
+	require.Equal(env.vm.ctx.ChainID, richTx.Inputs[0].SourceChain)
+	require.Equal(symbol, richTx.Inputs[0].AssetSymbol)
+	require.Equal(avajson.Uint64(utx.Ins[0].In.Amount()), richTx.Inputs[0].Amount)
+	require.NotNil(richTx.Inputs[0].Owner)
+	require.NotEmpty(richTx.Inputs[0].Owner.Addresses)
+
+	require.Len(richTx.Outputs, 1)
+	require.Equal(avajson.Uint64(utx.Outs[0].Out.Amount()), richTx.Outputs[0].Amount)
+	require.Equal([]string{"X-testing1lnk637g0edwnqc2tn8tel39652fswa3xk4r65e"}, richTx.Outputs[0].Owner.Addresses)
+
+	require.Equal([]avax.RichAmount{{
+		AssetID:     assetID,
+		AssetSymbol: symbol,
+		Amount:      avajson.Uint64(env.vm.TxFee),
+	}}, richTx.Burned)
+}
+
 func TestServiceGetTxJSON_ExportTx(t *testing.T) {
 	require := require.New(t)
 
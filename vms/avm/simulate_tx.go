@@ -0,0 +1,91 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avm
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/state"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+// APISimulatedUTXO is a UTXO consumed or produced by a simulated tx
+type APISimulatedUTXO struct {
+	UTXOID  string `json:"utxoID"`
+	AssetID ids.ID `json:"assetID"`
+	// Amount is 0 for outputs that don't hold a fungible amount, such as NFTs.
+	Amount avajson.Uint64 `json:"amount"`
+}
+
+// getSimulatedConsumed returns the UTXOs that [tx] consumes. Local UTXOs are
+// read from [chain], which must be the state that [tx] is executed on.
+func getSimulatedConsumed(chain state.Chain, tx *txs.Tx) ([]APISimulatedUTXO, error) {
+	var consumed []APISimulatedUTXO
+	if importTx, ok := tx.Unsigned.(*txs.ImportTx); ok {
+		for _, in := range importTx.ImportedIns {
+			consumed = append(consumed, APISimulatedUTXO{
+				UTXOID:  in.UTXOID.String(),
+				AssetID: in.AssetID(),
+				Amount:  avajson.Uint64(in.Input().Amount()),
+			})
+		}
+	}
+
+	for _, utxoID := range tx.Unsigned.InputUTXOs() {
+		// Imported UTXOs are symbolic and were handled above.
+		if utxoID.Symbolic() {
+			continue
+		}
+		inputID := utxoID.InputID()
+		utxo, err := chain.GetUTXO(inputID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get UTXO %s: %w", inputID, err)
+		}
+		consumed = append(consumed, newAPISimulatedUTXO(utxo))
+	}
+	return consumed, nil
+}
+
+// getBurned returns the amount of each asset that is consumed but not
+// produced.
+func getBurned(consumed, produced []APISimulatedUTXO) (map[ids.ID]avajson.Uint64, error) {
+	amounts := make(map[ids.ID]uint64)
+	for _, utxo := range consumed {
+		amount, err := safemath.Add64(amounts[utxo.AssetID], uint64(utxo.Amount))
+		if err != nil {
+			return nil, err
+		}
+		amounts[utxo.AssetID] = amount
+	}
+
+	for _, utxo := range produced {
+		// Assets can be minted, so more of an asset may be produced than
+		// consumed.
+		amounts[utxo.AssetID] -= min(amounts[utxo.AssetID], uint64(utxo.Amount))
+	}
+
+	burned := make(map[ids.ID]avajson.Uint64)
+	for assetID, amount := range amounts {
+		if amount > 0 {
+			burned[assetID] = avajson.Uint64(amount)
+		}
+	}
+	return burned, nil
+}
+
+func newAPISimulatedUTXO(utxo *avax.UTXO) APISimulatedUTXO {
+	apiUTXO := APISimulatedUTXO{
+		UTXOID:  utxo.UTXOID.String(),
+		AssetID: utxo.AssetID(),
+	}
+	if out, ok := utxo.Out.(avax.Amounter); ok {
+		apiUTXO.Amount = avajson.Uint64(out.Amount())
+	}
+	return apiUTXO
+}
@@ -0,0 +1,621 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/utils/wrappers"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+const (
+	holderKeyLen = 2*ids.IDLen + wrappers.LongLen
+	supplyKeyLen = ids.IDLen + wrappers.LongLen
+	supplyLen    = 4 * wrappers.LongLen
+	lockedKeyLen = ids.IDLen + 2*wrappers.LongLen
+)
+
+var (
+	holderPrefix         = []byte("holder")
+	ownerPrefix          = []byte("owner")
+	supplyPrefix         = []byte("supply")
+	lockedPrefix         = []byte("locked")
+	assetSingletonPrefix = []byte("singleton")
+
+	assetIndexCompleteKey = []byte{0x00}
+
+	ErrAssetIndexIncomplete = errors.New("asset index is incomplete because it wasn't enabled since genesis. Allow incomplete indices or re-sync the chain")
+
+	_ AssetIndex = (*assetIndex)(nil)
+)
+
+// AssetHolder is an owner of a positive balance of an asset
+type AssetHolder struct {
+	// OwnerID is the hash of the owner, which identifies the owner in the
+	// index
+	OwnerID ids.ID
+	Owner   *secp256k1fx.OutputOwners
+	Balance uint64
+}
+
+// AssetSupply is the cumulative amount of an asset that was moved in or out
+// of the X-chain's UTXO set.
+type AssetSupply struct {
+	// Minted is the amount that was created by txs
+	Minted uint64
+	// Burned is the amount that was consumed but not produced by txs, which is
+	// the amount paid as fees.
+	Burned uint64
+	// Imported is the amount that was imported from other chains
+	Imported uint64
+	// Exported is the amount that was exported to other chains
+	Exported uint64
+	// Locked is the amount held by owners whose locktime hasn't passed
+	Locked uint64
+}
+
+// AssetIndex tracks the balance of every owner of an asset and the supply of
+// the asset at every height.
+//
+// Only UTXOs with a *secp256k1fx.TransferOutput are indexed. Txs that were
+// accepted before the chain was linearized are indexed at height 0.
+type AssetIndex interface {
+	// Accept indexes [tx], which was accepted at [height] and consumed
+	// [consumed].
+	//
+	// Invariant: txs are accepted in order of height.
+	Accept(height uint64, tx *txs.Tx, consumed []*avax.UTXO) error
+
+	// GetHolders returns at most [limit] owners of a positive balance of
+	// [assetID] at [height], in order of owner ID, starting at [startOwnerID].
+	// The returned ID is the owner ID to start the next page at, or ids.Empty
+	// if there are no more holders.
+	GetHolders(
+		assetID ids.ID,
+		height uint64,
+		startOwnerID ids.ID,
+		limit int,
+	) ([]AssetHolder, ids.ID, error)
+
+	// GetSupply returns the supply of [assetID] at [height]. Balances whose
+	// owners have a locktime after [timestamp] are reported as locked.
+	//
+	// Returns database.ErrNotFound if [assetID] wasn't indexed by [height].
+	GetSupply(assetID ids.ID, height uint64, timestamp uint64) (AssetSupply, error)
+}
+
+/*
+ * DB
+ * |-. holders
+ * | '-- assetID + ownerID + ^height -> balance
+ * |-. owners
+ * | '-- ownerID -> owner bytes
+ * |-. supplies
+ * | '-- assetID + ^height -> minted + burned + imported + exported
+ * |-. locked
+ * | '-- assetID + locktime + ^height -> balance of owners with locktime
+ * '-. singletons
+ *   '-- completeKey -> complete
+ */
+type assetIndex struct {
+	codec codec.Manager
+
+	holderDB database.Database
+	ownerDB  database.Database
+	supplyDB database.Database
+	// lockedDB tracks the total balance of owners with each non-zero
+	// locktime so that the locked supply can be computed without iterating
+	// over every holder.
+	lockedDB database.Database
+}
+
+// NewAssetIndex returns an AssetIndex that is stored in [db]. [isNewChain]
+// should be true if no txs have been accepted on the chain yet. If the index
+// wasn't enabled when txs were accepted and [allowIncomplete] is false,
+// ErrAssetIndexIncomplete is returned.
+//
+// Writes to [db] are expected to be committed with the chain's state.
+func NewAssetIndex(
+	db database.Database,
+	codec codec.Manager,
+	isNewChain bool,
+	allowIncomplete bool,
+) (AssetIndex, error) {
+	singletonDB := prefixdb.New(assetSingletonPrefix, db)
+	complete, err := database.GetBool(singletonDB, assetIndexCompleteKey)
+	switch {
+	case err == database.ErrNotFound:
+		complete = isNewChain
+		if err := database.PutBool(singletonDB, assetIndexCompleteKey, complete); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+	if !complete && !allowIncomplete {
+		return nil, ErrAssetIndexIncomplete
+	}
+
+	return &assetIndex{
+		codec:    codec,
+		holderDB: prefixdb.New(holderPrefix, db),
+		ownerDB:  prefixdb.New(ownerPrefix, db),
+		supplyDB: prefixdb.New(supplyPrefix, db),
+		lockedDB: prefixdb.New(lockedPrefix, db),
+	}, nil
+}
+
+// DisableAssetIndex marks the asset index stored in [db], if there is one, as
+// incomplete because txs are being accepted without it.
+func DisableAssetIndex(db database.Database) error {
+	singletonDB := prefixdb.New(assetSingletonPrefix, db)
+	has, err := singletonDB.Has(assetIndexCompleteKey)
+	if err != nil || !has {
+		return err
+	}
+	return database.PutBool(singletonDB, assetIndexCompleteKey, false)
+}
+
+type holderKey struct {
+	assetID ids.ID
+	ownerID ids.ID
+}
+
+type lockedKey struct {
+	assetID  ids.ID
+	locktime uint64
+}
+
+// balanceChange is the amount of an asset that an owner received and spent in
+// a tx
+type balanceChange struct {
+	owner    *secp256k1fx.OutputOwners
+	added    uint64
+	consumed uint64
+}
+
+// supplyChange is the amount of an asset that a tx moved in or out of the UTXO
+// set
+type supplyChange struct {
+	produced uint64
+	consumed uint64
+	imported uint64
+	exported uint64
+}
+
+func (a *assetIndex) Accept(height uint64, tx *txs.Tx, consumed []*avax.UTXO) error {
+	balanceChanges := make(map[holderKey]*balanceChange)
+	lockedChanges := make(map[lockedKey]*balanceChange)
+	supplyChanges := make(map[ids.ID]*supplyChange)
+	getSupplyChange := func(assetID ids.ID) *supplyChange {
+		change, ok := supplyChanges[assetID]
+		if !ok {
+			change = &supplyChange{}
+			supplyChanges[assetID] = change
+		}
+		return change
+	}
+	addUTXO := func(utxo *avax.UTXO, spent bool) error {
+		out, ok := utxo.Out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			return nil
+		}
+		ownerID, err := a.ownerID(&out.OutputOwners)
+		if err != nil {
+			return err
+		}
+
+		assetID := utxo.AssetID()
+		key := holderKey{
+			assetID: assetID,
+			ownerID: ownerID,
+		}
+		change, ok := balanceChanges[key]
+		if !ok {
+			change = &balanceChange{
+				owner: &out.OutputOwners,
+			}
+			balanceChanges[key] = change
+		}
+		changes := []*balanceChange{change}
+		if out.Locktime > 0 {
+			key := lockedKey{
+				assetID:  assetID,
+				locktime: out.Locktime,
+			}
+			lockedChange, ok := lockedChanges[key]
+			if !ok {
+				lockedChange = &balanceChange{}
+				lockedChanges[key] = lockedChange
+			}
+			changes = append(changes, lockedChange)
+		}
+		supply := getSupplyChange(assetID)
+		if spent {
+			for _, c := range changes {
+				c.consumed, err = safemath.Add64(c.consumed, out.Amt)
+				if err != nil {
+					return err
+				}
+			}
+			supply.consumed, err = safemath.Add64(supply.consumed, out.Amt)
+			return err
+		}
+		for _, c := range changes {
+			c.added, err = safemath.Add64(c.added, out.Amt)
+			if err != nil {
+				return err
+			}
+		}
+		supply.produced, err = safemath.Add64(supply.produced, out.Amt)
+		return err
+	}
+
+	for _, utxo := range consumed {
+		if err := addUTXO(utxo, true); err != nil {
+			return err
+		}
+	}
+	for _, utxo := range tx.UTXOs() {
+		if err := addUTXO(utxo, false); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	switch utx := tx.Unsigned.(type) {
+	case *txs.ImportTx:
+		for _, in := range utx.ImportedIns {
+			supply := getSupplyChange(in.AssetID())
+			supply.imported, err = safemath.Add64(supply.imported, in.In.Amount())
+			if err != nil {
+				return err
+			}
+		}
+	case *txs.ExportTx:
+		for _, out := range utx.ExportedOuts {
+			supply := getSupplyChange(out.AssetID())
+			supply.exported, err = safemath.Add64(supply.exported, out.Out.Amount())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for key, change := range balanceChanges {
+		if err := a.updateBalance(height, key, change); err != nil {
+			return err
+		}
+	}
+	for key, change := range lockedChanges {
+		if err := a.updateLocked(height, key, change); err != nil {
+			return err
+		}
+	}
+	for assetID, change := range supplyChanges {
+		if err := a.updateSupply(height, assetID, change); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *assetIndex) updateBalance(height uint64, key holderKey, change *balanceChange) error {
+	if change.added == change.consumed {
+		return nil
+	}
+
+	balance, err := a.getBalance(key.assetID, key.ownerID, math.MaxUint64)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	if err == database.ErrNotFound {
+		ownerBytes, err := a.codec.Marshal(txs.CodecVersion, change.owner)
+		if err != nil {
+			return fmt.Errorf("couldn't marshal owner: %w", err)
+		}
+		if err := a.ownerDB.Put(key.ownerID[:], ownerBytes); err != nil {
+			return err
+		}
+	}
+
+	balance, err = safemath.Add64(balance, change.added)
+	if err != nil {
+		return err
+	}
+	balance, err = safemath.Sub(balance, change.consumed)
+	if err != nil {
+		return fmt.Errorf("balance of owner %s of asset %s is negative: %w", key.ownerID, key.assetID, err)
+	}
+	return database.PutUInt64(a.holderDB, holderDBKey(key.assetID, key.ownerID, height), balance)
+}
+
+func (a *assetIndex) updateLocked(height uint64, key lockedKey, change *balanceChange) error {
+	if change.added == change.consumed {
+		return nil
+	}
+
+	balance, err := a.getLocked(key.assetID, key.locktime, math.MaxUint64)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+	balance, err = safemath.Add64(balance, change.added)
+	if err != nil {
+		return err
+	}
+	balance, err = safemath.Sub(balance, change.consumed)
+	if err != nil {
+		return fmt.Errorf("balance of asset %s locked until %d is negative: %w", key.assetID, key.locktime, err)
+	}
+	return database.PutUInt64(a.lockedDB, lockedDBKey(key.assetID, key.locktime, height), balance)
+}
+
+func (a *assetIndex) updateSupply(height uint64, assetID ids.ID, change *supplyChange) error {
+	supply, err := a.getSupply(assetID, math.MaxUint64)
+	if err != nil && err != database.ErrNotFound {
+		return err
+	}
+
+	// Any amount that was produced but not consumed must have been minted and
+	// any amount that was consumed but not produced must have been burned.
+	in, err := safemath.Add64(change.consumed, change.imported)
+	if err != nil {
+		return err
+	}
+	out, err := safemath.Add64(change.produced, change.exported)
+	if err != nil {
+		return err
+	}
+	if out > in {
+		supply.Minted, err = safemath.Add64(supply.Minted, out-in)
+	} else {
+		supply.Burned, err = safemath.Add64(supply.Burned, in-out)
+	}
+	if err != nil {
+		return err
+	}
+	supply.Imported, err = safemath.Add64(supply.Imported, change.imported)
+	if err != nil {
+		return err
+	}
+	supply.Exported, err = safemath.Add64(supply.Exported, change.exported)
+	if err != nil {
+		return err
+	}
+
+	supplyBytes := make([]byte, supplyLen)
+	binary.BigEndian.PutUint64(supplyBytes, supply.Minted)
+	binary.BigEndian.PutUint64(supplyBytes[wrappers.LongLen:], supply.Burned)
+	binary.BigEndian.PutUint64(supplyBytes[2*wrappers.LongLen:], supply.Imported)
+	binary.BigEndian.PutUint64(supplyBytes[3*wrappers.LongLen:], supply.Exported)
+	return a.supplyDB.Put(supplyDBKey(assetID, height), supplyBytes)
+}
+
+func (a *assetIndex) GetHolders(
+	assetID ids.ID,
+	height uint64,
+	startOwnerID ids.ID,
+	limit int,
+) ([]AssetHolder, ids.ID, error) {
+	it := a.holderDB.NewIteratorWithStartAndPrefix(
+		holderDBKey(assetID, startOwnerID, math.MaxUint64),
+		assetID[:],
+	)
+	defer it.Release()
+
+	var (
+		holders []AssetHolder
+		// Entries of an owner are sorted by descending height, so only the
+		// first entry at or below [height] is the owner's balance.
+		lastOwnerID ids.ID
+		hasLast     bool
+	)
+	for it.Next() {
+		key := it.Key()
+		if len(key) != holderKeyLen {
+			return nil, ids.Empty, fmt.Errorf("unexpected holder key length %d", len(key))
+		}
+		ownerID, err := ids.ToID(key[ids.IDLen : 2*ids.IDLen])
+		if err != nil {
+			return nil, ids.Empty, err
+		}
+		if hasLast && ownerID == lastOwnerID {
+			continue
+		}
+		if entryHeight := ^binary.BigEndian.Uint64(key[2*ids.IDLen:]); entryHeight > height {
+			continue
+		}
+		lastOwnerID = ownerID
+		hasLast = true
+
+		balance, err := database.ParseUInt64(it.Value())
+		if err != nil {
+			return nil, ids.Empty, err
+		}
+		if balance == 0 {
+			continue
+		}
+		if len(holders) == limit {
+			return holders, ownerID, it.Error()
+		}
+
+		owner, err := a.getOwner(ownerID)
+		if err != nil {
+			return nil, ids.Empty, err
+		}
+		holders = append(holders, AssetHolder{
+			OwnerID: ownerID,
+			Owner:   owner,
+			Balance: balance,
+		})
+	}
+	return holders, ids.Empty, it.Error()
+}
+
+func (a *assetIndex) GetSupply(assetID ids.ID, height uint64, timestamp uint64) (AssetSupply, error) {
+	supply, err := a.getSupply(assetID, height)
+	if err != nil {
+		return AssetSupply{}, err
+	}
+	if timestamp == math.MaxUint64 {
+		return supply, nil
+	}
+
+	// Only locktimes after [timestamp] are iterated over, so the cost is
+	// bounded by the number of distinct locktimes rather than the number of
+	// holders.
+	it := a.lockedDB.NewIteratorWithStartAndPrefix(
+		lockedDBKey(assetID, timestamp+1, math.MaxUint64),
+		assetID[:],
+	)
+	defer it.Release()
+
+	var (
+		// Entries of a locktime are sorted by descending height, so only the
+		// first entry at or below [height] is the locked balance.
+		lastLocktime uint64
+		hasLast      bool
+	)
+	for it.Next() {
+		key := it.Key()
+		if len(key) != lockedKeyLen {
+			return AssetSupply{}, fmt.Errorf("unexpected locked key length %d", len(key))
+		}
+		locktime := binary.BigEndian.Uint64(key[ids.IDLen:])
+		if hasLast && locktime == lastLocktime {
+			continue
+		}
+		if entryHeight := ^binary.BigEndian.Uint64(key[ids.IDLen+wrappers.LongLen:]); entryHeight > height {
+			continue
+		}
+		lastLocktime = locktime
+		hasLast = true
+
+		balance, err := database.ParseUInt64(it.Value())
+		if err != nil {
+			return AssetSupply{}, err
+		}
+		supply.Locked, err = safemath.Add64(supply.Locked, balance)
+		if err != nil {
+			return AssetSupply{}, err
+		}
+	}
+	return supply, it.Error()
+}
+
+// getBalance returns the balance of [ownerID] at [height]
+func (a *assetIndex) getBalance(assetID ids.ID, ownerID ids.ID, height uint64) (uint64, error) {
+	prefix := make([]byte, 2*ids.IDLen)
+	copy(prefix, assetID[:])
+	copy(prefix[ids.IDLen:], ownerID[:])
+	it := a.holderDB.NewIteratorWithStartAndPrefix(holderDBKey(assetID, ownerID, height), prefix)
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, err
+		}
+		return 0, database.ErrNotFound
+	}
+	return database.ParseUInt64(it.Value())
+}
+
+// getLocked returns the total balance of [assetID] held by owners with
+// [locktime] at [height]
+func (a *assetIndex) getLocked(assetID ids.ID, locktime uint64, height uint64) (uint64, error) {
+	prefix := make([]byte, ids.IDLen+wrappers.LongLen)
+	copy(prefix, assetID[:])
+	binary.BigEndian.PutUint64(prefix[ids.IDLen:], locktime)
+	it := a.lockedDB.NewIteratorWithStartAndPrefix(lockedDBKey(assetID, locktime, height), prefix)
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, err
+		}
+		return 0, database.ErrNotFound
+	}
+	return database.ParseUInt64(it.Value())
+}
+
+// getSupply returns the supply of [assetID] at [height], without the locked
+// amount
+func (a *assetIndex) getSupply(assetID ids.ID, height uint64) (AssetSupply, error) {
+	it := a.supplyDB.NewIteratorWithStartAndPrefix(supplyDBKey(assetID, height), assetID[:])
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return AssetSupply{}, err
+		}
+		return AssetSupply{}, database.ErrNotFound
+	}
+	supplyBytes := it.Value()
+	if len(supplyBytes) != supplyLen {
+		return AssetSupply{}, fmt.Errorf("unexpected supply length %d", len(supplyBytes))
+	}
+	return AssetSupply{
+		Minted:   binary.BigEndian.Uint64(supplyBytes),
+		Burned:   binary.BigEndian.Uint64(supplyBytes[wrappers.LongLen:]),
+		Imported: binary.BigEndian.Uint64(supplyBytes[2*wrappers.LongLen:]),
+		Exported: binary.BigEndian.Uint64(supplyBytes[3*wrappers.LongLen:]),
+	}, nil
+}
+
+func (a *assetIndex) getOwner(ownerID ids.ID) (*secp256k1fx.OutputOwners, error) {
+	ownerBytes, err := a.ownerDB.Get(ownerID[:])
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get owner %s: %w", ownerID, err)
+	}
+	owner := &secp256k1fx.OutputOwners{}
+	if _, err := a.codec.Unmarshal(ownerBytes, owner); err != nil {
+		return nil, fmt.Errorf("couldn't unmarshal owner %s: %w", ownerID, err)
+	}
+	return owner, nil
+}
+
+func (a *assetIndex) ownerID(owner *secp256k1fx.OutputOwners) (ids.ID, error) {
+	ownerBytes, err := a.codec.Marshal(txs.CodecVersion, owner)
+	if err != nil {
+		return ids.Empty, fmt.Errorf("couldn't marshal owner: %w", err)
+	}
+	return hashing.ComputeHash256Array(ownerBytes), nil
+}
+
+// Heights are inverted so that the most recent entry is iterated first
+func holderDBKey(assetID ids.ID, ownerID ids.ID, height uint64) []byte {
+	key := make([]byte, holderKeyLen)
+	copy(key, assetID[:])
+	copy(key[ids.IDLen:], ownerID[:])
+	binary.BigEndian.PutUint64(key[2*ids.IDLen:], ^height)
+	return key
+}
+
+func supplyDBKey(assetID ids.ID, height uint64) []byte {
+	key := make([]byte, supplyKeyLen)
+	copy(key, assetID[:])
+	binary.BigEndian.PutUint64(key[ids.IDLen:], ^height)
+	return key
+}
+
+func lockedDBKey(assetID ids.ID, locktime uint64, height uint64) []byte {
+	key := make([]byte, lockedKeyLen)
+	copy(key, assetID[:])
+	binary.BigEndian.PutUint64(key[ids.IDLen:], locktime)
+	binary.BigEndian.PutUint64(key[ids.IDLen+wrappers.LongLen:], ^height)
+	return key
+}
@@ -0,0 +1,240 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestAssetIndex(t *testing.T) {
+	require := require.New(t)
+
+	index, err := NewAssetIndex(memdb.New(), parser.Codec(), true, false)
+	require.NoError(err)
+
+	alice := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{1}},
+	}
+	bob := secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{2}},
+	}
+	lockedBob := secp256k1fx.OutputOwners{
+		Locktime:  100,
+		Threshold: 1,
+		Addrs:     []ids.ShortID{{2}},
+	}
+
+	// Mint 1000 to alice and 500 to bob, locked until 100, at height 0
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		Name:         "asset",
+		Symbol:       "A",
+		Denomination: 0,
+		States: []*txs.InitialState{{
+			FxIndex: 0,
+			Outs: []verify.State{
+				&secp256k1fx.TransferOutput{
+					Amt:          1000,
+					OutputOwners: alice,
+				},
+				&secp256k1fx.TransferOutput{
+					Amt:          500,
+					OutputOwners: lockedBob,
+				},
+			},
+		}},
+	}}
+	require.NoError(createAssetTx.Initialize(parser.Codec()))
+	assetID := createAssetTx.ID()
+	require.NoError(index.Accept(0, createAssetTx, nil))
+
+	// Alice sends 600 to bob and pays a fee of 10 at height 1
+	aliceUTXO := createAssetTx.UTXOs()[0]
+	baseTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{
+			{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          600,
+					OutputOwners: bob,
+				},
+			},
+			{
+				Asset: avax.Asset{ID: assetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt:          390,
+					OutputOwners: alice,
+				},
+			},
+		},
+	}}}
+	require.NoError(baseTx.Initialize(parser.Codec()))
+	require.NoError(index.Accept(1, baseTx, []*avax.UTXO{aliceUTXO}))
+
+	// Bob exports 600 and pays a fee of 10 at height 2
+	bobUTXO := baseTx.UTXOs()[0]
+	exportTx := &txs.Tx{Unsigned: &txs.ExportTx{
+		ExportedOuts: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          590,
+				OutputOwners: bob,
+			},
+		}},
+	}}
+	require.NoError(exportTx.Initialize(parser.Codec()))
+	require.NoError(index.Accept(2, exportTx, []*avax.UTXO{bobUTXO}))
+
+	getBalances := func(height uint64) map[uint64]uint64 {
+		holders, cursor, err := index.GetHolders(assetID, height, ids.Empty, 10)
+		require.NoError(err)
+		require.Equal(ids.Empty, cursor)
+
+		// Balances by the locktime and first address of the owner
+		balances := make(map[uint64]uint64)
+		for _, holder := range holders {
+			key := holder.Owner.Locktime + uint64(holder.Owner.Addrs[0][0])
+			balances[key] = holder.Balance
+		}
+		return balances
+	}
+	require.Equal(map[uint64]uint64{1: 1000, 102: 500}, getBalances(0))
+	require.Equal(map[uint64]uint64{1: 390, 2: 600, 102: 500}, getBalances(1))
+	require.Equal(map[uint64]uint64{1: 390, 102: 500}, getBalances(2))
+
+	// Holders are paginated
+	holders, cursor, err := index.GetHolders(assetID, 1, ids.Empty, 2)
+	require.NoError(err)
+	require.Len(holders, 2)
+	require.NotEqual(ids.Empty, cursor)
+	holders, cursor, err = index.GetHolders(assetID, 1, cursor, 2)
+	require.NoError(err)
+	require.Len(holders, 1)
+	require.Equal(ids.Empty, cursor)
+
+	supply, err := index.GetSupply(assetID, 0, 0)
+	require.NoError(err)
+	require.Equal(AssetSupply{
+		Minted: 1500,
+		Locked: 500,
+	}, supply)
+
+	supply, err = index.GetSupply(assetID, 2, 100)
+	require.NoError(err)
+	require.Equal(AssetSupply{
+		Minted:   1500,
+		Burned:   20,
+		Exported: 590,
+	}, supply)
+
+	_, err = index.GetSupply(ids.GenerateTestID(), 2, 0)
+	require.ErrorIs(err, database.ErrNotFound)
+}
+
+func TestAssetIndexLockedSupply(t *testing.T) {
+	require := require.New(t)
+
+	index, err := NewAssetIndex(memdb.New(), parser.Codec(), true, false)
+	require.NoError(err)
+
+	lockedOwner := func(locktime uint64) secp256k1fx.OutputOwners {
+		return secp256k1fx.OutputOwners{
+			Locktime:  locktime,
+			Threshold: 1,
+			Addrs:     []ids.ShortID{{1}},
+		}
+	}
+
+	// Mint 100 unlocked, 200 locked until 10 and 300 locked until 20 at
+	// height 0
+	createAssetTx := &txs.Tx{Unsigned: &txs.CreateAssetTx{
+		Name:         "asset",
+		Symbol:       "A",
+		Denomination: 0,
+		States: []*txs.InitialState{{
+			FxIndex: 0,
+			Outs: []verify.State{
+				&secp256k1fx.TransferOutput{
+					Amt:          100,
+					OutputOwners: lockedOwner(0),
+				},
+				&secp256k1fx.TransferOutput{
+					Amt:          200,
+					OutputOwners: lockedOwner(10),
+				},
+				&secp256k1fx.TransferOutput{
+					Amt:          300,
+					OutputOwners: lockedOwner(20),
+				},
+			},
+		}},
+	}}
+	require.NoError(createAssetTx.Initialize(parser.Codec()))
+	assetID := createAssetTx.ID()
+	require.NoError(index.Accept(0, createAssetTx, nil))
+
+	// The balance locked until 10 is spent at height 1
+	lockedUTXO := createAssetTx.UTXOs()[1]
+	baseTx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
+		Outs: []*avax.TransferableOutput{{
+			Asset: avax.Asset{ID: assetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          200,
+				OutputOwners: lockedOwner(0),
+			},
+		}},
+	}}}
+	require.NoError(baseTx.Initialize(parser.Codec()))
+	require.NoError(index.Accept(1, baseTx, []*avax.UTXO{lockedUTXO}))
+
+	tests := []struct {
+		height         uint64
+		timestamp      uint64
+		expectedLocked uint64
+	}{
+		{height: 0, timestamp: 0, expectedLocked: 500},
+		{height: 0, timestamp: 9, expectedLocked: 500},
+		{height: 0, timestamp: 10, expectedLocked: 300},
+		{height: 0, timestamp: 20, expectedLocked: 0},
+		{height: 1, timestamp: 0, expectedLocked: 300},
+		{height: 1, timestamp: 19, expectedLocked: 300},
+		{height: 1, timestamp: 20, expectedLocked: 0},
+	}
+	for _, test := range tests {
+		supply, err := index.GetSupply(assetID, test.height, test.timestamp)
+		require.NoError(err)
+		require.Equal(test.expectedLocked, supply.Locked, "height %d timestamp %d", test.height, test.timestamp)
+		require.Equal(uint64(600), supply.Minted)
+	}
+}
+
+func TestAssetIndexIncomplete(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	_, err := NewAssetIndex(db, parser.Codec(), false, false)
+	require.ErrorIs(err, ErrAssetIndexIncomplete)
+
+	_, err = NewAssetIndex(db, parser.Codec(), false, true)
+	require.NoError(err)
+
+	// An index that was complete becomes incomplete once it's disabled
+	db = memdb.New()
+	_, err = NewAssetIndex(db, parser.Codec(), true, false)
+	require.NoError(err)
+	require.NoError(DisableAssetIndex(db))
+	_, err = NewAssetIndex(db, parser.Codec(), false, false)
+	require.ErrorIs(err, ErrAssetIndexIncomplete)
+}
@@ -377,6 +377,21 @@ func (mr *MockStateMockRecorder) GetBlockIDAtHeight(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockIDAtHeight", reflect.TypeOf((*MockState)(nil).GetBlockIDAtHeight), arg0)
 }
 
+// GetImportTxID mocks base method.
+func (m *MockState) GetImportTxID(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportTxID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportTxID indicates an expected call of GetImportTxID.
+func (mr *MockStateMockRecorder) GetImportTxID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportTxID", reflect.TypeOf((*MockState)(nil).GetImportTxID), arg0)
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -435,6 +450,34 @@ func (mr *MockStateMockRecorder) GetUTXO(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXO", reflect.TypeOf((*MockState)(nil).GetUTXO), arg0)
 }
 
+// ImportedUTXOsIndexed mocks base method.
+func (m *MockState) ImportedUTXOsIndexed() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportedUTXOsIndexed")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ImportedUTXOsIndexed indicates an expected call of ImportedUTXOsIndexed.
+func (mr *MockStateMockRecorder) ImportedUTXOsIndexed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportedUTXOsIndexed", reflect.TypeOf((*MockState)(nil).ImportedUTXOsIndexed))
+}
+
+// IndexImportedUTXOs mocks base method.
+func (m *MockState) IndexImportedUTXOs(arg0 sync.Locker, arg1 logging.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexImportedUTXOs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexImportedUTXOs indicates an expected call of IndexImportedUTXOs.
+func (mr *MockStateMockRecorder) IndexImportedUTXOs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexImportedUTXOs", reflect.TypeOf((*MockState)(nil).IndexImportedUTXOs), arg0, arg1)
+}
+
 // InitializeChainState mocks base method.
 func (m *MockState) InitializeChainState(arg0 ids.ID, arg1 time.Time) error {
 	m.ctrl.T.Helper()
@@ -49,9 +49,12 @@ var (
 	blockPrefix     = []byte("block")
 	singletonPrefix = []byte("singleton")
 
-	isInitializedKey = []byte{0x00}
-	timestampKey     = []byte{0x01}
-	lastAcceptedKey  = []byte{0x02}
+	importedUTXOPrefix = []byte("importedUTXO")
+
+	isInitializedKey        = []byte{0x00}
+	timestampKey            = []byte{0x01}
+	lastAcceptedKey         = []byte{0x02}
+	importedUTXOsIndexedKey = []byte{0x03}
 
 	errStatusWithoutTx = errors.New("unexpected status without transactions")
 
@@ -122,6 +125,25 @@ type State interface {
 	// Checksums returns the current TxChecksum and UTXOChecksum.
 	Checksums() (txChecksum ids.ID, utxoChecksum ids.ID)
 
+	// GetImportTxID returns the ID of the accepted ImportTx that imported
+	// [utxoID] from another chain.
+	//
+	// Note: UTXOs imported before the import index was introduced are only
+	// returned once ImportedUTXOsIndexed returns true.
+	GetImportTxID(utxoID ids.ID) (ids.ID, error)
+
+	// ImportedUTXOsIndexed returns true if every UTXO imported by an accepted
+	// ImportTx is returned by GetImportTxID.
+	ImportedUTXOsIndexed() bool
+
+	// Asynchronously indexes the UTXOs that were imported before the import
+	// index was introduced.
+	//
+	// [lock] is the AVM's context lock and is assumed to be unlocked when this
+	// method is called. Must be called after Prune, so that every tx on disk
+	// is accepted.
+	IndexImportedUTXOs(lock sync.Locker, log logging.Logger) error
+
 	Close() error
 }
 
@@ -137,10 +159,13 @@ type State interface {
  * | '-- height -> blockID
  * |-. blocks
  * | '-- blockID -> block bytes
+ * |-. importedUTXOs
+ * | '-- utxoID -> txID
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- timestampKey -> timestamp
- *   '-- lastAcceptedKey -> lastAccepted
+ *   |-- lastAcceptedKey -> lastAccepted
+ *   '-- importedUTXOsIndexedKey -> nil
  */
 type state struct {
 	parser block.Parser
@@ -166,6 +191,11 @@ type state struct {
 	blockCache  cache.Cacher[ids.ID, block.Block] // cache of blockID -> Block. If the entry is nil, it is not in the database
 	blockDB     database.Database
 
+	importedUTXODB database.Database
+	// [importedUTXOsIndexed] is true once the UTXOs that were imported before
+	// the import index was introduced have been indexed.
+	importedUTXOsIndexed bool
+
 	// [lastAccepted] is the most recently accepted block.
 	lastAccepted, persistedLastAccepted ids.ID
 	timestamp, persistedTimestamp       time.Time
@@ -187,6 +217,7 @@ func New(
 	blockIDDB := prefixdb.New(blockIDPrefix, db)
 	blockDB := prefixdb.New(blockPrefix, db)
 	singletonDB := prefixdb.New(singletonPrefix, db)
+	importedUTXODB := prefixdb.New(importedUTXOPrefix, db)
 
 	statusCache, err := metercacher.New[ids.ID, *choices.Status](
 		"status_cache",
@@ -229,6 +260,11 @@ func New(
 		return nil, err
 	}
 
+	importedUTXOsIndexed, err := singletonDB.Has(importedUTXOsIndexedKey)
+	if err != nil {
+		return nil, err
+	}
+
 	s := &state{
 		parser: parser,
 		db:     db,
@@ -252,6 +288,9 @@ func New(
 		blockCache:  blockCache,
 		blockDB:     blockDB,
 
+		importedUTXODB:       importedUTXODB,
+		importedUTXOsIndexed: importedUTXOsIndexed,
+
 		singletonDB: singletonDB,
 
 		trackChecksum: trackChecksums,
@@ -525,6 +564,7 @@ func (s *state) Close() error {
 		s.txDB.Close(),
 		s.blockIDDB.Close(),
 		s.blockDB.Close(),
+		s.importedUTXODB.Close(),
 		s.singletonDB.Close(),
 		s.db.Close(),
 	)
@@ -571,6 +611,9 @@ func (s *state) writeTxs() error {
 		if err := s.statusDB.Delete(txID[:]); err != nil {
 			return fmt.Errorf("failed to delete status: %w", err)
 		}
+		if err := s.writeImportedUTXOs(txID, tx); err != nil {
+			return err
+		}
 	}
 	return nil
 }
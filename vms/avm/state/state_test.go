@@ -4,6 +4,7 @@
 package state
 
 import (
+	"sync"
 	"testing"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/ava-labs/avalanchego/database/memdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/avm/block"
 	"github.com/ava-labs/avalanchego/vms/avm/fxs"
@@ -317,3 +319,49 @@ func TestInitializeChainState(t *testing.T) {
 	require.NoError(err)
 	require.Equal(genesis.ID(), lastAccepted.Parent())
 }
+
+func TestIndexImportedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	db := memdb.New()
+	vdb := versiondb.New(db)
+	s, err := New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+	require.False(s.ImportedUTXOsIndexed())
+
+	utxoID := avax.UTXOID{TxID: ids.GenerateTestID()}
+	importTx := &txs.Tx{Unsigned: &txs.ImportTx{
+		BaseTx: txs.BaseTx{BaseTx: avax.BaseTx{
+			BlockchainID: ids.GenerateTestID(),
+		}},
+		SourceChain: ids.GenerateTestID(),
+		ImportedIns: []*avax.TransferableInput{{
+			UTXOID: utxoID,
+			Asset:  avax.Asset{ID: ids.GenerateTestID()},
+			In:     &secp256k1fx.TransferInput{Amt: 1},
+		}},
+	}}
+	require.NoError(importTx.Initialize(parser.Codec()))
+
+	s.AddTx(importTx)
+	require.NoError(s.Commit())
+
+	// Remove the UTXO from the index, as if it was imported before the index
+	// was introduced.
+	importedUTXOID := utxoID.InputID()
+	require.NoError(s.(*state).importedUTXODB.Delete(importedUTXOID[:]))
+	_, err = s.GetImportTxID(importedUTXOID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(s.IndexImportedUTXOs(&sync.Mutex{}, logging.NoLog{}))
+	require.True(s.ImportedUTXOsIndexed())
+
+	importTxID, err := s.GetImportTxID(importedUTXOID)
+	require.NoError(err)
+	require.Equal(importTx.ID(), importTxID)
+
+	// The index is only built once
+	s, err = New(vdb, parser, prometheus.NewRegistry(), trackChecksums)
+	require.NoError(err)
+	require.True(s.ImportedUTXOsIndexed())
+}
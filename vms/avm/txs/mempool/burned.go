@@ -0,0 +1,78 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package mempool
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/avm/txs"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+var _ txs.Visitor = (*burnedCalculator)(nil)
+
+// burnedCalculator calculates the amount of an asset that a tx burns
+type burnedCalculator struct {
+	assetID ids.ID
+	burned  uint64
+}
+
+func (c *burnedCalculator) BaseTx(tx *txs.BaseTx) error {
+	return c.calculate(tx.Ins, tx.Outs)
+}
+
+func (c *burnedCalculator) CreateAssetTx(tx *txs.CreateAssetTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *burnedCalculator) OperationTx(tx *txs.OperationTx) error {
+	return c.BaseTx(&tx.BaseTx)
+}
+
+func (c *burnedCalculator) ImportTx(tx *txs.ImportTx) error {
+	ins := make([]*avax.TransferableInput, 0, len(tx.Ins)+len(tx.ImportedIns))
+	ins = append(ins, tx.Ins...)
+	ins = append(ins, tx.ImportedIns...)
+	return c.calculate(ins, tx.Outs)
+}
+
+func (c *burnedCalculator) ExportTx(tx *txs.ExportTx) error {
+	outs := make([]*avax.TransferableOutput, 0, len(tx.Outs)+len(tx.ExportedOuts))
+	outs = append(outs, tx.Outs...)
+	outs = append(outs, tx.ExportedOuts...)
+	return c.calculate(tx.Ins, outs)
+}
+
+func (c *burnedCalculator) calculate(ins []*avax.TransferableInput, outs []*avax.TransferableOutput) error {
+	var (
+		consumed uint64
+		produced uint64
+		err      error
+	)
+	for _, in := range ins {
+		if in.AssetID() != c.assetID {
+			continue
+		}
+		consumed, err = safemath.Add64(consumed, in.In.Amount())
+		if err != nil {
+			return err
+		}
+	}
+	for _, out := range outs {
+		if out.AssetID() != c.assetID {
+			continue
+		}
+		produced, err = safemath.Add64(produced, out.Out.Amount())
+		if err != nil {
+			return err
+		}
+	}
+	// Txs that produce more than they consume are invalid, so they are
+	// treated as not burning anything.
+	if consumed > produced {
+		c.burned = consumed - produced
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
@@ -15,7 +16,9 @@ import (
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/linkedhashmap"
+	"github.com/ava-labs/avalanchego/utils/set"
 	"github.com/ava-labs/avalanchego/utils/setmap"
+	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 )
@@ -39,10 +42,24 @@ var (
 	ErrTxTooLarge           = errors.New("tx too large")
 	ErrMempoolFull          = errors.New("mempool is full")
 	ErrConflictsWithOtherTx = errors.New("tx conflicts with other tx")
+	ErrReplacedByFee        = errors.New("tx replaced by conflicting tx with a higher fee")
 )
 
+// TxInfo describes a tx in the mempool
+type TxInfo struct {
+	TxID ids.ID
+	Size int
+	// Burned is the amount of AVAX that the tx burns
+	Burned uint64
+	// Added is the time that the tx was added to the mempool
+	Added time.Time
+}
+
 // Mempool contains transactions that have not yet been put into a block.
 type Mempool interface {
+	// Add [tx] to the mempool. If [tx] conflicts with txs in the mempool, it
+	// replaces them only if it burns strictly more AVAX than all of them
+	// combined.
 	Add(tx *txs.Tx) error
 	Get(txID ids.ID) (*txs.Tx, bool)
 	// Remove [txs] and any conflicts of [txs] from the mempool.
@@ -66,16 +83,23 @@ type Mempool interface {
 
 	// Len returns the number of txs in the mempool.
 	Len() int
+
+	// TxInfos returns information about the txs in the mempool from oldest to
+	// newest.
+	TxInfos() []TxInfo
 }
 
 type mempool struct {
 	lock           sync.RWMutex
 	unissuedTxs    linkedhashmap.LinkedHashmap[ids.ID, *txs.Tx]
 	consumedUTXOs  *setmap.SetMap[ids.ID, ids.ID] // TxID -> Consumed UTXOs
+	txInfos        map[ids.ID]TxInfo
 	bytesAvailable int
 	droppedTxIDs   *cache.LRU[ids.ID, error] // TxID -> Verification error
 
-	toEngine chan<- common.Message
+	avaxAssetID ids.ID
+	clock       mockable.Clock
+	toEngine    chan<- common.Message
 
 	numTxs               prometheus.Gauge
 	bytesAvailableMetric prometheus.Gauge
@@ -84,13 +108,16 @@ type mempool struct {
 func New(
 	namespace string,
 	registerer prometheus.Registerer,
+	avaxAssetID ids.ID,
 	toEngine chan<- common.Message,
 ) (Mempool, error) {
 	m := &mempool{
 		unissuedTxs:    linkedhashmap.New[ids.ID, *txs.Tx](),
 		consumedUTXOs:  setmap.New[ids.ID, ids.ID](),
+		txInfos:        make(map[ids.ID]TxInfo),
 		bytesAvailable: maxMempoolSize,
 		droppedTxIDs:   &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		avaxAssetID:    avaxAssetID,
 		toEngine:       toEngine,
 		numTxs: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -131,25 +158,69 @@ func (m *mempool) Add(tx *txs.Tx) error {
 			MaxTxSize,
 		)
 	}
-	if txSize > m.bytesAvailable {
+
+	burned, err := m.burned(tx)
+	if err != nil {
+		return fmt.Errorf("failed to calculate burned amount of %s: %w", txID, err)
+	}
+
+	// Find the txs in the mempool that consume the same UTXOs as [tx]
+	inputs := tx.Unsigned.InputIDs()
+	var (
+		conflicts       set.Set[ids.ID]
+		conflictsBurned uint64
+		conflictsSize   int
+	)
+	for inputID := range inputs {
+		conflictID, ok := m.consumedUTXOs.GetKey(inputID)
+		if !ok || conflicts.Contains(conflictID) {
+			continue
+		}
+		conflicts.Add(conflictID)
+
+		info := m.txInfos[conflictID]
+		conflictsBurned += info.Burned
+		conflictsSize += info.Size
+	}
+	if conflicts.Len() > 0 && burned <= conflictsBurned {
+		return fmt.Errorf("%w: %s burns %d which doesn't exceed the %d burned by %d conflicting txs",
+			ErrConflictsWithOtherTx,
+			txID,
+			burned,
+			conflictsBurned,
+			conflicts.Len(),
+		)
+	}
+
+	if txSize > m.bytesAvailable+conflictsSize {
 		return fmt.Errorf("%w: %s size (%d) > available space (%d)",
 			ErrMempoolFull,
 			txID,
 			txSize,
-			m.bytesAvailable,
+			m.bytesAvailable+conflictsSize,
 		)
 	}
 
-	inputs := tx.Unsigned.InputIDs()
-	if m.consumedUTXOs.HasOverlap(inputs) {
-		return fmt.Errorf("%w: %s", ErrConflictsWithOtherTx, txID)
+	// Replace the conflicting txs
+	for conflictID := range conflicts {
+		m.remove(conflictID)
+		m.droppedTxIDs.Put(
+			conflictID,
+			fmt.Errorf("%w: %s", ErrReplacedByFee, txID),
+		)
 	}
 
 	m.bytesAvailable -= txSize
 	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
 
 	m.unissuedTxs.Put(txID, tx)
-	m.numTxs.Inc()
+	m.txInfos[txID] = TxInfo{
+		TxID:   txID,
+		Size:   txSize,
+		Burned: burned,
+		Added:  m.clock.Time(),
+	}
+	m.numTxs.Set(float64(m.unissuedTxs.Len()))
 
 	// Mark these UTXOs as consumed in the mempool
 	m.consumedUTXOs.Put(txID, inputs)
@@ -171,20 +242,31 @@ func (m *mempool) Remove(txs ...*txs.Tx) {
 	for _, tx := range txs {
 		txID := tx.ID()
 		// If the transaction is in the mempool, remove it.
-		if _, ok := m.consumedUTXOs.DeleteKey(txID); ok {
-			m.unissuedTxs.Delete(txID)
-			m.bytesAvailable += len(tx.Bytes())
+		if m.consumedUTXOs.HasKey(txID) {
+			m.remove(txID)
 			continue
 		}
 
 		// If the transaction isn't in the mempool, remove any conflicts it has.
 		inputs := tx.Unsigned.InputIDs()
 		for _, removed := range m.consumedUTXOs.DeleteOverlapping(inputs) {
-			tx, _ := m.unissuedTxs.Get(removed.Key)
-			m.unissuedTxs.Delete(removed.Key)
-			m.bytesAvailable += len(tx.Bytes())
+			m.remove(removed.Key)
 		}
 	}
+}
+
+// remove [txID] from the mempool. Assumes the lock is held.
+func (m *mempool) remove(txID ids.ID) {
+	tx, ok := m.unissuedTxs.Get(txID)
+	if !ok {
+		return
+	}
+
+	m.consumedUTXOs.DeleteKey(txID)
+	m.unissuedTxs.Delete(txID)
+	delete(m.txInfos, txID)
+	m.bytesAvailable += len(tx.Bytes())
+
 	m.bytesAvailableMetric.Set(float64(m.bytesAvailable))
 	m.numTxs.Set(float64(m.unissuedTxs.Len()))
 }
@@ -243,3 +325,22 @@ func (m *mempool) Len() int {
 
 	return m.unissuedTxs.Len()
 }
+
+func (m *mempool) TxInfos() []TxInfo {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	infos := make([]TxInfo, 0, m.unissuedTxs.Len())
+	it := m.unissuedTxs.NewIterator()
+	for it.Next() {
+		infos = append(infos, m.txInfos[it.Key()])
+	}
+	return infos
+}
+
+// burned returns the amount of AVAX that [tx] burns
+func (m *mempool) burned(tx *txs.Tx) (uint64, error) {
+	c := &burnedCalculator{assetID: m.avaxAssetID}
+	err := tx.Unsigned.Visit(c)
+	return c.burned, err
+}
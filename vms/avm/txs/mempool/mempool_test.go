@@ -6,6 +6,7 @@ package mempool
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
@@ -15,8 +16,11 @@ import (
 	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/vms/avm/txs"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
 )
 
+var avaxAssetID = ids.ID{'a', 'v', 'a', 'x'}
+
 func TestAdd(t *testing.T) {
 	tx0 := newTx(0, 32)
 
@@ -70,6 +74,7 @@ func TestAdd(t *testing.T) {
 			mempool, err := New(
 				"mempool",
 				prometheus.NewRegistry(),
+				avaxAssetID,
 				nil,
 			)
 			require.NoError(err)
@@ -99,6 +104,7 @@ func TestGet(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		nil,
 	)
 	require.NoError(err)
@@ -127,6 +133,7 @@ func TestPeek(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		nil,
 	)
 	require.NoError(err)
@@ -168,6 +175,7 @@ func TestRemoveConflict(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		nil,
 	)
 	require.NoError(err)
@@ -193,6 +201,7 @@ func TestIterate(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		nil,
 	)
 	require.NoError(err)
@@ -242,6 +251,7 @@ func TestRequestBuildBlock(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		toEngine,
 	)
 	require.NoError(err)
@@ -276,6 +286,7 @@ func TestDropped(t *testing.T) {
 	mempool, err := New(
 		"mempool",
 		prometheus.NewRegistry(),
+		avaxAssetID,
 		nil,
 	)
 	require.NoError(err)
@@ -296,6 +307,146 @@ func TestDropped(t *testing.T) {
 	require.NoError(mempool.GetDropReason(txID))
 }
 
+func TestReplaceByFee(t *testing.T) {
+	tests := []struct {
+		name       string
+		initialTxs []*txs.Tx
+		tx         *txs.Tx
+		err        error
+	}{
+		{
+			name:       "replace tx with higher burned amount",
+			initialTxs: []*txs.Tx{newTxWithBurned(0, 32, 10)},
+			tx:         newTxWithBurned(0, 32, 11),
+			err:        nil,
+		},
+		{
+			name:       "attempt replacing tx with equal burned amount",
+			initialTxs: []*txs.Tx{newTxWithBurned(0, 32, 10)},
+			tx:         newTxWithBurned(0, 32, 10),
+			err:        ErrConflictsWithOtherTx,
+		},
+		{
+			name:       "attempt replacing tx with lower burned amount",
+			initialTxs: []*txs.Tx{newTxWithBurned(0, 32, 10)},
+			tx:         newTxWithBurned(0, 32, 9),
+			err:        ErrConflictsWithOtherTx,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+
+			mempool, err := New(
+				"mempool",
+				prometheus.NewRegistry(),
+				avaxAssetID,
+				nil,
+			)
+			require.NoError(err)
+
+			for _, tx := range test.initialTxs {
+				require.NoError(mempool.Add(tx))
+			}
+
+			err = mempool.Add(test.tx)
+			require.ErrorIs(err, test.err)
+
+			_, added := mempool.Get(test.tx.ID())
+			require.Equal(err == nil, added)
+			for _, tx := range test.initialTxs {
+				txID := tx.ID()
+				_, exists := mempool.Get(txID)
+				require.Equal(err != nil, exists)
+				if err == nil {
+					require.ErrorIs(mempool.GetDropReason(txID), ErrReplacedByFee)
+				}
+			}
+			require.Equal(1, mempool.Len())
+		})
+	}
+}
+
+func TestReplaceByFeeMultipleConflicts(t *testing.T) {
+	require := require.New(t)
+
+	mempool, err := New(
+		"mempool",
+		prometheus.NewRegistry(),
+		avaxAssetID,
+		nil,
+	)
+	require.NoError(err)
+
+	tx0 := newTxWithBurned(0, 32, 10)
+	tx1 := newTxWithBurned(1, 32, 10)
+	require.NoError(mempool.Add(tx0))
+	require.NoError(mempool.Add(tx1))
+
+	// newConflict returns a tx that consumes the UTXOs of both [tx0] and
+	// [tx1] and burns [burned] AVAX
+	newConflict := func(burned uint64) *txs.Tx {
+		tx := newTxWithBurned(0, 32, burned-10)
+		utx := tx.Unsigned.(*txs.BaseTx)
+		utx.Ins = append(utx.Ins, tx1.Unsigned.(*txs.BaseTx).Ins[0])
+		return tx
+	}
+
+	// The replacing tx must burn more than all of its conflicts combined
+	err = mempool.Add(newConflict(20))
+	require.ErrorIs(err, ErrConflictsWithOtherTx)
+
+	tx := newConflict(21)
+	require.NoError(mempool.Add(tx))
+	require.Equal(1, mempool.Len())
+	require.ErrorIs(mempool.GetDropReason(tx0.ID()), ErrReplacedByFee)
+	require.ErrorIs(mempool.GetDropReason(tx1.ID()), ErrReplacedByFee)
+}
+
+func TestTxInfos(t *testing.T) {
+	require := require.New(t)
+
+	m, err := New(
+		"mempool",
+		prometheus.NewRegistry(),
+		avaxAssetID,
+		nil,
+	)
+	require.NoError(err)
+
+	now := time.Unix(1607133600, 0)
+	m.(*mempool).clock.Set(now)
+
+	tx0 := newTxWithBurned(0, 32, 10)
+	tx1 := newTxWithBurned(1, 64, 20)
+	require.NoError(m.Add(tx0))
+	require.NoError(m.Add(tx1))
+	require.Equal([]TxInfo{
+		{
+			TxID:   tx0.ID(),
+			Size:   len(tx0.Bytes()),
+			Burned: 10,
+			Added:  now,
+		},
+		{
+			TxID:   tx1.ID(),
+			Size:   len(tx1.Bytes()),
+			Burned: 20,
+			Added:  now,
+		},
+	}, m.TxInfos())
+
+	m.Remove(tx0)
+	require.Equal([]TxInfo{
+		{
+			TxID:   tx1.ID(),
+			Size:   len(tx1.Bytes()),
+			Burned: 20,
+			Added:  now,
+		},
+	}, m.TxInfos())
+}
+
 func newTxs(num int, size int) []*txs.Tx {
 	txs := make([]*txs.Tx, num)
 	for i := range txs {
@@ -305,12 +456,22 @@ func newTxs(num int, size int) []*txs.Tx {
 }
 
 func newTx(index uint32, size int) *txs.Tx {
+	return newTxWithBurned(index, size, 0)
+}
+
+// newTxWithBurned returns a tx that consumes UTXO [index] and burns [burned]
+// AVAX
+func newTxWithBurned(index uint32, size int, burned uint64) *txs.Tx {
 	tx := &txs.Tx{Unsigned: &txs.BaseTx{BaseTx: avax.BaseTx{
 		Ins: []*avax.TransferableInput{{
 			UTXOID: avax.UTXOID{
 				TxID:        ids.ID{'t', 'x', 'I', 'D'},
 				OutputIndex: index,
 			},
+			Asset: avax.Asset{ID: avaxAssetID},
+			In: &secp256k1fx.TransferInput{
+				Amt: burned,
+			},
 		}},
 	}}}
 	tx.SetBytes(utils.RandomBytes(size), utils.RandomBytes(size))
@@ -163,3 +163,17 @@ func (mr *MockMempoolMockRecorder) RequestBuildBlock() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestBuildBlock", reflect.TypeOf((*MockMempool)(nil).RequestBuildBlock))
 }
+
+// TxInfos mocks base method.
+func (m *MockMempool) TxInfos() []TxInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TxInfos")
+	ret0, _ := ret[0].([]TxInfo)
+	return ret0
+}
+
+// TxInfos indicates an expected call of TxInfos.
+func (mr *MockMempoolMockRecorder) TxInfos() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TxInfos", reflect.TypeOf((*MockMempool)(nil).TxInfos))
+}
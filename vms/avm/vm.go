@@ -17,6 +17,7 @@ import (
 
 	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/database/versiondb"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/pubsub"
@@ -53,6 +54,8 @@ import (
 const assetToFxCacheSize = 1024
 
 var (
+	assetIndexPrefix = []byte("assetIndex")
+
 	errIncompatibleFx            = errors.New("incompatible feature extension")
 	errUnknownFx                 = errors.New("unknown feature extension")
 	errGenesisAssetMustHaveState = errors.New("genesis asset must have non-empty state")
@@ -110,6 +113,9 @@ type VM struct {
 
 	addressTxsIndexer index.AddressTxsIndexer
 
+	// nil if the asset index is disabled
+	assetIndex state.AssetIndex
+
 	txBackend *txexecutor.Backend
 
 	// Cancelled on shutdown
@@ -123,6 +129,7 @@ type VM struct {
 	blockbuilder.Builder
 	chainManager blockexecutor.Manager
 	network      *network.Network
+	mempool      mempool.Mempool
 }
 
 func (vm *VM) Connected(ctx context.Context, nodeID ids.NodeID, version *version.Application) error {
@@ -243,6 +250,10 @@ func (vm *VM) Initialize(
 
 	vm.state = state
 
+	if err := vm.initAssetIndex(avmConfig); err != nil {
+		return err
+	}
+
 	if err := vm.initGenesis(genesisBytes); err != nil {
 		return err
 	}
@@ -408,10 +419,11 @@ func (vm *VM) Linearize(ctx context.Context, stopVertexID ids.ID, toEngine chan<
 		return err
 	}
 
-	mempool, err := mempool.New("mempool", vm.registerer, toEngine)
+	mempool, err := mempool.New("mempool", vm.registerer, vm.feeAssetID, toEngine)
 	if err != nil {
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
+	vm.mempool = mempool
 
 	vm.chainManager = blockexecutor.NewManager(
 		mempool,
@@ -482,6 +494,14 @@ func (vm *VM) Linearize(ctx context.Context, stopVertexID ids.ID, toEngine chan<
 			return
 		}
 		vm.ctx.Log.Info("state pruning finished")
+
+		// Indexing must wait for pruning, which removes the txs that weren't
+		// accepted.
+		if err := vm.state.IndexImportedUTXOs(&vm.ctx.Lock, vm.ctx.Log); err != nil {
+			vm.ctx.Log.Error("imported UTXO indexing failed",
+				zap.Error(err),
+			)
+		}
 	}()
 
 	return nil
@@ -569,7 +589,9 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 		}
 
 		if !stateInitialized {
-			vm.initState(tx)
+			if err := vm.initState(tx); err != nil {
+				return err
+			}
 		}
 		if index == 0 {
 			vm.ctx.Log.Info("fee asset is established",
@@ -587,7 +609,7 @@ func (vm *VM) initGenesis(genesisBytes []byte) error {
 	return nil
 }
 
-func (vm *VM) initState(tx *txs.Tx) {
+func (vm *VM) initState(tx *txs.Tx) error {
 	txID := tx.ID()
 	vm.ctx.Log.Info("initializing genesis asset",
 		zap.Stringer("txID", txID),
@@ -596,6 +618,55 @@ func (vm *VM) initState(tx *txs.Tx) {
 	for _, utxo := range tx.UTXOs() {
 		vm.state.AddUTXO(utxo)
 	}
+
+	if vm.assetIndex == nil {
+		return nil
+	}
+	if err := vm.assetIndex.Accept(0, tx, nil); err != nil {
+		return fmt.Errorf("failed to index genesis asset %s: %w", txID, err)
+	}
+	return nil
+}
+
+func (vm *VM) initAssetIndex(avmConfig Config) error {
+	db := prefixdb.New(assetIndexPrefix, vm.db)
+	if !avmConfig.IndexAssets {
+		return state.DisableAssetIndex(db)
+	}
+
+	stateInitialized, err := vm.state.IsInitialized()
+	if err != nil {
+		return err
+	}
+	vm.assetIndex, err = state.NewAssetIndex(
+		db,
+		vm.parser.Codec(),
+		!stateInitialized,
+		avmConfig.IndexAllowIncomplete,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize asset index: %w", err)
+	}
+	vm.ctx.Log.Info("asset indexing is enabled")
+	return nil
+}
+
+// acceptingHeight returns the height of the block whose txs are being
+// accepted. Txs that are accepted before the chain is linearized are reported
+// at height 0.
+func (vm *VM) acceptingHeight() (uint64, error) {
+	if vm.chainManager == nil {
+		return 0, nil
+	}
+
+	// The state of the block being accepted hasn't been applied yet, so the
+	// last accepted block is its parent.
+	lastAcceptedID := vm.state.GetLastAccepted()
+	lastAccepted, err := vm.state.GetBlock(lastAcceptedID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get last accepted block %s: %w", lastAcceptedID, err)
+	}
+	return lastAccepted.Height() + 1, nil
 }
 
 // LoadUser returns:
@@ -696,6 +767,16 @@ func (vm *VM) onAccept(tx *txs.Tx) error {
 		return fmt.Errorf("error indexing tx: %w", err)
 	}
 
+	if vm.assetIndex != nil {
+		height, err := vm.acceptingHeight()
+		if err != nil {
+			return err
+		}
+		if err := vm.assetIndex.Accept(height, tx, inputUTXOs); err != nil {
+			return fmt.Errorf("error indexing assets of tx: %w", err)
+		}
+	}
+
 	vm.pubsub.Publish(NewPubSubFilterer(tx))
 	vm.walletService.decided(txID)
 	return nil
@@ -4,15 +4,54 @@
 package avax
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/ava-labs/avalanchego/chains/atomic"
 	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/set"
 )
 
-var _ AtomicUTXOManager = (*atomicUTXOManager)(nil)
+var (
+	_ AtomicUTXOManager = (*atomicUTXOManager)(nil)
+
+	ErrOutboundNotSupported = errors.New("shared memory doesn't support reading exported UTXOs")
+)
+
+// AtomicUTXOStatus is the status of a UTXO that was exported from one chain to
+// another
+type AtomicUTXOStatus uint8
+
+const (
+	// AtomicUTXOUnknown means that the UTXO isn't in shared memory and wasn't
+	// imported by this chain. Shared memory drops a UTXO once it is imported,
+	// so this includes the UTXOs that this chain exported and that were then
+	// imported by the peer chain.
+	AtomicUTXOUnknown AtomicUTXOStatus = iota
+	// AtomicUTXOExported means that the UTXO was exported by this chain and is
+	// pending import on the peer chain
+	AtomicUTXOExported
+	// AtomicUTXOImportable means that the UTXO was exported by the peer chain
+	// and is pending import on this chain
+	AtomicUTXOImportable
+	// AtomicUTXOImported means that the UTXO was imported by this chain
+	AtomicUTXOImported
+)
+
+func (s AtomicUTXOStatus) String() string {
+	switch s {
+	case AtomicUTXOExported:
+		return "Exported"
+	case AtomicUTXOImportable:
+		return "Importable"
+	case AtomicUTXOImported:
+		return "Imported"
+	default:
+		return "Unknown"
+	}
+}
 
 type AtomicUTXOManager interface {
 	// GetAtomicUTXOs returns exported UTXOs such that at least one of the
@@ -32,6 +71,28 @@ type AtomicUTXOManager interface {
 		startUTXOID ids.ID,
 		limit int,
 	) ([]*UTXO, ids.ShortID, ids.ID, error)
+
+	// GetExportedUTXOs returns the UTXOs that were exported to [chainID] and
+	// haven't been imported yet such that at least one of the addresses in
+	// [addrs] is referenced.
+	//
+	// Returns at most [limit] UTXOs.
+	//
+	// Returns the same values as GetAtomicUTXOs. Returns
+	// [ErrOutboundNotSupported] if the shared memory can't read exported
+	// UTXOs.
+	GetExportedUTXOs(
+		chainID ids.ID,
+		addrs set.Set[ids.ShortID],
+		startAddr ids.ShortID,
+		startUTXOID ids.ID,
+		limit int,
+	) ([]*UTXO, ids.ShortID, ids.ID, error)
+
+	// GetAtomicUTXOStatus returns whether [utxoID] is pending import from or
+	// to [chainID]. If [utxoID] isn't in shared memory, [AtomicUTXOUnknown] is
+	// returned.
+	GetAtomicUTXOStatus(chainID ids.ID, utxoID ids.ID) (AtomicUTXOStatus, error)
 }
 
 type atomicUTXOManager struct {
@@ -52,6 +113,60 @@ func (a *atomicUTXOManager) GetAtomicUTXOs(
 	startAddr ids.ShortID,
 	startUTXOID ids.ID,
 	limit int,
+) ([]*UTXO, ids.ShortID, ids.ID, error) {
+	return a.getUTXOs(a.sm.Indexed, chainID, addrs, startAddr, startUTXOID, limit)
+}
+
+func (a *atomicUTXOManager) GetExportedUTXOs(
+	chainID ids.ID,
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
+) ([]*UTXO, ids.ShortID, ids.ID, error) {
+	outbound, ok := a.sm.(atomic.OutboundSharedMemory)
+	if !ok {
+		return nil, ids.ShortID{}, ids.ID{}, ErrOutboundNotSupported
+	}
+	return a.getUTXOs(outbound.OutboundIndexed, chainID, addrs, startAddr, startUTXOID, limit)
+}
+
+func (a *atomicUTXOManager) GetAtomicUTXOStatus(chainID ids.ID, utxoID ids.ID) (AtomicUTXOStatus, error) {
+	keys := [][]byte{utxoID[:]}
+	if outbound, ok := a.sm.(atomic.OutboundSharedMemory); ok {
+		_, err := outbound.OutboundGet(chainID, keys)
+		if err == nil {
+			return AtomicUTXOExported, nil
+		}
+		if !errors.Is(err, database.ErrNotFound) {
+			return AtomicUTXOUnknown, fmt.Errorf("error fetching exported UTXO: %w", err)
+		}
+	}
+
+	_, err := a.sm.Get(chainID, keys)
+	switch {
+	case err == nil:
+		return AtomicUTXOImportable, nil
+	case errors.Is(err, database.ErrNotFound):
+		return AtomicUTXOUnknown, nil
+	default:
+		return AtomicUTXOUnknown, fmt.Errorf("error fetching atomic UTXO: %w", err)
+	}
+}
+
+func (a *atomicUTXOManager) getUTXOs(
+	indexed func(
+		peerChainID ids.ID,
+		traits [][]byte,
+		startTrait,
+		startKey []byte,
+		limit int,
+	) ([][]byte, []byte, []byte, error),
+	chainID ids.ID,
+	addrs set.Set[ids.ShortID],
+	startAddr ids.ShortID,
+	startUTXOID ids.ID,
+	limit int,
 ) ([]*UTXO, ids.ShortID, ids.ID, error) {
 	addrsList := make([][]byte, addrs.Len())
 	i := 0
@@ -61,7 +176,7 @@ func (a *atomicUTXOManager) GetAtomicUTXOs(
 		i++
 	}
 
-	allUTXOBytes, lastAddr, lastUTXO, err := a.sm.Indexed(
+	allUTXOBytes, lastAddr, lastUTXO, err := indexed(
 		chainID,
 		addrsList,
 		startAddr.Bytes(),
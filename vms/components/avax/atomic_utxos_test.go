@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/chains/atomic"
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/codec/linearcodec"
+	"github.com/ava-labs/avalanchego/database/memdb"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestAtomicUTXOManagerExportedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	addr := ids.GenerateTestShortID()
+	utxo := &UTXO{
+		UTXOID: UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 12345,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr},
+			},
+		},
+	}
+
+	c := linearcodec.NewDefault(time.Time{})
+	manager := codec.NewDefaultManager()
+
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	utxoBytes, err := manager.Marshal(codecVersion, utxo)
+	require.NoError(err)
+
+	sourceChainID := ids.GenerateTestID()
+	destinationChainID := ids.GenerateTestID()
+	m := atomic.NewMemory(memdb.New())
+	sourceSM := m.NewSharedMemory(sourceChainID)
+	destinationSM := m.NewSharedMemory(destinationChainID)
+	source := NewAtomicUTXOManager(sourceSM, manager)
+	destination := NewAtomicUTXOManager(destinationSM, manager)
+
+	utxoID := utxo.InputID()
+	status, err := source.GetAtomicUTXOStatus(destinationChainID, utxoID)
+	require.NoError(err)
+	require.Equal(AtomicUTXOUnknown, status)
+
+	require.NoError(sourceSM.Apply(map[ids.ID]*atomic.Requests{
+		destinationChainID: {PutRequests: []*atomic.Element{{
+			Key:    utxoID[:],
+			Value:  utxoBytes,
+			Traits: [][]byte{addr[:]},
+		}}},
+	}))
+
+	utxos, _, _, err := source.GetExportedUTXOs(destinationChainID, set.Of(addr), ids.ShortEmpty, ids.Empty, 10)
+	require.NoError(err)
+	require.Len(utxos, 1)
+	require.Equal(utxoID, utxos[0].InputID())
+
+	status, err = source.GetAtomicUTXOStatus(destinationChainID, utxoID)
+	require.NoError(err)
+	require.Equal(AtomicUTXOExported, status)
+
+	status, err = destination.GetAtomicUTXOStatus(sourceChainID, utxoID)
+	require.NoError(err)
+	require.Equal(AtomicUTXOImportable, status)
+
+	// Importing the UTXO removes it from shared memory
+	require.NoError(destinationSM.Apply(map[ids.ID]*atomic.Requests{
+		sourceChainID: {RemoveRequests: [][]byte{utxoID[:]}},
+	}))
+
+	utxos, _, _, err = source.GetExportedUTXOs(destinationChainID, set.Of(addr), ids.ShortEmpty, ids.Empty, 10)
+	require.NoError(err)
+	require.Empty(utxos)
+
+	status, err = source.GetAtomicUTXOStatus(destinationChainID, utxoID)
+	require.NoError(err)
+	require.Equal(AtomicUTXOUnknown, status)
+}
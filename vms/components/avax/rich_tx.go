@@ -0,0 +1,114 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+)
+
+// RichOwner is the owner of an output, with its addresses formatted for the
+// chain that the output is on
+type RichOwner struct {
+	Locktime  avajson.Uint64 `json:"locktime"`
+	Threshold avajson.Uint32 `json:"threshold"`
+	Addresses []string       `json:"addresses"`
+}
+
+// NewRichOwner returns [owners] with its addresses formatted for [chainID]
+func NewRichOwner(formatter AddressManager, chainID ids.ID, owners *secp256k1fx.OutputOwners) (*RichOwner, error) {
+	addrs := make([]string, len(owners.Addrs))
+	for i, addr := range owners.Addrs {
+		var err error
+		addrs[i], err = formatter.FormatAddress(chainID, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &RichOwner{
+		Locktime:  avajson.Uint64(owners.Locktime),
+		Threshold: avajson.Uint32(owners.Threshold),
+		Addresses: addrs,
+	}, nil
+}
+
+// RichInput is an input of a tx, annotated with the UTXO that it spends
+type RichInput struct {
+	UTXOID string `json:"utxoID"`
+	// SourceChain is the chain that the spent UTXO is on
+	SourceChain ids.ID         `json:"sourceChain"`
+	AssetID     ids.ID         `json:"assetID"`
+	AssetSymbol string         `json:"assetSymbol,omitempty"`
+	Amount      avajson.Uint64 `json:"amount"`
+	// Owner is nil if the spent UTXO couldn't be resolved, which is the case
+	// for UTXOs that were imported from another chain
+	Owner *RichOwner `json:"owner,omitempty"`
+}
+
+// RichOutput is an output of a tx that holds funds
+type RichOutput struct {
+	// DestinationChain is the chain that the output is on
+	DestinationChain ids.ID         `json:"destinationChain"`
+	AssetID          ids.ID         `json:"assetID"`
+	AssetSymbol      string         `json:"assetSymbol,omitempty"`
+	Amount           avajson.Uint64 `json:"amount"`
+	// Stake is true if the output is locked until its staker leaves the
+	// validator set
+	Stake bool       `json:"stake,omitempty"`
+	Owner *RichOwner `json:"owner,omitempty"`
+}
+
+// RichAmount is an amount of an asset
+type RichAmount struct {
+	AssetID     ids.ID         `json:"assetID"`
+	AssetSymbol string         `json:"assetSymbol,omitempty"`
+	Amount      avajson.Uint64 `json:"amount"`
+}
+
+// GetBurned returns the amount of each asset that [ins] consume but [outs]
+// don't produce, sorted by asset ID.
+func GetBurned(ins []RichInput, outs []RichOutput) ([]RichAmount, error) {
+	var (
+		consumed = make(map[ids.ID]uint64)
+		symbols  = make(map[ids.ID]string)
+		err      error
+	)
+	for _, in := range ins {
+		consumed[in.AssetID], err = safemath.Add64(consumed[in.AssetID], uint64(in.Amount))
+		if err != nil {
+			return nil, err
+		}
+		symbols[in.AssetID] = in.AssetSymbol
+	}
+	for _, out := range outs {
+		consumed[out.AssetID], err = safemath.Sub(consumed[out.AssetID], uint64(out.Amount))
+		if err != nil {
+			// Txs that produce more than they consume must be minting the
+			// asset, so it isn't burned.
+			consumed[out.AssetID] = 0
+		}
+	}
+
+	assetIDs := make([]ids.ID, 0, len(consumed))
+	for assetID, amount := range consumed {
+		if amount > 0 {
+			assetIDs = append(assetIDs, assetID)
+		}
+	}
+	utils.Sort(assetIDs)
+
+	burned := make([]RichAmount, len(assetIDs))
+	for i, assetID := range assetIDs {
+		burned[i] = RichAmount{
+			AssetID:     assetID,
+			AssetSymbol: symbols[assetID],
+			Amount:      avajson.Uint64(consumed[assetID]),
+		}
+	}
+	return burned, nil
+}
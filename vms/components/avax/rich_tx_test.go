@@ -0,0 +1,36 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+)
+
+func TestGetBurned(t *testing.T) {
+	require := require.New(t)
+
+	burnedAssetID := ids.ID{1}
+	mintedAssetID := ids.ID{2}
+	ins := []RichInput{
+		{AssetID: burnedAssetID, AssetSymbol: "A", Amount: 10},
+		{AssetID: burnedAssetID, AssetSymbol: "A", Amount: 5},
+		{AssetID: mintedAssetID, Amount: 1},
+	}
+	outs := []RichOutput{
+		{AssetID: burnedAssetID, Amount: 12},
+		// Produces more than is consumed, which isn't burning
+		{AssetID: mintedAssetID, Amount: 2},
+	}
+	burned, err := GetBurned(ins, outs)
+	require.NoError(err)
+	require.Equal([]RichAmount{{
+		AssetID:     burnedAssetID,
+		AssetSymbol: "A",
+		Amount:      3,
+	}}, burned)
+}
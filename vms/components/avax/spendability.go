@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/api"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+// LockState is the state of an output relative to a set of addresses at a
+// given time
+type LockState int
+
+const (
+	// Unlocked outputs can be spent by the addresses now
+	Unlocked LockState = iota
+	// Locked outputs can't be spent by anyone until their locktime
+	Locked
+	// RequiresOtherSigners outputs aren't locked, but the addresses can't
+	// provide enough signatures to spend them alone
+	RequiresOtherSigners
+)
+
+// GetLockState returns the state of an output owned by [owners] relative to
+// [addrs] at [time], following the rules of secp256k1fx.Fx.VerifyCredentials.
+func GetLockState(owners *secp256k1fx.OutputOwners, addrs set.Set[ids.ShortID], time uint64) LockState {
+	if owners.Locktime > time {
+		return Locked
+	}
+
+	var numSigners uint32
+	for _, addr := range owners.Addrs {
+		if addrs.Contains(addr) {
+			numSigners++
+		}
+	}
+	if numSigners < owners.Threshold {
+		return RequiresOtherSigners
+	}
+	return Unlocked
+}
+
+// GetSpendability returns the spendability of an output owned by [owners]
+// relative to [addrs] at [time].
+func GetSpendability(
+	formatter AddressManager,
+	owners *secp256k1fx.OutputOwners,
+	addrs set.Set[ids.ShortID],
+	time uint64,
+) (*api.Spendability, error) {
+	addrStrs := make([]string, len(owners.Addrs))
+	for i, addr := range owners.Addrs {
+		var err error
+		addrStrs[i], err = formatter.FormatLocalAddress(addr)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't format address %s: %w", addr, err)
+		}
+	}
+	return &api.Spendability{
+		Spendable: GetLockState(owners, addrs, time) == Unlocked,
+		Locktime:  avajson.Uint64(owners.Locktime),
+		Threshold: avajson.Uint32(owners.Threshold),
+		Addresses: addrStrs,
+	}, nil
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package avax
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+func TestGetLockState(t *testing.T) {
+	addr0 := ids.GenerateTestShortID()
+	addr1 := ids.GenerateTestShortID()
+
+	tests := []struct {
+		name     string
+		owners   *secp256k1fx.OutputOwners
+		addrs    set.Set[ids.ShortID]
+		time     uint64
+		expected LockState
+	}{
+		{
+			name: "unlocked",
+			owners: &secp256k1fx.OutputOwners{
+				Locktime:  10,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr0},
+			},
+			addrs:    set.Of(addr0),
+			time:     10,
+			expected: Unlocked,
+		},
+		{
+			name: "locked",
+			owners: &secp256k1fx.OutputOwners{
+				Locktime:  11,
+				Threshold: 1,
+				Addrs:     []ids.ShortID{addr0},
+			},
+			addrs:    set.Of(addr0),
+			time:     10,
+			expected: Locked,
+		},
+		{
+			name: "multisig with every signer",
+			owners: &secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr0, addr1},
+			},
+			addrs:    set.Of(addr0, addr1),
+			expected: Unlocked,
+		},
+		{
+			name: "multisig without enough signers",
+			owners: &secp256k1fx.OutputOwners{
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr0, addr1},
+			},
+			addrs:    set.Of(addr0),
+			expected: RequiresOtherSigners,
+		},
+		{
+			name: "locked multisig without enough signers",
+			owners: &secp256k1fx.OutputOwners{
+				Locktime:  11,
+				Threshold: 2,
+				Addrs:     []ids.ShortID{addr0, addr1},
+			},
+			addrs:    set.Of(addr0),
+			time:     10,
+			expected: Locked,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.expected, GetLockState(test.owners, test.addrs, test.time))
+		})
+	}
+}
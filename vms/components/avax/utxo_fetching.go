@@ -65,11 +65,46 @@ func GetPaginatedUTXOs(
 	lastUTXOID ids.ID,
 	limit int,
 ) ([]*UTXO, ids.ShortID, ids.ID, error) {
+	utxos, lastAddr, lastUTXOID, _, err := GetFilteredPaginatedUTXOs(
+		db,
+		addrs,
+		lastAddr,
+		lastUTXOID,
+		limit,
+		math.MaxInt,
+		func(*UTXO) bool { return true },
+	)
+	return utxos, lastAddr, lastUTXOID, err
+}
+
+// GetFilteredPaginatedUTXOs is the same as GetPaginatedUTXOs, except that only
+// UTXOs for which [filter] returns true are returned and counted towards
+// [limit].
+//
+// At most [maxSearched] UTXOs are searched, so that a filter that rarely
+// matches doesn't cause every UTXO of [addrs] to be read in one call.
+//
+// Returns:
+// * The fetched UTXOs
+// * The address associated with the last UTXO searched
+// * The ID of the last UTXO searched
+// * Whether every UTXO after the start was searched
+//
+// If not every UTXO was searched, more UTXOs may be found by searching again
+// from the returned address and ID.
+func GetFilteredPaginatedUTXOs(
+	db UTXOReader,
+	addrs set.Set[ids.ShortID],
+	lastAddr ids.ShortID,
+	lastUTXOID ids.ID,
+	limit int,
+	maxSearched int,
+	filter func(*UTXO) bool,
+) ([]*UTXO, ids.ShortID, ids.ID, bool, error) {
 	var (
-		utxos      []*UTXO
-		seen       set.Set[ids.ID] // IDs of UTXOs already in the list
-		searchSize = limit         // the limit diminishes which can impact the expected return
-		addrsList  = addrs.List()
+		utxos     []*UTXO
+		seen      set.Set[ids.ID] // IDs of UTXOs already searched
+		addrsList = addrs.List()
 	)
 	utils.Sort(addrsList) // enforces the same ordering for pagination
 	for _, addr := range addrsList {
@@ -82,29 +117,42 @@ func GetPaginatedUTXOs(
 
 		lastAddr = addr // The last address searched
 
-		utxoIDs, err := db.UTXOIDs(addr.Bytes(), start, searchSize) // Get UTXOs associated with [addr]
-		if err != nil {
-			return nil, ids.ShortID{}, ids.ID{}, fmt.Errorf("couldn't get UTXOs for address %s: %w", addr, err)
-		}
-		for _, utxoID := range utxoIDs {
-			lastUTXOID = utxoID // The last searched UTXO - not the last found
-
-			if seen.Contains(utxoID) { // Already have this UTXO in the list
-				continue
-			}
-
-			utxo, err := db.GetUTXO(utxoID)
+		// Because UTXOs can be filtered out, all the UTXOs of [addr] may need
+		// to be searched to find [limit] UTXOs.
+		for {
+			searchSize := min(limit, maxSearched)
+			utxoIDs, err := db.UTXOIDs(addr.Bytes(), start, searchSize)
 			if err != nil {
-				return nil, ids.ShortID{}, ids.ID{}, fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
+				return nil, ids.ShortID{}, ids.ID{}, false, fmt.Errorf("couldn't get UTXOs for address %s: %w", addr, err)
 			}
+			for _, utxoID := range utxoIDs {
+				start = utxoID
+				lastUTXOID = utxoID // The last searched UTXO - not the last found
+				maxSearched--
+
+				if !seen.Contains(utxoID) { // Skip UTXOs that were already searched
+					seen.Add(utxoID)
 
-			utxos = append(utxos, utxo)
-			seen.Add(utxoID)
-			limit--
-			if limit <= 0 {
-				return utxos, lastAddr, lastUTXOID, nil // Found [limit] utxos; stop.
+					utxo, err := db.GetUTXO(utxoID)
+					if err != nil {
+						return nil, ids.ShortID{}, ids.ID{}, false, fmt.Errorf("couldn't get UTXO %s: %w", utxoID, err)
+					}
+					if filter(utxo) {
+						utxos = append(utxos, utxo)
+					}
+				}
+
+				if len(utxos) >= limit {
+					return utxos, lastAddr, lastUTXOID, false, nil // Found [limit] utxos; stop.
+				}
+				if maxSearched <= 0 {
+					return utxos, lastAddr, lastUTXOID, false, nil // Searched [maxSearched] utxos; stop.
+				}
+			}
+			if len(utxoIDs) < searchSize {
+				break // Searched every UTXO of [addr]
 			}
 		}
 	}
-	return utxos, lastAddr, lastUTXOID, nil // Didn't reach the [limit] utxos; no more were found
+	return utxos, lastAddr, lastUTXOID, true, nil // Didn't reach the [limit] utxos; no more were found
 }
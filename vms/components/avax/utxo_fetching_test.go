@@ -159,3 +159,74 @@ func TestGetPaginatedUTXOs(t *testing.T) {
 	require.NoError(err)
 	require.Len(notPaginatedUTXOs, len(totalUTXOs))
 }
+
+func TestGetFilteredPaginatedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	addr0 := ids.GenerateTestShortID()
+	addr1 := ids.GenerateTestShortID()
+	addrs := set.Of(addr0, addr1)
+
+	c := linearcodec.NewDefault(time.Time{})
+	manager := codec.NewDefaultManager()
+
+	require.NoError(c.RegisterType(&secp256k1fx.TransferOutput{}))
+	require.NoError(manager.RegisterCodec(codecVersion, c))
+
+	db := memdb.New()
+	s, err := NewUTXOState(db, manager, trackChecksum)
+	require.NoError(err)
+
+	// Create 100 UTXOs on each address, a tenth of which have [assetID].
+	assetID := ids.GenerateTestID()
+	for i := 0; i < 100; i++ {
+		utxoAssetID := ids.GenerateTestID()
+		if i%10 == 0 {
+			utxoAssetID = assetID
+		}
+		for j, addr := range []ids.ShortID{addr0, addr1} {
+			require.NoError(s.PutUTXO(&UTXO{
+				UTXOID: UTXOID{
+					TxID:        ids.GenerateTestID(),
+					OutputIndex: uint32(j),
+				},
+				Asset: Asset{ID: utxoAssetID},
+				Out: &secp256k1fx.TransferOutput{
+					Amt: 12345,
+					OutputOwners: secp256k1fx.OutputOwners{
+						Threshold: 1,
+						Addrs:     []ids.ShortID{addr},
+					},
+				},
+			}))
+		}
+	}
+
+	filter := func(utxo *UTXO) bool {
+		return utxo.AssetID() == assetID
+	}
+	var (
+		fetchedUTXOs []*UTXO
+		lastAddr     = ids.ShortEmpty
+		lastIdx      = ids.Empty
+		searchedAll  bool
+		fetchedIDs   set.Set[ids.ID]
+		numCalls     int
+	)
+	for !searchedAll {
+		// Only 25 UTXOs are searched per call, so some calls may return fewer
+		// than [limit] UTXOs even though more remain.
+		fetchedUTXOs, lastAddr, lastIdx, searchedAll, err = GetFilteredPaginatedUTXOs(s, addrs, lastAddr, lastIdx, 3, 25, filter)
+		require.NoError(err)
+		require.LessOrEqual(len(fetchedUTXOs), 3)
+		for _, utxo := range fetchedUTXOs {
+			require.Equal(assetID, utxo.AssetID())
+			require.False(fetchedIDs.Contains(utxo.InputID()))
+			fetchedIDs.Add(utxo.InputID())
+		}
+		numCalls++
+	}
+	require.Len(fetchedIDs, 20)
+	// Searching 200 UTXOs, 25 at a time, takes at least 8 calls.
+	require.GreaterOrEqual(numCalls, 8)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package index
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+
+	stdjson "encoding/json"
+)
+
+// DecodedTx is a tx that was decoded from a container by the VM's parser
+type DecodedTx struct {
+	ID ids.ID `json:"id"`
+	// Type is the name of the tx type, such as "ImportTx"
+	Type string `json:"type"`
+	// Tx is the JSON representation of the tx
+	Tx stdjson.RawMessage `json:"tx"`
+}
+
+// ContainerParser decodes the containers that were accepted on a chain so that
+// their contents can be served by the node's index API.
+type ContainerParser interface {
+	// ParseBlock returns the height of the block [blkBytes] and its txs.
+	// [blkBytes] is the block as it was built by the VM, rather than the
+	// proposervm block that wraps it.
+	ParseBlock(blkBytes []byte) (uint64, []DecodedTx, error)
+	// ParseTx returns the tx [txBytes].
+	ParseTx(txBytes []byte) (DecodedTx, error)
+}
+
+// ContainerParserFactory returns the ContainerParser of the chain of [ctx].
+type ContainerParserFactory func(ctx *snow.Context) (ContainerParser, error)
+
+// NewDecodedTx returns the decoded form of [tx], whose unsigned tx is
+// [unsignedTx].
+func NewDecodedTx(txID ids.ID, unsignedTx interface{}, tx interface{}) (DecodedTx, error) {
+	txJSON, err := stdjson.Marshal(tx)
+	if err != nil {
+		return DecodedTx{}, fmt.Errorf("couldn't marshal tx %s: %w", txID, err)
+	}
+	return DecodedTx{
+		ID:   txID,
+		Type: reflect.Indirect(reflect.ValueOf(unsignedTx)).Type().Name(),
+		Tx:   txJSON,
+	}, nil
+}
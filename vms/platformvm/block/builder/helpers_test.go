@@ -37,6 +37,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
@@ -193,6 +194,8 @@ func newEnvironment(t *testing.T, f fork) *environment { //nolint:unparam
 		res.state,
 		&res.backend,
 		pvalidators.TestManager,
+		func(*txs.Tx) error { return nil },
+		func(block.Block, bool) {},
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&res.ctx.Lock, res.blkManager)
@@ -13,6 +13,7 @@ import (
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/validators"
 )
 
@@ -30,14 +31,22 @@ type acceptor struct {
 	metrics      metrics.Metrics
 	validators   validators.Manager
 	bootstrapped *utils.Atomic[bool]
+	// Invariant: onAccept is called when [tx] is being marked as accepted, but
+	// before its state changes are applied.
+	// Invariant: any error returned by onAccept should be considered fatal.
+	onAccept func(*txs.Tx) error
+	// Invariant: onAcceptBlock is called after the state changes of [blk]
+	// have been committed. [aborted] is true if [blk] is a proposal block
+	// whose abort option was accepted.
+	onAcceptBlock func(blk block.Block, aborted bool)
 }
 
 func (a *acceptor) BanffAbortBlock(b *block.BanffAbortBlock) error {
-	return a.optionBlock(b, "banff abort")
+	return a.optionBlock(b, true, "banff abort")
 }
 
 func (a *acceptor) BanffCommitBlock(b *block.BanffCommitBlock) error {
-	return a.optionBlock(b, "banff commit")
+	return a.optionBlock(b, false, "banff commit")
 }
 
 func (a *acceptor) BanffProposalBlock(b *block.BanffProposalBlock) error {
@@ -50,11 +59,11 @@ func (a *acceptor) BanffStandardBlock(b *block.BanffStandardBlock) error {
 }
 
 func (a *acceptor) ApricotAbortBlock(b *block.ApricotAbortBlock) error {
-	return a.optionBlock(b, "apricot abort")
+	return a.optionBlock(b, true, "apricot abort")
 }
 
 func (a *acceptor) ApricotCommitBlock(b *block.ApricotCommitBlock) error {
-	return a.optionBlock(b, "apricot commit")
+	return a.optionBlock(b, false, "apricot commit")
 }
 
 func (a *acceptor) ApricotProposalBlock(b *block.ApricotProposalBlock) error {
@@ -79,6 +88,10 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
 
+	if err := a.acceptTxs(b); err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
@@ -104,6 +117,8 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 		)
 	}
 
+	a.onAcceptBlock(b, false)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", "apricot atomic"),
@@ -116,7 +131,7 @@ func (a *acceptor) ApricotAtomicBlock(b *block.ApricotAtomicBlock) error {
 	return nil
 }
 
-func (a *acceptor) optionBlock(b block.Block, blockType string) error {
+func (a *acceptor) optionBlock(b block.Block, isAbort bool, blockType string) error {
 	parentID := b.Parent()
 	parentState, ok := a.blkIDToState[parentID]
 	if !ok {
@@ -140,16 +155,24 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		return err
 	}
 
+	blkState, ok := a.blkIDToState[blkID]
+	if !ok {
+		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
+	}
+
+	// The proposal's txs are accepted even if it was aborted, as both of its
+	// outcomes consume the inputs of its txs and return any stake to its
+	// owners.
+	if err := a.acceptTxs(parentState.statelessBlock); err != nil {
+		return err
+	}
+
 	if parentState.onDecisionState != nil {
 		if err := parentState.onDecisionState.Apply(a.state); err != nil {
 			return err
 		}
 	}
 
-	blkState, ok := a.blkIDToState[blkID]
-	if !ok {
-		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
-	}
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
 	}
@@ -173,6 +196,9 @@ func (a *acceptor) optionBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.onAcceptBlock(parentState.statelessBlock, isAbort)
+	a.onAcceptBlock(b, false)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -228,6 +254,10 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		return fmt.Errorf("%w %s", errMissingBlockState, blkID)
 	}
 
+	if err := a.acceptTxs(b); err != nil {
+		return err
+	}
+
 	// Update the state to reflect the changes made in [onAcceptState].
 	if err := blkState.onAcceptState.Apply(a.state); err != nil {
 		return err
@@ -252,6 +282,8 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 		onAcceptFunc()
 	}
 
+	a.onAcceptBlock(b, false)
+
 	a.ctx.Log.Trace(
 		"accepted block",
 		zap.String("blockType", blockType),
@@ -264,6 +296,22 @@ func (a *acceptor) standardBlock(b block.Block, blockType string) error {
 	return nil
 }
 
+// acceptTxs marks the txs in [b] as accepted. It must be called before the
+// state changes of [b] are applied.
+func (a *acceptor) acceptTxs(b block.Block) error {
+	for _, tx := range b.Txs() {
+		if err := a.onAccept(tx); err != nil {
+			return fmt.Errorf(
+				"failed to mark tx %s in block %s as accepted: %w",
+				tx.ID(),
+				b.ID(),
+				err,
+			)
+		}
+	}
+	return nil
+}
+
 func (a *acceptor) commonAccept(b block.Block) error {
 	blkID := b.ID()
 
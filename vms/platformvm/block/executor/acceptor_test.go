@@ -60,8 +60,10 @@ func TestAcceptorVisitProposalBlock(t *testing.T) {
 			},
 			state: s,
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:       metrics.Noop,
+		validators:    validators.TestManager,
+		onAccept:      func(*txs.Tx) error { return nil },
+		onAcceptBlock: func(block.Block, bool) {},
 	}
 
 	require.NoError(acceptor.ApricotProposalBlock(blk))
@@ -95,8 +97,10 @@ func TestAcceptorVisitAtomicBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:       metrics.Noop,
+		validators:    validators.TestManager,
+		onAccept:      func(*txs.Tx) error { return nil },
+		onAcceptBlock: func(block.Block, bool) {},
 	}
 
 	blk, err := block.NewApricotAtomicBlock(
@@ -175,8 +179,10 @@ func TestAcceptorVisitStandardBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:    metrics.Noop,
-		validators: validators.TestManager,
+		metrics:       metrics.Noop,
+		validators:    validators.TestManager,
+		onAccept:      func(*txs.Tx) error { return nil },
+		onAcceptBlock: func(block.Block, bool) {},
 	}
 
 	blk, err := block.NewBanffStandardBlock(
@@ -264,9 +270,11 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validators.TestManager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:       metrics.Noop,
+		validators:    validators.TestManager,
+		onAccept:      func(*txs.Tx) error { return nil },
+		onAcceptBlock: func(block.Block, bool) {},
+		bootstrapped:  &utils.Atomic[bool]{},
 	}
 
 	blk, err := block.NewApricotCommitBlock(parentID, 1 /*height*/)
@@ -330,6 +338,7 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 	}
 
 	batch := database.NewMockBatch(ctrl)
+	proposalTx := &txs.Tx{Unsigned: &txs.AdvanceTimeTx{}}
 
 	// Set expected calls on dependencies.
 	// Make sure the parent is accepted first.
@@ -344,6 +353,7 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 		s.EXPECT().SetHeight(blk.Height()).Times(1),
 		s.EXPECT().AddStatelessBlock(blk).Times(1),
 
+		parentStatelessBlk.EXPECT().Txs().Return([]*txs.Tx{proposalTx}).Times(1),
 		parentOnCommitState.EXPECT().Apply(s).Times(1),
 		s.EXPECT().CommitBatch().Return(batch, nil).Times(1),
 		sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1),
@@ -351,8 +361,25 @@ func TestAcceptorVisitCommitBlock(t *testing.T) {
 		s.EXPECT().Abort().Times(1),
 	)
 
+	var acceptedTxs []*txs.Tx
+	acceptor.onAccept = func(tx *txs.Tx) error {
+		acceptedTxs = append(acceptedTxs, tx)
+		return nil
+	}
+	acceptedBlks := make(map[block.Block]bool)
+	acceptor.onAcceptBlock = func(blk block.Block, aborted bool) {
+		acceptedBlks[blk] = aborted
+	}
 	require.NoError(acceptor.ApricotCommitBlock(blk))
 	require.True(calledOnAcceptFunc)
+	require.Equal([]*txs.Tx{proposalTx}, acceptedTxs)
+	require.Equal(
+		map[block.Block]bool{
+			parentStatelessBlk: false,
+			blk:                false,
+		},
+		acceptedBlks,
+	)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
 
@@ -374,9 +401,11 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 				SharedMemory: sharedMemory,
 			},
 		},
-		metrics:      metrics.Noop,
-		validators:   validators.TestManager,
-		bootstrapped: &utils.Atomic[bool]{},
+		metrics:       metrics.Noop,
+		validators:    validators.TestManager,
+		onAccept:      func(*txs.Tx) error { return nil },
+		onAcceptBlock: func(block.Block, bool) {},
+		bootstrapped:  &utils.Atomic[bool]{},
 	}
 
 	blk, err := block.NewApricotAbortBlock(parentID, 1 /*height*/)
@@ -440,6 +469,7 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 	}
 
 	batch := database.NewMockBatch(ctrl)
+	proposalTx := &txs.Tx{Unsigned: &txs.AdvanceTimeTx{}}
 
 	// Set expected calls on dependencies.
 	// Make sure the parent is accepted first.
@@ -454,6 +484,7 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 		s.EXPECT().SetHeight(blk.Height()).Times(1),
 		s.EXPECT().AddStatelessBlock(blk).Times(1),
 
+		parentStatelessBlk.EXPECT().Txs().Return([]*txs.Tx{proposalTx}).Times(1),
 		parentOnAbortState.EXPECT().Apply(s).Times(1),
 		s.EXPECT().CommitBatch().Return(batch, nil).Times(1),
 		sharedMemory.EXPECT().Apply(atomicRequests, batch).Return(nil).Times(1),
@@ -461,7 +492,26 @@ func TestAcceptorVisitAbortBlock(t *testing.T) {
 		s.EXPECT().Abort().Times(1),
 	)
 
+	var acceptedTxs []*txs.Tx
+	acceptor.onAccept = func(tx *txs.Tx) error {
+		acceptedTxs = append(acceptedTxs, tx)
+		return nil
+	}
+	acceptedBlks := make(map[block.Block]bool)
+	acceptor.onAcceptBlock = func(blk block.Block, aborted bool) {
+		acceptedBlks[blk] = aborted
+	}
 	require.NoError(acceptor.ApricotAbortBlock(blk))
 	require.True(calledOnAcceptFunc)
+	// Aborted proposals still consume their inputs and return their stake, so
+	// their txs are marked as accepted.
+	require.Equal([]*txs.Tx{proposalTx}, acceptedTxs)
+	require.Equal(
+		map[block.Block]bool{
+			parentStatelessBlk: true,
+			blk:                false,
+		},
+		acceptedBlks,
+	)
 	require.Equal(blk.ID(), acceptor.backend.lastAccepted)
 }
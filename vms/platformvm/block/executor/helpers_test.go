@@ -37,6 +37,7 @@ import (
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/api"
+	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
@@ -214,6 +215,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 			res.state,
 			res.backend,
 			pvalidators.TestManager,
+			func(*txs.Tx) error { return nil },
+			func(block.Block, bool) {},
 		)
 		addSubnet(res)
 	} else {
@@ -223,6 +226,8 @@ func newEnvironment(t *testing.T, ctrl *gomock.Controller, f fork) *environment
 			res.mockedState,
 			res.backend,
 			pvalidators.TestManager,
+			func(*txs.Tx) error { return nil },
+			func(block.Block, bool) {},
 		)
 		// we do not add any subnet to state, since we can mock
 		// whatever we need
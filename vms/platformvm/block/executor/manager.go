@@ -41,6 +41,12 @@ type Manager interface {
 	// preferred state. This should *not* be used to verify transactions in a block.
 	VerifyTx(tx *txs.Tx) error
 
+	// SimulateTx verifies [tx] in the same way as VerifyTx and returns the
+	// state that would result from executing it on top of the currently
+	// preferred state. Unlike VerifyTx, executor.ErrFutureStakeTime is
+	// returned if [tx] can't be executed until the chain time is advanced.
+	SimulateTx(tx *txs.Tx) (state.Diff, error)
+
 	// VerifyUniqueInputs verifies that the inputs are not duplicated in the
 	// provided blk or any of its ancestors pinned in memory.
 	VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error
@@ -52,6 +58,8 @@ func NewManager(
 	s state.State,
 	txExecutorBackend *executor.Backend,
 	validatorManager validators.Manager,
+	onAccept func(*txs.Tx) error,
+	onAcceptBlock func(block.Block, bool),
 ) Manager {
 	lastAccepted := s.GetLastAccepted()
 	backend := &backend{
@@ -69,10 +77,12 @@ func NewManager(
 			txExecutorBackend: txExecutorBackend,
 		},
 		acceptor: &acceptor{
-			backend:      backend,
-			metrics:      metrics,
-			validators:   validatorManager,
-			bootstrapped: txExecutorBackend.Bootstrapped,
+			backend:       backend,
+			metrics:       metrics,
+			validators:    validatorManager,
+			bootstrapped:  txExecutorBackend.Bootstrapped,
+			onAccept:      onAccept,
+			onAcceptBlock: onAcceptBlock,
 		},
 		rejector: &rejector{
 			backend:         backend,
@@ -123,38 +133,48 @@ func (m *manager) Preferred() ids.ID {
 }
 
 func (m *manager) VerifyTx(tx *txs.Tx) error {
+	_, err := m.SimulateTx(tx)
+	// We ignore [errFutureStakeTime] here because the time will be advanced
+	// when this transaction is issued.
+	//
+	// TODO: Remove this check post-Durango.
+	if errors.Is(err, executor.ErrFutureStakeTime) {
+		return nil
+	}
+	return err
+}
+
+func (m *manager) SimulateTx(tx *txs.Tx) (state.Diff, error) {
 	if !m.txExecutorBackend.Bootstrapped.Get() {
-		return ErrChainNotSynced
+		return nil, ErrChainNotSynced
 	}
 
 	stateDiff, err := state.NewDiff(m.preferred, m)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	nextBlkTime, _, err := executor.NextBlockTime(stateDiff, m.txExecutorBackend.Clk)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	_, err = executor.AdvanceTimeTo(m.txExecutorBackend, stateDiff, nextBlkTime)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	// If the staker starts too far in the future, the tx isn't executed, so
+	// [stateDiff] wouldn't include its changes.
 	err = tx.Unsigned.Visit(&executor.StandardTxExecutor{
 		Backend: m.txExecutorBackend,
 		State:   stateDiff,
 		Tx:      tx,
 	})
-	// We ignore [errFutureStakeTime] here because the time will be advanced
-	// when this transaction is issued.
-	//
-	// TODO: Remove this check post-Durango.
-	if errors.Is(err, executor.ErrFutureStakeTime) {
-		return nil
+	if err != nil {
+		return nil, err
 	}
-	return err
+	return stateDiff, nil
 }
 
 func (m *manager) VerifyUniqueInputs(blkID ids.ID, inputs set.Set[ids.ID]) error {
@@ -0,0 +1,472 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	walletbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
+)
+
+const (
+	BaseTxType                     = "baseTx"
+	CreateSubnetTxType             = "createSubnet"
+	CreateChainTxType              = "createChain"
+	AddSubnetValidatorTxType       = "addSubnetValidator"
+	AddPermissionlessDelegatorType = "addPermissionlessDelegator"
+	ExportTxType                   = "export"
+	ImportTxType                   = "import"
+)
+
+var (
+	errUnknownBuildTxType = errors.New("unknown tx type")
+	errMissingTxParams    = errors.New("missing tx params")
+	errUnknownInputType   = errors.New("unknown input type")
+	errUnknownOutputType  = errors.New("unknown output type")
+	errUnknownAuthType    = errors.New("unknown subnet auth type")
+	errMissingInputUTXO   = errors.New("missing input UTXO")
+
+	_ walletbuilder.Backend = (*buildTxBackend)(nil)
+)
+
+// BuildTxOutput is an output that a built tx should create
+type BuildTxOutput struct {
+	AssetID ids.ID         `json:"assetID"`
+	Amount  avajson.Uint64 `json:"amount"`
+	platformapi.Owner
+}
+
+// BuildBaseTxParams are the params of a [BaseTxType] tx
+type BuildBaseTxParams struct {
+	Outputs []BuildTxOutput `json:"outputs"`
+}
+
+// BuildCreateSubnetTxParams are the params of a [CreateSubnetTxType] tx
+type BuildCreateSubnetTxParams struct {
+	Owner platformapi.Owner `json:"owner"`
+}
+
+// BuildCreateChainTxParams are the params of a [CreateChainTxType] tx
+type BuildCreateChainTxParams struct {
+	SubnetID    ids.ID              `json:"subnetID"`
+	VMID        ids.ID              `json:"vmID"`
+	FxIDs       []ids.ID            `json:"fxIDs"`
+	ChainName   string              `json:"chainName"`
+	GenesisData string              `json:"genesisData"`
+	Encoding    formatting.Encoding `json:"encoding"`
+}
+
+// BuildStakerParams describe the staking period of a validator or delegator
+type BuildStakerParams struct {
+	NodeID    ids.NodeID     `json:"nodeID"`
+	SubnetID  ids.ID         `json:"subnetID"`
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+	Weight    avajson.Uint64 `json:"weight"`
+}
+
+// BuildAddSubnetValidatorTxParams are the params of a
+// [AddSubnetValidatorTxType] tx
+type BuildAddSubnetValidatorTxParams struct {
+	BuildStakerParams
+}
+
+// BuildAddPermissionlessDelegatorTxParams are the params of a
+// [AddPermissionlessDelegatorType] tx
+type BuildAddPermissionlessDelegatorTxParams struct {
+	BuildStakerParams
+	// AssetID defaults to AVAX if empty
+	AssetID      ids.ID            `json:"assetID"`
+	RewardsOwner platformapi.Owner `json:"rewardsOwner"`
+}
+
+// BuildExportTxParams are the params of a [ExportTxType] tx
+type BuildExportTxParams struct {
+	DestinationChain string          `json:"destinationChain"`
+	Outputs          []BuildTxOutput `json:"outputs"`
+}
+
+// BuildImportTxParams are the params of a [ImportTxType] tx
+type BuildImportTxParams struct {
+	SourceChain string            `json:"sourceChain"`
+	To          platformapi.Owner `json:"to"`
+}
+
+// BuildTxSigner describes a set of signatures that must be provided to issue
+// a built tx. Signers are returned in the order that their credentials must
+// be included in the signed tx.
+type BuildTxSigner struct {
+	// UTXO consumed by the input. Empty for a subnet authorization.
+	UTXOID string `json:"utxoID,omitempty"`
+	// Subnet that must authorize the tx. Nil for an input.
+	SubnetID *ids.ID `json:"subnetID,omitempty"`
+	// Indices of the owners that must sign.
+	SigIndices []avajson.Uint32 `json:"sigIndices"`
+	// Addresses of the owners that must sign, in the same order as
+	// [SigIndices].
+	Addresses []string `json:"addresses"`
+}
+
+// buildTxBackend provides the wallet builder with access to the last
+// accepted state of the VM.
+type buildTxBackend struct {
+	walletbuilder.Context
+
+	vm    *VM
+	addrs set.Set[ids.ShortID]
+	// utxos contains every UTXO returned to the builder, by inputID.
+	utxos map[ids.ID]*avax.UTXO
+	// owners contains every subnet owner returned to the builder.
+	owners map[ids.ID]fx.Owner
+}
+
+func newBuildTxBackend(vm *VM, addrs set.Set[ids.ShortID]) *buildTxBackend {
+	timestamp := vm.state.GetTimestamp()
+	return &buildTxBackend{
+		Context: walletbuilder.NewContext(
+			vm.ctx.NetworkID,
+			vm.ctx.AVAXAssetID,
+			vm.TxFee,
+			vm.GetCreateSubnetTxFee(timestamp),
+			vm.TransformSubnetTxFee,
+			vm.GetCreateBlockchainTxFee(timestamp),
+			vm.AddPrimaryNetworkValidatorFee,
+			vm.AddPrimaryNetworkDelegatorFee,
+			vm.AddSubnetValidatorFee,
+			vm.AddSubnetDelegatorFee,
+		),
+		vm:     vm,
+		addrs:  addrs,
+		utxos:  make(map[ids.ID]*avax.UTXO),
+		owners: make(map[ids.ID]fx.Owner),
+	}
+}
+
+func (b *buildTxBackend) UTXOs(_ context.Context, sourceChainID ids.ID) ([]*avax.UTXO, error) {
+	var (
+		utxos []*avax.UTXO
+		err   error
+	)
+	if sourceChainID == constants.PlatformChainID {
+		utxos, err = avax.GetAllUTXOs(b.vm.state, b.addrs)
+	} else {
+		utxos, _, _, err = b.vm.atomicUtxosManager.GetAtomicUTXOs(
+			sourceChainID,
+			b.addrs,
+			ids.ShortEmpty,
+			ids.Empty,
+			int(maxPageSize),
+		)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get UTXOs: %w", err)
+	}
+
+	for _, utxo := range utxos {
+		b.utxos[utxo.InputID()] = utxo
+	}
+	return utxos, nil
+}
+
+func (b *buildTxBackend) GetSubnetOwner(_ context.Context, subnetID ids.ID) (fx.Owner, error) {
+	owner, err := b.vm.state.GetSubnetOwner(subnetID)
+	if err != nil {
+		return nil, err
+	}
+	b.owners[subnetID] = owner
+	return owner, nil
+}
+
+// buildTx builds the unsigned tx of type [txType] described by [params].
+func (s *Service) buildTx(
+	txBuilder walletbuilder.Builder,
+	txType string,
+	params json.RawMessage,
+	options []common.Option,
+) (txs.UnsignedTx, error) {
+	if len(params) == 0 {
+		return nil, errMissingTxParams
+	}
+
+	switch txType {
+	case BaseTxType:
+		var p BuildBaseTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		outputs, err := s.parseBuildTxOutputs(p.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewBaseTx(outputs, options...)
+	case CreateSubnetTxType:
+		var p BuildCreateSubnetTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		owner, err := s.parseBuildTxOwner(&p.Owner)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewCreateSubnetTx(owner, options...)
+	case CreateChainTxType:
+		var p BuildCreateChainTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		genesisBytes, err := formatting.Decode(p.Encoding, p.GenesisData)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing genesis data: %w", err)
+		}
+		return txBuilder.NewCreateChainTx(p.SubnetID, genesisBytes, p.VMID, p.FxIDs, p.ChainName, options...)
+	case AddSubnetValidatorTxType:
+		var p BuildAddSubnetValidatorTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		return txBuilder.NewAddSubnetValidatorTx(p.subnetValidator(), options...)
+	case AddPermissionlessDelegatorType:
+		var p BuildAddPermissionlessDelegatorTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		rewardsOwner, err := s.parseBuildTxOwner(&p.RewardsOwner)
+		if err != nil {
+			return nil, err
+		}
+		assetID := p.AssetID
+		if assetID == ids.Empty {
+			assetID = s.vm.ctx.AVAXAssetID
+		}
+		return txBuilder.NewAddPermissionlessDelegatorTx(p.subnetValidator(), assetID, rewardsOwner, options...)
+	case ExportTxType:
+		var p BuildExportTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		chainID, err := s.vm.ctx.BCLookup.Lookup(p.DestinationChain)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing destinationChain %q: %w", p.DestinationChain, err)
+		}
+		outputs, err := s.parseBuildTxOutputs(p.Outputs)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewExportTx(chainID, outputs, options...)
+	case ImportTxType:
+		var p BuildImportTxParams
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, err
+		}
+		chainID, err := s.vm.ctx.BCLookup.Lookup(p.SourceChain)
+		if err != nil {
+			return nil, fmt.Errorf("problem parsing sourceChain %q: %w", p.SourceChain, err)
+		}
+		to, err := s.parseBuildTxOwner(&p.To)
+		if err != nil {
+			return nil, err
+		}
+		return txBuilder.NewImportTx(chainID, to, options...)
+	default:
+		return nil, fmt.Errorf("%w: %q", errUnknownBuildTxType, txType)
+	}
+}
+
+func (p *BuildStakerParams) subnetValidator() *txs.SubnetValidator {
+	return &txs.SubnetValidator{
+		Validator: txs.Validator{
+			NodeID: p.NodeID,
+			Start:  uint64(p.StartTime),
+			End:    uint64(p.EndTime),
+			Wght:   uint64(p.Weight),
+		},
+		Subnet: p.SubnetID,
+	}
+}
+
+func (s *Service) parseBuildTxOwner(owner *platformapi.Owner) (*secp256k1fx.OutputOwners, error) {
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, owner.Addresses)
+	if err != nil {
+		return nil, err
+	}
+	// Output owners must have sorted addresses to be valid.
+	sortedAddrs := addrs.List()
+	utils.Sort(sortedAddrs)
+	return &secp256k1fx.OutputOwners{
+		Locktime:  uint64(owner.Locktime),
+		Threshold: uint32(owner.Threshold),
+		Addrs:     sortedAddrs,
+	}, nil
+}
+
+func (s *Service) parseBuildTxOutputs(apiOutputs []BuildTxOutput) ([]*avax.TransferableOutput, error) {
+	outputs := make([]*avax.TransferableOutput, len(apiOutputs))
+	for i, apiOutput := range apiOutputs {
+		owner, err := s.parseBuildTxOwner(&apiOutput.Owner)
+		if err != nil {
+			return nil, err
+		}
+		outputs[i] = &avax.TransferableOutput{
+			Asset: avax.Asset{ID: apiOutput.AssetID},
+			Out: &secp256k1fx.TransferOutput{
+				Amt:          uint64(apiOutput.Amount),
+				OutputOwners: *owner,
+			},
+		}
+	}
+	return outputs, nil
+}
+
+// getBuildTxSigners returns the signatures needed to issue [utx], in the order
+// that their credentials must be included in the signed tx.
+func (s *Service) getBuildTxSigners(backend *buildTxBackend, utx txs.UnsignedTx) ([]BuildTxSigner, error) {
+	var (
+		ins        []*avax.TransferableInput
+		subnetID   ids.ID
+		subnetAuth *secp256k1fx.Input
+	)
+	switch utx := utx.(type) {
+	case *txs.CreateSubnetTx:
+		ins = utx.Ins
+	case *txs.CreateChainTx:
+		ins = utx.Ins
+		subnetID = utx.SubnetID
+		auth, ok := utx.SubnetAuth.(*secp256k1fx.Input)
+		if !ok {
+			return nil, errUnknownAuthType
+		}
+		subnetAuth = auth
+	case *txs.AddSubnetValidatorTx:
+		ins = utx.Ins
+		subnetID = utx.SubnetValidator.Subnet
+		auth, ok := utx.SubnetAuth.(*secp256k1fx.Input)
+		if !ok {
+			return nil, errUnknownAuthType
+		}
+		subnetAuth = auth
+	case *txs.AddPermissionlessDelegatorTx:
+		ins = utx.Ins
+	case *txs.ExportTx:
+		ins = utx.Ins
+	case *txs.ImportTx:
+		ins = make([]*avax.TransferableInput, 0, len(utx.Ins)+len(utx.ImportedInputs))
+		ins = append(ins, utx.Ins...)
+		ins = append(ins, utx.ImportedInputs...)
+	default:
+		return nil, fmt.Errorf("%w: %T", errUnknownBuildTxType, utx)
+	}
+
+	signers := make([]BuildTxSigner, 0, len(ins)+1)
+	for _, in := range ins {
+		utxoID := in.InputID()
+		utxo, ok := backend.utxos[utxoID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errMissingInputUTXO, utxoID)
+		}
+
+		sigIndices, err := getInputSigIndices(in.In)
+		if err != nil {
+			return nil, err
+		}
+		owners, err := getOutputOwners(utxo.Out)
+		if err != nil {
+			return nil, err
+		}
+		signer, err := s.getBuildTxSigner(sigIndices, owners)
+		if err != nil {
+			return nil, err
+		}
+		signer.UTXOID = in.UTXOID.String()
+		signers = append(signers, signer)
+	}
+
+	if subnetAuth != nil {
+		owner, ok := backend.owners[subnetID].(*secp256k1fx.OutputOwners)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", errUnknownOutputType, backend.owners[subnetID])
+		}
+		signer, err := s.getBuildTxSigner(subnetAuth.SigIndices, owner)
+		if err != nil {
+			return nil, err
+		}
+		signer.SubnetID = &subnetID
+		signers = append(signers, signer)
+	}
+	return signers, nil
+}
+
+func (s *Service) getBuildTxSigner(sigIndices []uint32, owners *secp256k1fx.OutputOwners) (BuildTxSigner, error) {
+	signer := BuildTxSigner{
+		SigIndices: make([]avajson.Uint32, len(sigIndices)),
+		Addresses:  make([]string, len(sigIndices)),
+	}
+	for i, sigIndex := range sigIndices {
+		if int(sigIndex) >= len(owners.Addrs) {
+			return BuildTxSigner{}, fmt.Errorf("signature index %d out of range", sigIndex)
+		}
+		addr, err := s.addrManager.FormatLocalAddress(owners.Addrs[sigIndex])
+		if err != nil {
+			return BuildTxSigner{}, fmt.Errorf("problem formatting address: %w", err)
+		}
+		signer.SigIndices[i] = avajson.Uint32(sigIndex)
+		signer.Addresses[i] = addr
+	}
+	return signer, nil
+}
+
+func getInputSigIndices(in avax.TransferableIn) ([]uint32, error) {
+	if lockedIn, ok := in.(*stakeable.LockIn); ok {
+		in = lockedIn.TransferableIn
+	}
+	transferInput, ok := in.(*secp256k1fx.TransferInput)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnknownInputType, in)
+	}
+	return transferInput.SigIndices, nil
+}
+
+func getOutputOwners(out verify.State) (*secp256k1fx.OutputOwners, error) {
+	if lockedOut, ok := out.(*stakeable.LockOut); ok {
+		out = lockedOut.TransferableOut
+	}
+	transferOutput, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", errUnknownOutputType, out)
+	}
+	return &transferOutput.OutputOwners, nil
+}
+
+// buildTxOptions returns the wallet options for a tx that sends its change to
+// [changeAddr] and can only spend outputs that are unlocked at [timestamp].
+func buildTxOptions(changeAddr ids.ShortID, timestamp time.Time) []common.Option {
+	options := []common.Option{
+		common.WithMinIssuanceTime(uint64(timestamp.Unix())),
+	}
+	if changeAddr != ids.ShortEmpty {
+		options = append(options, common.WithChangeOwner(&secp256k1fx.OutputOwners{
+			Threshold: 1,
+			Addrs:     []ids.ShortID{changeAddr},
+		}))
+	}
+	return options
+}
@@ -5,6 +5,7 @@ package platformvm
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/ava-labs/avalanchego/api"
@@ -17,6 +18,8 @@ import (
 	"github.com/ava-labs/avalanchego/utils/json"
 	"github.com/ava-labs/avalanchego/utils/rpc"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+
+	stdjson "encoding/json"
 )
 
 var _ Client = (*client)(nil)
@@ -57,8 +60,40 @@ type Client interface {
 		startUTXOID ids.ID,
 		options ...rpc.Option,
 	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetUTXOPages returns the page of UTXOs described by each of [queries],
+	// fetched in batch requests
+	GetUTXOPages(ctx context.Context, queries []UTXOsQuery, options ...rpc.Option) ([]UTXOsPage, error)
+	// GetExportedUTXOs returns the byte representation of the UTXOs controlled
+	// by [addrs] that were exported to [destinationChain] and haven't been
+	// imported yet
+	GetExportedUTXOs(
+		ctx context.Context,
+		addrs []ids.ShortID,
+		destinationChain string,
+		limit uint32,
+		startAddress ids.ShortID,
+		startUTXOID ids.ID,
+		options ...rpc.Option,
+	) ([][]byte, ids.ShortID, ids.ID, error)
+	// GetAtomicUTXOStatus returns the status of [utxoID], which was exported
+	// to or from [chain]
+	GetAtomicUTXOStatus(ctx context.Context, utxoID ids.ID, chain string, options ...rpc.Option) (*api.GetAtomicUTXOStatusReply, error)
+	// GetAddressTxs returns the IDs of the transactions that changed [addr]'s
+	// balance of [assetID], starting at [cursor], along with the cursor of the
+	// next page.
+	GetAddressTxs(
+		ctx context.Context,
+		addr ids.ShortID,
+		assetID ids.ID,
+		cursor uint64,
+		pageSize uint64,
+		options ...rpc.Option,
+	) ([]ids.ID, uint64, error)
 	// GetSubnet returns information about the specified subnet
 	GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (GetSubnetClientResponse, error)
+	// GetSubnetDetails returns the owners, blockchains, stakers and staking
+	// parameters of the specified subnet
+	GetSubnetDetails(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetSubnetDetailsReply, error)
 	// GetSubnets returns information about the specified subnets
 	//
 	// Deprecated: Subnets should be fetched from a dedicated indexer.
@@ -72,6 +107,12 @@ type Client interface {
 	GetPendingValidators(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]interface{}, []interface{}, error)
 	// GetCurrentSupply returns an upper bound on the supply of AVAX in the system along with the P-chain height
 	GetCurrentSupply(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (uint64, uint64, error)
+	// GetSupplyAtHeight returns an upper bound on the supply of AVAX in the
+	// system after the block at [height] was accepted
+	GetSupplyAtHeight(ctx context.Context, subnetID ids.ID, height uint64, options ...rpc.Option) (uint64, error)
+	// GetSupplyAtTimestamp returns an upper bound on the supply of AVAX in the
+	// system as of [timestamp] along with the P-chain height it was read at
+	GetSupplyAtTimestamp(ctx context.Context, subnetID ids.ID, timestamp time.Time, options ...rpc.Option) (uint64, uint64, error)
 	// SampleValidators returns the nodeIDs of a sample of [sampleSize] validators from the current validator set for subnet with ID [subnetID]
 	SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error)
 	// GetBlockchainStatus returns the current status of blockchain with ID: [blockchainID]
@@ -84,12 +125,37 @@ type Client interface {
 	//
 	// Deprecated: Blockchains should be fetched from a dedicated indexer.
 	GetBlockchains(ctx context.Context, options ...rpc.Option) ([]APIBlockchain, error)
+	// BuildTx builds an unsigned tx of type [txType], described by [params],
+	// that is funded by [from]. It returns the unsigned tx bytes and the
+	// signatures needed to issue the tx.
+	BuildTx(
+		ctx context.Context,
+		from []ids.ShortID,
+		changeAddr ids.ShortID,
+		txType string,
+		params interface{},
+		options ...rpc.Option,
+	) ([]byte, []BuildTxSigner, error)
 	// IssueTx issues the transaction and returns its txID
 	IssueTx(ctx context.Context, tx []byte, options ...rpc.Option) (ids.ID, error)
+	// SimulateTx verifies the transaction against the preferred state, without
+	// issuing it, and returns the changes that it would make
+	SimulateTx(ctx context.Context, tx []byte, options ...rpc.Option) (*SimulateTxReply, error)
 	// GetTx returns the byte representation of the transaction corresponding to [txID]
 	GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetTxStatus returns the status of the transaction corresponding to [txID]
 	GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error)
+	// GetTxs returns the byte representations of [txIDs], fetched in batch
+	// requests
+	GetTxs(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([][]byte, error)
+	// GetTxStatuses returns the statuses of [txIDs], fetched in batch requests
+	GetTxStatuses(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([]*GetTxStatusResponse, error)
+	// GetMempool returns the decision and staker txs that are waiting in the
+	// mempool
+	GetMempool(ctx context.Context, options ...rpc.Option) (*GetMempoolReply, error)
+	// GetDroppedTxs returns the txs that were most recently dropped from the
+	// mempool
+	GetDroppedTxs(ctx context.Context, options ...rpc.Option) ([]DroppedTx, error)
 	// AwaitTxDecided polls [GetTxStatus] until a status is returned that
 	// implies the tx may be decided.
 	// TODO: Move this function off of the Client interface into a utility
@@ -129,10 +195,46 @@ type Client interface {
 		endTime uint64,
 		options ...rpc.Option,
 	) (uint64, error)
+	// GetDelegationCapacity returns how much more stake each current validator
+	// of [subnetID] can be delegated for the rest of its staking period. If
+	// [nodeIDs] is empty, every current validator is returned.
+	GetDelegationCapacity(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]APIDelegationCapacity, error)
 	// GetRewardUTXOs returns the reward UTXOs for a transaction
 	//
 	// Deprecated: GetRewardUTXOs should be fetched from a dedicated indexer.
 	GetRewardUTXOs(context.Context, *api.GetTxArgs, ...rpc.Option) ([][]byte, error)
+	// GetRewardEstimate returns the reward that staking [amount] on [subnetID]
+	// for [duration] would earn if the staking period started now. If
+	// [delegationFee] is non-nil, the reward is split as if delegating to a
+	// validator charging the provided fee percentage.
+	GetRewardEstimate(
+		ctx context.Context,
+		subnetID ids.ID,
+		amount uint64,
+		duration time.Duration,
+		delegationFee *float32,
+		options ...rpc.Option,
+	) (*GetRewardEstimateReply, error)
+	// GetNodeRewardHistory returns the outcome of rewarding at most [limit]
+	// stakers of [nodeID], starting at [startIndex], along with the start
+	// index of the next page.
+	GetNodeRewardHistory(
+		ctx context.Context,
+		nodeID ids.NodeID,
+		startIndex uint64,
+		limit uint64,
+		options ...rpc.Option,
+	) ([]APIRewardRecord, uint64, error)
+	// GetAddressRewardHistory returns the outcome of rewarding at most [limit]
+	// stakers that paid [addr], starting at [startIndex], along with the start
+	// index of the next page.
+	GetAddressRewardHistory(
+		ctx context.Context,
+		addr ids.ShortID,
+		startIndex uint64,
+		limit uint64,
+		options ...rpc.Option,
+	) ([]APIRewardRecord, uint64, error)
 	// GetTimestamp returns the current chain timestamp
 	GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error)
 	// GetValidatorsAt returns the weights of the validator set of a provided
@@ -143,12 +245,45 @@ type Client interface {
 		height uint64,
 		options ...rpc.Option,
 	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetValidatorsAtTimestamp returns the weights of the validator set of a
+	// provided subnet as of the last block accepted at or before [timestamp].
+	GetValidatorsAtTimestamp(
+		ctx context.Context,
+		subnetID ids.ID,
+		timestamp time.Time,
+		options ...rpc.Option,
+	) (map[ids.NodeID]*validators.GetValidatorOutput, error)
+	// GetHeightByTimestamp returns the height of the last block accepted at
+	// or before [timestamp].
+	GetHeightByTimestamp(ctx context.Context, timestamp time.Time, options ...rpc.Option) (uint64, error)
 	// GetBlock returns the block with the given id.
 	GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error)
 	// GetBlockByHeight returns the block at the given [height].
 	GetBlockByHeight(ctx context.Context, height uint64, options ...rpc.Option) ([]byte, error)
 }
 
+// UTXOsQuery is a page of UTXOs to fetch with GetUTXOPages
+type UTXOsQuery struct {
+	// Addresses that control the UTXOs
+	Addrs []ids.ShortID
+	// Chain the atomic UTXOs were exported from. If empty, the UTXOs on this
+	// chain are fetched.
+	SourceChain string
+	// Maximum number of UTXOs to fetch
+	Limit uint32
+	// Index to start fetching UTXOs from
+	StartAddress ids.ShortID
+	StartUTXOID  ids.ID
+}
+
+// UTXOsPage is a page of UTXOs fetched with GetUTXOPages
+type UTXOsPage struct {
+	UTXOs [][]byte
+	// Index to continue fetching UTXOs from
+	EndAddress ids.ShortID
+	EndUTXOID  ids.ID
+}
+
 // Client implementation for interacting with the P Chain endpoint
 type client struct {
 	requester rpc.EndpointRequester
@@ -228,20 +363,103 @@ func (c *client) GetAtomicUTXOs(
 		return nil, ids.ShortID{}, ids.Empty, err
 	}
 
-	utxos := make([][]byte, len(res.UTXOs))
-	for i, utxo := range res.UTXOs {
-		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+	return parseUTXOsReply(res)
+}
+
+func (c *client) GetUTXOPages(ctx context.Context, queries []UTXOsQuery, options ...rpc.Option) ([]UTXOsPage, error) {
+	if len(queries) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]rpc.Request, len(queries))
+	replies := make([]api.GetUTXOsReply, len(queries))
+	for i, query := range queries {
+		requests[i] = rpc.Request{
+			Method: "platform.getUTXOs",
+			Params: &api.GetUTXOsArgs{
+				Addresses:   ids.ShortIDsToStrings(query.Addrs),
+				SourceChain: query.SourceChain,
+				Limit:       json.Uint32(query.Limit),
+				StartIndex: api.Index{
+					Address: query.StartAddress.String(),
+					UTXO:    query.StartUTXOID.String(),
+				},
+				Encoding: formatting.Hex,
+			},
+			Reply: &replies[i],
+		}
+	}
+	errs, err := rpc.SendBatchRequest(ctx, c.requester, requests, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]UTXOsPage, len(queries))
+	for i, err := range errs {
 		if err != nil {
-			return nil, ids.ShortID{}, ids.Empty, err
+			return nil, fmt.Errorf("couldn't get UTXOs of query %d: %w", i, err)
+		}
+		page := &pages[i]
+		page.UTXOs, page.EndAddress, page.EndUTXOID, err = parseUTXOsReply(&replies[i])
+		if err != nil {
+			return nil, err
 		}
-		utxos[i] = utxoBytes
 	}
-	endAddr, err := address.ParseToID(res.EndIndex.Address)
+	return pages, nil
+}
+
+func (c *client) GetExportedUTXOs(
+	ctx context.Context,
+	addrs []ids.ShortID,
+	destinationChain string,
+	limit uint32,
+	startAddress ids.ShortID,
+	startUTXOID ids.ID,
+	options ...rpc.Option,
+) ([][]byte, ids.ShortID, ids.ID, error) {
+	res := &api.GetUTXOsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getExportedUTXOs", &api.GetExportedUTXOsArgs{
+		Addresses:        ids.ShortIDsToStrings(addrs),
+		DestinationChain: destinationChain,
+		Limit:            json.Uint32(limit),
+		StartIndex: api.Index{
+			Address: startAddress.String(),
+			UTXO:    startUTXOID.String(),
+		},
+		Encoding: formatting.Hex,
+	}, res, options...)
 	if err != nil {
 		return nil, ids.ShortID{}, ids.Empty, err
 	}
-	endUTXOID, err := ids.FromString(res.EndIndex.UTXO)
-	return utxos, endAddr, endUTXOID, err
+
+	return parseUTXOsReply(res)
+}
+
+func (c *client) GetAtomicUTXOStatus(ctx context.Context, utxoID ids.ID, chain string, options ...rpc.Option) (*api.GetAtomicUTXOStatusReply, error) {
+	res := &api.GetAtomicUTXOStatusReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAtomicUTXOStatus", &api.GetAtomicUTXOStatusArgs{
+		UTXOID: utxoID,
+		Chain:  chain,
+	}, res, options...)
+	return res, err
+}
+
+func (c *client) GetAddressTxs(
+	ctx context.Context,
+	addr ids.ShortID,
+	assetID ids.ID,
+	cursor uint64,
+	pageSize uint64,
+	options ...rpc.Option,
+) ([]ids.ID, uint64, error) {
+	res := &GetAddressTxsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getAddressTxs", &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: addr.String()},
+		Cursor:      json.Uint64(cursor),
+		PageSize:    json.Uint64(pageSize),
+		AssetID:     assetID.String(),
+	}, res, options...)
+	return res.TxIDs, uint64(res.Cursor), err
 }
 
 // GetSubnetClientResponse is the response from calling GetSubnet on the client
@@ -278,6 +496,14 @@ func (c *client) GetSubnet(ctx context.Context, subnetID ids.ID, options ...rpc.
 	}, nil
 }
 
+func (c *client) GetSubnetDetails(ctx context.Context, subnetID ids.ID, options ...rpc.Option) (*GetSubnetDetailsReply, error) {
+	res := &GetSubnetDetailsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getSubnetDetails", &GetSubnetDetailsArgs{
+		SubnetID: subnetID,
+	}, res, options...)
+	return res, err
+}
+
 // ClientSubnet is a representation of a subnet used in client methods
 type ClientSubnet struct {
 	// ID of the subnet
@@ -360,6 +586,26 @@ func (c *client) GetCurrentSupply(ctx context.Context, subnetID ids.ID, options
 	return uint64(res.Supply), uint64(res.Height), err
 }
 
+func (c *client) GetSupplyAtHeight(ctx context.Context, subnetID ids.ID, height uint64, options ...rpc.Option) (uint64, error) {
+	res := &GetCurrentSupplyReply{}
+	jsonHeight := json.Uint64(height)
+	err := c.requester.SendRequest(ctx, "platform.getCurrentSupply", &GetCurrentSupplyArgs{
+		SubnetID: subnetID,
+		Height:   &jsonHeight,
+	}, res, options...)
+	return uint64(res.Supply), err
+}
+
+func (c *client) GetSupplyAtTimestamp(ctx context.Context, subnetID ids.ID, timestamp time.Time, options ...rpc.Option) (uint64, uint64, error) {
+	res := &GetCurrentSupplyReply{}
+	unixTime := json.Uint64(timestamp.Unix())
+	err := c.requester.SendRequest(ctx, "platform.getCurrentSupply", &GetCurrentSupplyArgs{
+		SubnetID:  subnetID,
+		Timestamp: &unixTime,
+	}, res, options...)
+	return uint64(res.Supply), uint64(res.Height), err
+}
+
 func (c *client) SampleValidators(ctx context.Context, subnetID ids.ID, sampleSize uint16, options ...rpc.Option) ([]ids.NodeID, error) {
 	res := &SampleValidatorsReply{}
 	err := c.requester.SendRequest(ctx, "platform.sampleValidators", &SampleValidatorsArgs{
@@ -399,6 +645,37 @@ func (c *client) GetBlockchains(ctx context.Context, options ...rpc.Option) ([]A
 	return res.Blockchains, err
 }
 
+func (c *client) BuildTx(
+	ctx context.Context,
+	from []ids.ShortID,
+	changeAddr ids.ShortID,
+	txType string,
+	params interface{},
+	options ...rpc.Option,
+) ([]byte, []BuildTxSigner, error) {
+	paramsBytes, err := stdjson.Marshal(params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	args := &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: ids.ShortIDsToStrings(from)},
+		TxType:        txType,
+		Params:        paramsBytes,
+		Encoding:      formatting.Hex,
+	}
+	if changeAddr != ids.ShortEmpty {
+		args.ChangeAddr = changeAddr.String()
+	}
+
+	res := &BuildTxReply{}
+	if err := c.requester.SendRequest(ctx, "platform.buildTx", args, res, options...); err != nil {
+		return nil, nil, err
+	}
+	txBytes, err := formatting.Decode(res.Encoding, res.UnsignedTx)
+	return txBytes, res.Signers, err
+}
+
 func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (ids.ID, error) {
 	txStr, err := formatting.Encode(formatting.Hex, txBytes)
 	if err != nil {
@@ -413,6 +690,20 @@ func (c *client) IssueTx(ctx context.Context, txBytes []byte, options ...rpc.Opt
 	return res.TxID, err
 }
 
+func (c *client) SimulateTx(ctx context.Context, txBytes []byte, options ...rpc.Option) (*SimulateTxReply, error) {
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SimulateTxReply{}
+	err = c.requester.SendRequest(ctx, "platform.simulateTx", &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, res, options...)
+	return res, err
+}
+
 func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedTx{}
 	err := c.requester.SendRequest(ctx, "platform.getTx", &api.GetTxArgs{
@@ -425,6 +716,41 @@ func (c *client) GetTx(ctx context.Context, txID ids.ID, options ...rpc.Option)
 	return formatting.Decode(res.Encoding, res.Tx)
 }
 
+func (c *client) GetTxs(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([][]byte, error) {
+	if len(txIDs) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]rpc.Request, len(txIDs))
+	replies := make([]api.FormattedTx, len(txIDs))
+	for i, txID := range txIDs {
+		requests[i] = rpc.Request{
+			Method: "platform.getTx",
+			Params: &api.GetTxArgs{
+				TxID:     txID,
+				Encoding: formatting.Hex,
+			},
+			Reply: &replies[i],
+		}
+	}
+	errs, err := rpc.SendBatchRequest(ctx, c.requester, requests, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([][]byte, len(txIDs))
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get tx %s: %w", txIDs[i], err)
+		}
+		txs[i], err = formatting.Decode(replies[i].Encoding, replies[i].Tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return txs, nil
+}
+
 func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Option) (*GetTxStatusResponse, error) {
 	res := &GetTxStatusResponse{}
 	err := c.requester.SendRequest(
@@ -439,6 +765,48 @@ func (c *client) GetTxStatus(ctx context.Context, txID ids.ID, options ...rpc.Op
 	return res, err
 }
 
+func (c *client) GetTxStatuses(ctx context.Context, txIDs []ids.ID, options ...rpc.Option) ([]*GetTxStatusResponse, error) {
+	if len(txIDs) == 0 {
+		return nil, nil
+	}
+
+	requests := make([]rpc.Request, len(txIDs))
+	replies := make([]*GetTxStatusResponse, len(txIDs))
+	for i, txID := range txIDs {
+		replies[i] = &GetTxStatusResponse{}
+		requests[i] = rpc.Request{
+			Method: "platform.getTxStatus",
+			Params: &GetTxStatusArgs{
+				TxID: txID,
+			},
+			Reply: replies[i],
+		}
+	}
+	errs, err := rpc.SendBatchRequest(ctx, c.requester, requests, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get status of tx %s: %w", txIDs[i], err)
+		}
+	}
+	return replies, nil
+}
+
+func (c *client) GetMempool(ctx context.Context, options ...rpc.Option) (*GetMempoolReply, error) {
+	res := &GetMempoolReply{}
+	err := c.requester.SendRequest(ctx, "platform.getMempool", struct{}{}, res, options...)
+	return res, err
+}
+
+func (c *client) GetDroppedTxs(ctx context.Context, options ...rpc.Option) ([]DroppedTx, error) {
+	res := &GetDroppedTxsReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDroppedTxs", struct{}{}, res, options...)
+	return res.Txs, err
+}
+
 func (c *client) AwaitTxDecided(ctx context.Context, txID ids.ID, freq time.Duration, options ...rpc.Option) (*GetTxStatusResponse, error) {
 	ticker := time.NewTicker(freq)
 	defer ticker.Stop()
@@ -527,6 +895,15 @@ func (c *client) GetMaxStakeAmount(ctx context.Context, subnetID ids.ID, nodeID
 	return uint64(res.Amount), err
 }
 
+func (c *client) GetDelegationCapacity(ctx context.Context, subnetID ids.ID, nodeIDs []ids.NodeID, options ...rpc.Option) ([]APIDelegationCapacity, error) {
+	res := &GetDelegationCapacityReply{}
+	err := c.requester.SendRequest(ctx, "platform.getDelegationCapacity", &GetDelegationCapacityArgs{
+		SubnetID: subnetID,
+		NodeIDs:  nodeIDs,
+	}, res, options...)
+	return res.Validators, err
+}
+
 func (c *client) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, options ...rpc.Option) ([][]byte, error) {
 	res := &GetRewardUTXOsReply{}
 	err := c.requester.SendRequest(ctx, "platform.getRewardUTXOs", args, res, options...)
@@ -544,6 +921,60 @@ func (c *client) GetRewardUTXOs(ctx context.Context, args *api.GetTxArgs, option
 	return utxos, err
 }
 
+func (c *client) GetRewardEstimate(
+	ctx context.Context,
+	subnetID ids.ID,
+	amount uint64,
+	duration time.Duration,
+	delegationFee *float32,
+	options ...rpc.Option,
+) (*GetRewardEstimateReply, error) {
+	args := &GetRewardEstimateArgs{
+		SubnetID: subnetID,
+		Amount:   json.Uint64(amount),
+		Duration: json.Uint64(duration / time.Second),
+	}
+	if delegationFee != nil {
+		fee := json.Float32(*delegationFee)
+		args.DelegationFee = &fee
+	}
+	res := &GetRewardEstimateReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRewardEstimate", args, res, options...)
+	return res, err
+}
+
+func (c *client) GetNodeRewardHistory(
+	ctx context.Context,
+	nodeID ids.NodeID,
+	startIndex uint64,
+	limit uint64,
+	options ...rpc.Option,
+) ([]APIRewardRecord, uint64, error) {
+	res := &GetRewardHistoryReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRewardHistory", &GetRewardHistoryArgs{
+		NodeID:     &nodeID,
+		StartIndex: json.Uint64(startIndex),
+		Limit:      json.Uint64(limit),
+	}, res, options...)
+	return res.Rewards, uint64(res.EndIndex), err
+}
+
+func (c *client) GetAddressRewardHistory(
+	ctx context.Context,
+	addr ids.ShortID,
+	startIndex uint64,
+	limit uint64,
+	options ...rpc.Option,
+) ([]APIRewardRecord, uint64, error) {
+	res := &GetRewardHistoryReply{}
+	err := c.requester.SendRequest(ctx, "platform.getRewardHistory", &GetRewardHistoryArgs{
+		Address:    addr.String(),
+		StartIndex: json.Uint64(startIndex),
+		Limit:      json.Uint64(limit),
+	}, res, options...)
+	return res.Rewards, uint64(res.EndIndex), err
+}
+
 func (c *client) GetTimestamp(ctx context.Context, options ...rpc.Option) (time.Time, error) {
 	res := &GetTimestampReply{}
 	err := c.requester.SendRequest(ctx, "platform.getTimestamp", struct{}{}, res, options...)
@@ -557,13 +988,37 @@ func (c *client) GetValidatorsAt(
 	options ...rpc.Option,
 ) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
 	res := &GetValidatorsAtReply{}
+	jsonHeight := json.Uint64(height)
 	err := c.requester.SendRequest(ctx, "platform.getValidatorsAt", &GetValidatorsAtArgs{
 		SubnetID: subnetID,
-		Height:   json.Uint64(height),
+		Height:   &jsonHeight,
+	}, res, options...)
+	return res.Validators, err
+}
+
+func (c *client) GetValidatorsAtTimestamp(
+	ctx context.Context,
+	subnetID ids.ID,
+	timestamp time.Time,
+	options ...rpc.Option,
+) (map[ids.NodeID]*validators.GetValidatorOutput, error) {
+	res := &GetValidatorsAtReply{}
+	unixTime := json.Uint64(timestamp.Unix())
+	err := c.requester.SendRequest(ctx, "platform.getValidatorsAt", &GetValidatorsAtArgs{
+		SubnetID:  subnetID,
+		Timestamp: &unixTime,
 	}, res, options...)
 	return res.Validators, err
 }
 
+func (c *client) GetHeightByTimestamp(ctx context.Context, timestamp time.Time, options ...rpc.Option) (uint64, error) {
+	res := &GetHeightByTimestampReply{}
+	err := c.requester.SendRequest(ctx, "platform.getHeightByTimestamp", &GetHeightByTimestampArgs{
+		Timestamp: json.Uint64(timestamp.Unix()),
+	}, res, options...)
+	return uint64(res.Height), err
+}
+
 func (c *client) GetBlock(ctx context.Context, blockID ids.ID, options ...rpc.Option) ([]byte, error) {
 	res := &api.FormattedBlock{}
 	if err := c.requester.SendRequest(ctx, "platform.getBlock", &api.GetBlockArgs{
@@ -586,3 +1041,22 @@ func (c *client) GetBlockByHeight(ctx context.Context, height uint64, options ..
 	}
 	return formatting.Decode(res.Encoding, res.Block)
 }
+
+// parseUTXOsReply returns the UTXOs in [res] and the index to continue fetching
+// UTXOs from.
+func parseUTXOsReply(res *api.GetUTXOsReply) ([][]byte, ids.ShortID, ids.ID, error) {
+	utxos := make([][]byte, len(res.UTXOs))
+	for i, utxo := range res.UTXOs {
+		utxoBytes, err := formatting.Decode(res.Encoding, utxo)
+		if err != nil {
+			return nil, ids.ShortID{}, ids.Empty, err
+		}
+		utxos[i] = utxoBytes
+	}
+	endAddr, err := address.ParseToID(res.EndIndex.Address)
+	if err != nil {
+		return nil, ids.ShortID{}, ids.Empty, err
+	}
+	endUTXOID, err := ids.FromString(res.EndIndex.UTXO)
+	return utxos, endAddr, endUTXOID, err
+}
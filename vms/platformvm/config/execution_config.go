@@ -23,6 +23,8 @@ var DefaultExecutionConfig = ExecutionConfig{
 	FxOwnerCacheSize:             4 * units.MiB,
 	ChecksumsEnabled:             false,
 	MempoolPruneFrequency:        30 * time.Minute,
+	IndexTransactions:            false,
+	IndexAllowIncomplete:         false,
 }
 
 // ExecutionConfig provides execution parameters of PlatformVM
@@ -38,6 +40,8 @@ type ExecutionConfig struct {
 	FxOwnerCacheSize             int            `json:"fx-owner-cache-size"`
 	ChecksumsEnabled             bool           `json:"checksums-enabled"`
 	MempoolPruneFrequency        time.Duration  `json:"mempool-prune-frequency"`
+	IndexTransactions            bool           `json:"index-transactions"`
+	IndexAllowIncomplete         bool           `json:"index-allow-incomplete"`
 }
 
 // GetExecutionConfig returns an ExecutionConfig
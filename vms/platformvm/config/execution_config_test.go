@@ -69,7 +69,9 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			"block-id-cache-size": 8,
 			"fx-owner-cache-size": 9,
 			"checksums-enabled": true,
-			"mempool-prune-frequency": 60000000000
+			"mempool-prune-frequency": 60000000000,
+			"index-transactions": true,
+			"index-allow-incomplete": true
 		}`)
 		ec, err := GetExecutionConfig(b)
 		require.NoError(err)
@@ -102,6 +104,8 @@ func TestExecutionConfigUnmarshal(t *testing.T) {
 			FxOwnerCacheSize:             9,
 			ChecksumsEnabled:             true,
 			MempoolPruneFrequency:        time.Minute,
+			IndexTransactions:            true,
+			IndexAllowIncomplete:         true,
 		}
 		require.Equal(expected, ec)
 	})
@@ -0,0 +1,160 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+// Types of the events published on the /events endpoint
+const (
+	BlockEventType         = "block"
+	StakerAddedEventType   = "stakerAdded"
+	StakerRemovedEventType = "stakerRemoved"
+	SubnetCreatedEventType = "subnetCreated"
+)
+
+var (
+	_ pubsub.Filterer = (*eventFilterer)(nil)
+
+	errUnexpectedStakerTxType = errors.New("unexpected staker tx type")
+)
+
+// APIBlockEvent is published when a block is accepted
+type APIBlockEvent struct {
+	Type     string         `json:"type"`
+	BlockID  ids.ID         `json:"blockID"`
+	ParentID ids.ID         `json:"parentID"`
+	Height   avajson.Uint64 `json:"height"`
+	TxIDs    []ids.ID       `json:"txIDs"`
+}
+
+// APIStakerEvent is published when a staker is added or removed
+type APIStakerEvent struct {
+	Type    string `json:"type"`
+	BlockID ids.ID `json:"blockID"`
+	// ID of the tx that added or removed the staker
+	TxID     ids.ID     `json:"txID"`
+	SubnetID ids.ID     `json:"subnetID"`
+	NodeID   ids.NodeID `json:"nodeID"`
+	// Weight and EndTime are omitted when a subnet validator is removed
+	// before its end time.
+	Weight  avajson.Uint64 `json:"weight,omitempty"`
+	EndTime avajson.Uint64 `json:"endTime,omitempty"`
+}
+
+// APISubnetEvent is published when a subnet is created
+type APISubnetEvent struct {
+	Type     string `json:"type"`
+	BlockID  ids.ID `json:"blockID"`
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// eventFilterer publishes [event] to the connections that filter on any of
+// [keys]. Keys are subnet IDs and node IDs.
+type eventFilterer struct {
+	keys  [][]byte
+	event interface{}
+}
+
+func newEventFilterer(event interface{}, keys ...[]byte) *eventFilterer {
+	return &eventFilterer{
+		keys:  keys,
+		event: event,
+	}
+}
+
+func (f *eventFilterer) Filter(filters []pubsub.Filter) ([]bool, interface{}) {
+	resp := make([]bool, len(filters))
+	for i, c := range filters {
+		for _, key := range f.keys {
+			if c.Check(key) {
+				resp[i] = true
+				break
+			}
+		}
+	}
+	return resp, f.event
+}
+
+// newTxEventFilterer returns the event caused by [tx], if any, along with the
+// keys it is published under. If [aborted] is true, [tx] is the tx of a
+// proposal block whose abort option was accepted.
+func (vm *VM) newTxEventFilterer(blkID ids.ID, tx *txs.Tx, aborted bool) (pubsub.Filterer, [][]byte, error) {
+	txID := tx.ID()
+	switch utx := tx.Unsigned.(type) {
+	case *txs.CreateSubnetTx:
+		keys := [][]byte{txID[:]}
+		return newEventFilterer(
+			&APISubnetEvent{
+				Type:     SubnetCreatedEventType,
+				BlockID:  blkID,
+				SubnetID: txID,
+			},
+			keys...,
+		), keys, nil
+	case *txs.RemoveSubnetValidatorTx:
+		keys := [][]byte{utx.Subnet[:], utx.NodeID.Bytes()}
+		return newEventFilterer(
+			&APIStakerEvent{
+				Type:     StakerRemovedEventType,
+				BlockID:  blkID,
+				TxID:     txID,
+				SubnetID: utx.Subnet,
+				NodeID:   utx.NodeID,
+			},
+			keys...,
+		), keys, nil
+	case *txs.RewardValidatorTx:
+		// The staker is removed whether the tx is committed or aborted. Only
+		// its rewards depend on the decision.
+		stakerTx, _, err := vm.state.GetTx(utx.TxID)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get staker tx %s: %w", utx.TxID, err)
+		}
+		staker, ok := stakerTx.Unsigned.(txs.Staker)
+		if !ok {
+			return nil, nil, fmt.Errorf("%w: %T", errUnexpectedStakerTxType, stakerTx.Unsigned)
+		}
+		return newStakerEventFilterer(StakerRemovedEventType, blkID, utx.TxID, staker)
+	case txs.Staker:
+		// A staker proposed by an aborted tx was never added.
+		if aborted {
+			return nil, nil, nil
+		}
+		return newStakerEventFilterer(StakerAddedEventType, blkID, txID, utx)
+	default:
+		return nil, nil, nil
+	}
+}
+
+func newStakerEventFilterer(
+	eventType string,
+	blkID ids.ID,
+	txID ids.ID,
+	staker txs.Staker,
+) (pubsub.Filterer, [][]byte, error) {
+	subnetID := staker.SubnetID()
+	nodeID := staker.NodeID()
+	keys := [][]byte{subnetID[:], nodeID.Bytes()}
+	return newEventFilterer(
+		&APIStakerEvent{
+			Type:     eventType,
+			BlockID:  blkID,
+			TxID:     txID,
+			SubnetID: subnetID,
+			NodeID:   nodeID,
+			Weight:   avajson.Uint64(staker.Weight()),
+			EndTime:  avajson.Uint64(staker.EndTime().Unix()),
+		},
+		keys...,
+	), keys, nil
+}
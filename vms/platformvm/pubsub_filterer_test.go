@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+type mockFilter struct {
+	key []byte
+}
+
+func (f *mockFilter) Check(key []byte) bool {
+	return bytes.Equal(key, f.key)
+}
+
+func TestEventFilterer(t *testing.T) {
+	require := require.New(t)
+
+	subnetID := ids.GenerateTestID()
+	nodeID := ids.GenerateTestNodeID()
+	blkID := ids.GenerateTestID()
+	tx := &txs.Tx{Unsigned: &txs.AddSubnetValidatorTx{
+		SubnetValidator: txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: nodeID,
+				End:    1,
+				Wght:   2,
+			},
+			Subnet: subnetID,
+		},
+		SubnetAuth: &secp256k1fx.Input{},
+	}}
+	tx.SetBytes(nil, []byte{1})
+
+	vm := &VM{}
+	filterer, keys, err := vm.newTxEventFilterer(blkID, tx, false)
+	require.NoError(err)
+	require.Equal([][]byte{subnetID[:], nodeID.Bytes()}, keys)
+
+	otherID := ids.GenerateTestID()
+	matches, event := filterer.Filter([]pubsub.Filter{
+		&mockFilter{key: subnetID[:]},
+		&mockFilter{key: nodeID.Bytes()},
+		&mockFilter{key: otherID[:]},
+	})
+	require.Equal([]bool{true, true, false}, matches)
+	require.Equal(
+		&APIStakerEvent{
+			Type:     StakerAddedEventType,
+			BlockID:  blkID,
+			TxID:     tx.ID(),
+			SubnetID: subnetID,
+			NodeID:   nodeID,
+			Weight:   2,
+			EndTime:  1,
+		},
+		event,
+	)
+
+	// Stakers proposed by aborted txs aren't added, so they aren't published.
+	filterer, keys, err = vm.newTxEventFilterer(blkID, tx, true)
+	require.NoError(err)
+	require.Nil(filterer)
+	require.Empty(keys)
+
+	// Txs that don't cause an event aren't published.
+	tx = &txs.Tx{Unsigned: &txs.BaseTx{}}
+	tx.SetBytes(nil, []byte{2})
+	filterer, keys, err = vm.newTxEventFilterer(blkID, tx, false)
+	require.NoError(err)
+	require.Nil(filterer)
+	require.Empty(keys)
+}
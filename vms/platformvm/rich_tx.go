@@ -0,0 +1,386 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/verify"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/genesis"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+)
+
+const avaxSymbol = "AVAX"
+
+var _ txs.Visitor = (*txFunds)(nil)
+
+// RichTx is a tx annotated with the UTXOs that it spends, the funds that it
+// produces, the fee that it burns and, for staker txs, the staker that it adds
+type RichTx struct {
+	Tx      *txs.Tx           `json:"tx"`
+	Inputs  []avax.RichInput  `json:"inputs"`
+	Outputs []avax.RichOutput `json:"outputs"`
+	Burned  []avax.RichAmount `json:"burned"`
+	Staker  *RichStaker       `json:"staker,omitempty"`
+}
+
+// RichStaker is the staker that a staker tx adds
+type RichStaker struct {
+	NodeID   ids.NodeID `json:"nodeID"`
+	SubnetID ids.ID     `json:"subnetID"`
+	// StartTime is only set for stakers that were scheduled to start at a
+	// specific time
+	StartTime *avajson.Uint64 `json:"startTime,omitempty"`
+	EndTime   avajson.Uint64  `json:"endTime"`
+	Weight    avajson.Uint64  `json:"weight"`
+	PublicKey *string         `json:"publicKey,omitempty"`
+	// The reward owners are only set for the stakers that are rewarded. For
+	// validators, RewardOwner receives the validation rewards.
+	RewardOwner           *avax.RichOwner `json:"rewardOwner,omitempty"`
+	DelegationRewardOwner *avax.RichOwner `json:"delegationRewardOwner,omitempty"`
+	DelegationShares      *avajson.Uint32 `json:"delegationShares,omitempty"`
+}
+
+// txFunds collects the inputs and outputs of a tx that move funds
+type txFunds struct {
+	ins              []*avax.TransferableInput
+	importedIns      []*avax.TransferableInput
+	sourceChain      ids.ID
+	outs             []*avax.TransferableOutput
+	exportedOuts     []*avax.TransferableOutput
+	destinationChain ids.ID
+	stake            []*avax.TransferableOutput
+}
+
+func (f *txFunds) AddValidatorTx(tx *txs.AddValidatorTx) error {
+	f.stake = tx.StakeOuts
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) AddSubnetValidatorTx(tx *txs.AddSubnetValidatorTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) AddDelegatorTx(tx *txs.AddDelegatorTx) error {
+	f.stake = tx.StakeOuts
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) CreateChainTx(tx *txs.CreateChainTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) CreateSubnetTx(tx *txs.CreateSubnetTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) ImportTx(tx *txs.ImportTx) error {
+	f.importedIns = tx.ImportedInputs
+	f.sourceChain = tx.SourceChain
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) ExportTx(tx *txs.ExportTx) error {
+	f.exportedOuts = tx.ExportedOutputs
+	f.destinationChain = tx.DestinationChain
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (*txFunds) AdvanceTimeTx(*txs.AdvanceTimeTx) error {
+	return nil
+}
+
+func (*txFunds) RewardValidatorTx(*txs.RewardValidatorTx) error {
+	return nil
+}
+
+func (f *txFunds) RemoveSubnetValidatorTx(tx *txs.RemoveSubnetValidatorTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) TransformSubnetTx(tx *txs.TransformSubnetTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) AddPermissionlessValidatorTx(tx *txs.AddPermissionlessValidatorTx) error {
+	f.stake = tx.StakeOuts
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) AddPermissionlessDelegatorTx(tx *txs.AddPermissionlessDelegatorTx) error {
+	f.stake = tx.StakeOuts
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) TransferSubnetOwnershipTx(tx *txs.TransferSubnetOwnershipTx) error {
+	return f.BaseTx(&tx.BaseTx)
+}
+
+func (f *txFunds) BaseTx(tx *txs.BaseTx) error {
+	f.ins = tx.Ins
+	f.outs = tx.Outs
+	return nil
+}
+
+// getRichTx annotates [tx], which must have been initialized for JSON
+// marshalling. Assumes the context lock is held.
+func (s *Service) getRichTx(tx *txs.Tx) (*RichTx, error) {
+	funds := &txFunds{}
+	if err := tx.Unsigned.Visit(funds); err != nil {
+		return nil, err
+	}
+
+	var (
+		chainID = s.vm.ctx.ChainID
+		richTx  = &RichTx{
+			Tx:      tx,
+			Inputs:  make([]avax.RichInput, 0, len(funds.ins)+len(funds.importedIns)),
+			Outputs: make([]avax.RichOutput, 0, len(funds.outs)+len(funds.exportedOuts)+len(funds.stake)),
+		}
+	)
+	for _, in := range funds.ins {
+		richIn, err := s.getRichInput(in, chainID)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Inputs = append(richTx.Inputs, richIn)
+	}
+	for _, in := range funds.importedIns {
+		richIn, err := s.getRichInput(in, funds.sourceChain)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Inputs = append(richTx.Inputs, richIn)
+	}
+	for _, out := range funds.outs {
+		richOut, err := s.getRichOutput(out, chainID)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Outputs = append(richTx.Outputs, richOut)
+	}
+	for _, out := range funds.exportedOuts {
+		richOut, err := s.getRichOutput(out, funds.destinationChain)
+		if err != nil {
+			return nil, err
+		}
+		richTx.Outputs = append(richTx.Outputs, richOut)
+	}
+	for _, out := range funds.stake {
+		richOut, err := s.getRichOutput(out, chainID)
+		if err != nil {
+			return nil, err
+		}
+		richOut.Stake = true
+		richTx.Outputs = append(richTx.Outputs, richOut)
+	}
+
+	var err error
+	richTx.Burned, err = avax.GetBurned(richTx.Inputs, richTx.Outputs)
+	if err != nil {
+		return nil, err
+	}
+
+	if staker, ok := tx.Unsigned.(txs.Staker); ok {
+		richTx.Staker, err = s.getRichStaker(staker)
+	}
+	return richTx, err
+}
+
+// getRichInput annotates [in], which spends a UTXO on [sourceChain]. Only the
+// UTXOs of this chain are resolved.
+func (s *Service) getRichInput(in *avax.TransferableInput, sourceChain ids.ID) (avax.RichInput, error) {
+	assetID := in.AssetID()
+	richIn := avax.RichInput{
+		UTXOID:      in.UTXOID.String(),
+		SourceChain: sourceChain,
+		AssetID:     assetID,
+		AssetSymbol: s.getAssetSymbol(assetID),
+		Amount:      avajson.Uint64(in.In.Amount()),
+	}
+	if sourceChain != s.vm.ctx.ChainID {
+		return richIn, nil
+	}
+
+	// Spent UTXOs are removed from the state, so they are read from the tx
+	// that produced them.
+	producingTx, _, err := s.vm.state.GetTx(in.TxID)
+	if errors.Is(err, database.ErrNotFound) {
+		// UTXOs that were created in genesis don't have a producing tx, so
+		// they are read from the genesis.
+		utxo, ok, err := s.getGenesisUTXO(in.InputID())
+		if err != nil || !ok {
+			return richIn, err
+		}
+		richIn.Owner, err = s.getRichOwner(utxo.Out, sourceChain)
+		return richIn, err
+	}
+	if err != nil {
+		return avax.RichInput{}, fmt.Errorf("couldn't get tx %s: %w", in.TxID, err)
+	}
+	out, ok := getProducedOutput(producingTx, in.OutputIndex)
+	if !ok {
+		return richIn, nil
+	}
+	richIn.Owner, err = s.getRichOwner(out, sourceChain)
+	return richIn, err
+}
+
+// getGenesisUTXO returns the UTXO with [inputID] that was created in genesis,
+// if there is one. Assumes the context lock is held.
+func (s *Service) getGenesisUTXO(inputID ids.ID) (*avax.UTXO, bool, error) {
+	if s.genesisUTXOs == nil {
+		gen, err := genesis.Parse(s.vm.genesisBytes)
+		if err != nil {
+			return nil, false, fmt.Errorf("couldn't parse genesis: %w", err)
+		}
+		s.genesisUTXOs = make(map[ids.ID]*avax.UTXO, len(gen.UTXOs))
+		for _, utxo := range gen.UTXOs {
+			s.genesisUTXOs[utxo.InputID()] = &utxo.UTXO
+		}
+	}
+	utxo, ok := s.genesisUTXOs[inputID]
+	return utxo, ok, nil
+}
+
+// getProducedOutput returns the output of the UTXO at [outputIndex] of [tx].
+// The UTXOs that are created when a staker leaves the validator set are
+// resolved if they return its stake or pay its own reward.
+func getProducedOutput(tx *txs.Tx, outputIndex uint32) (verify.State, bool) {
+	index := int(outputIndex)
+	outs := tx.Unsigned.Outputs()
+	if index < len(outs) {
+		return outs[index].Out, true
+	}
+
+	staker, ok := tx.Unsigned.(txs.PermissionlessStaker)
+	if !ok {
+		return nil, false
+	}
+	index -= len(outs)
+	stake := staker.Stake()
+	if index < len(stake) {
+		return stake[index].Out, true
+	}
+	if index > len(stake) {
+		return nil, false
+	}
+
+	var owner fx.Owner
+	switch staker := staker.(type) {
+	case txs.ValidatorTx:
+		owner = staker.ValidationRewardsOwner()
+	case txs.DelegatorTx:
+		owner = staker.RewardsOwner()
+	default:
+		return nil, false
+	}
+	owners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, false
+	}
+	return &secp256k1fx.TransferOutput{OutputOwners: *owners}, true
+}
+
+// getRichOutput annotates [out], which is on [destinationChain]
+func (s *Service) getRichOutput(out *avax.TransferableOutput, destinationChain ids.ID) (avax.RichOutput, error) {
+	assetID := out.AssetID()
+	richOut := avax.RichOutput{
+		DestinationChain: destinationChain,
+		AssetID:          assetID,
+		AssetSymbol:      s.getAssetSymbol(assetID),
+		Amount:           avajson.Uint64(out.Out.Amount()),
+	}
+	var err error
+	richOut.Owner, err = s.getRichOwner(out.Out, destinationChain)
+	return richOut, err
+}
+
+// getRichOwner returns the owner of [out], or nil if [out] isn't owned by a
+// set of addresses
+func (s *Service) getRichOwner(out verify.State, chainID ids.ID) (*avax.RichOwner, error) {
+	if lockedOut, ok := out.(*stakeable.LockOut); ok {
+		out = lockedOut.TransferableOut
+	}
+	transferOut, ok := out.(*secp256k1fx.TransferOutput)
+	if !ok {
+		return nil, nil
+	}
+	return avax.NewRichOwner(s.addrManager, chainID, &transferOut.OutputOwners)
+}
+
+// getRichStaker returns the staker that [staker] adds
+func (s *Service) getRichStaker(staker txs.Staker) (*RichStaker, error) {
+	richStaker := &RichStaker{
+		NodeID:   staker.NodeID(),
+		SubnetID: staker.SubnetID(),
+		EndTime:  avajson.Uint64(staker.EndTime().Unix()),
+		Weight:   avajson.Uint64(staker.Weight()),
+	}
+	if scheduledStaker, ok := staker.(txs.ScheduledStaker); ok {
+		startTime := avajson.Uint64(scheduledStaker.StartTime().Unix())
+		richStaker.StartTime = &startTime
+	}
+
+	publicKey, hasPublicKey, err := staker.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	if hasPublicKey {
+		pk, err := formatting.Encode(formatting.HexNC, bls.PublicKeyToBytes(publicKey))
+		if err != nil {
+			return nil, err
+		}
+		richStaker.PublicKey = &pk
+	}
+
+	chainID := s.vm.ctx.ChainID
+	switch staker := staker.(type) {
+	case txs.ValidatorTx:
+		richStaker.RewardOwner, err = s.getRichRewardOwner(staker.ValidationRewardsOwner(), chainID)
+		if err != nil {
+			return nil, err
+		}
+		richStaker.DelegationRewardOwner, err = s.getRichRewardOwner(staker.DelegationRewardsOwner(), chainID)
+		if err != nil {
+			return nil, err
+		}
+		shares := avajson.Uint32(staker.Shares())
+		richStaker.DelegationShares = &shares
+	case txs.DelegatorTx:
+		richStaker.RewardOwner, err = s.getRichRewardOwner(staker.RewardsOwner(), chainID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return richStaker, nil
+}
+
+func (s *Service) getRichRewardOwner(owner fx.Owner, chainID ids.ID) (*avax.RichOwner, error) {
+	owners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, nil
+	}
+	return avax.NewRichOwner(s.addrManager, chainID, owners)
+}
+
+// getAssetSymbol returns the symbol of [assetID], or the empty string if the
+// asset isn't AVAX
+func (s *Service) getAssetSymbol(assetID ids.ID) string {
+	if assetID == s.vm.ctx.AVAXAssetID {
+		return avaxSymbol
+	}
+	return ""
+}
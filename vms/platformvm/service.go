@@ -43,6 +43,7 @@ import (
 	avajson "github.com/ava-labs/avalanchego/utils/json"
 	safemath "github.com/ava-labs/avalanchego/utils/math"
 	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+	walletbuilder "github.com/ava-labs/avalanchego/wallet/chain/p/builder"
 )
 
 const (
@@ -52,6 +53,9 @@ const (
 	// Max number of addresses that can be passed in as argument to GetStake
 	maxGetStakeAddrs = 256
 
+	// Max number of tx IDs that can be returned by GetAddressTxs
+	maxPageSize uint64 = 1024
+
 	// Note: Staker attributes cache should be large enough so that no evictions
 	// happen when the API loops through all stakers.
 	stakerAttributesCacheSize = 100_000
@@ -61,9 +65,15 @@ var (
 	errMissingDecisionBlock       = errors.New("should have a decision block within the past two blocks")
 	errPrimaryNetworkIsNotASubnet = errors.New("the primary network isn't a subnet")
 	errNoAddresses                = errors.New("no addresses provided")
+	errRichTxNotJSON              = errors.New("rich txs are only supported with the json encoding")
 	errMissingBlockchainID        = errors.New("argument 'blockchainID' not given")
 	errStartAfterEndTime          = errors.New("start time must be before end time")
 	errStartTimeInThePast         = errors.New("start time in the past")
+	errHeightAndTimestamp         = errors.New("only one of 'height' and 'timestamp' may be provided")
+	errNodeIDAndAddress           = errors.New("exactly one of 'nodeID' and 'address' must be provided")
+	errInvalidDelegationFee       = errors.New("delegation fee must be between 0 and 100")
+	errZeroStakeAmount            = errors.New("stake amount must be non-zero")
+	errZeroStakeDuration          = errors.New("stake duration must be non-zero")
 )
 
 // Service defines the API calls that can be made to the platform chain
@@ -71,6 +81,10 @@ type Service struct {
 	vm                    *VM
 	addrManager           avax.AddressManager
 	stakerAttributesCache *cache.LRU[ids.ID, *stakerAttributes]
+
+	// Maps the input IDs of the UTXOs that were created in genesis to the
+	// UTXOs. Populated when first needed, while holding the context lock.
+	genesisUTXOs map[ids.ID]*avax.UTXO
 }
 
 // All attributes are optional and may not be filled for each stakerTx.
@@ -160,7 +174,8 @@ type GetBalanceResponse struct {
 	UTXOIDs             []*avax.UTXOID            `json:"utxoIDs"`
 }
 
-// GetBalance gets the balance of an address
+// GetBalance gets the balance of an address. The balance is calculated from
+// the current UTXO set, so historical balances aren't supported.
 func (s *Service) GetBalance(_ *http.Request, args *GetBalanceRequest, response *GetBalanceResponse) error {
 	s.vm.ctx.Log.Debug("deprecated API called",
 		zap.String("service", "platform"),
@@ -408,6 +423,33 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 		}
 	}
 
+	// Like tx verification, spendability uses the local clock rather than
+	// the chain timestamp.
+	now := s.vm.clock.Unix()
+	response.Spendability = make([]*api.Spendability, len(utxos))
+	for i, utxo := range utxos {
+		out := utxo.Out
+		var stakeableLocktime uint64
+		if lockedOut, ok := out.(*stakeable.LockOut); ok {
+			stakeableLocktime = lockedOut.Locktime
+			out = lockedOut.TransferableOut
+		}
+		transferOut, ok := out.(*secp256k1fx.TransferOutput)
+		if !ok {
+			continue
+		}
+
+		spendability, err := avax.GetSpendability(s.addrManager, &transferOut.OutputOwners, addrSet, now)
+		if err != nil {
+			return err
+		}
+		// Stakeable locked UTXOs can only be staked until their stakeable
+		// locktime.
+		spendability.Spendable = spendability.Spendable && stakeableLocktime <= now
+		spendability.StakeableLocktime = avajson.Uint64(stakeableLocktime)
+		response.Spendability[i] = spendability
+	}
+
 	endAddress, err := s.addrManager.FormatLocalAddress(endAddr)
 	if err != nil {
 		return fmt.Errorf("problem formatting address: %w", err)
@@ -420,6 +462,188 @@ func (s *Service) GetUTXOs(_ *http.Request, args *api.GetUTXOsArgs, response *ap
 	return nil
 }
 
+// GetExportedUTXOs returns the UTXOs that this chain exported to the
+// destination chain that haven't been imported yet
+func (s *Service) GetExportedUTXOs(_ *http.Request, args *api.GetExportedUTXOsArgs, response *api.GetUTXOsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getExportedUTXOs"),
+	)
+
+	if len(args.Addresses) == 0 {
+		return errNoAddresses
+	}
+	if len(args.Addresses) > maxGetUTXOsAddrs {
+		return fmt.Errorf("number of addresses given, %d, exceeds maximum, %d", len(args.Addresses), maxGetUTXOsAddrs)
+	}
+
+	destinationChain, err := s.vm.ctx.BCLookup.Lookup(args.DestinationChain)
+	if err != nil {
+		return fmt.Errorf("problem parsing destination chainID %q: %w", args.DestinationChain, err)
+	}
+
+	addrSet, err := avax.ParseServiceAddresses(s.addrManager, args.Addresses)
+	if err != nil {
+		return err
+	}
+
+	startAddr := ids.ShortEmpty
+	startUTXO := ids.Empty
+	if args.StartIndex.Address != "" || args.StartIndex.UTXO != "" {
+		startAddr, err = avax.ParseServiceAddress(s.addrManager, args.StartIndex.Address)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index address %q: %w", args.StartIndex.Address, err)
+		}
+		startUTXO, err = ids.FromString(args.StartIndex.UTXO)
+		if err != nil {
+			return fmt.Errorf("couldn't parse start index utxo: %w", err)
+		}
+	}
+
+	limit := int(args.Limit)
+	if limit <= 0 || builder.MaxPageSize < limit {
+		limit = builder.MaxPageSize
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	utxos, endAddr, endUTXOID, err := s.vm.atomicUtxosManager.GetExportedUTXOs(
+		destinationChain,
+		addrSet,
+		startAddr,
+		startUTXO,
+		limit,
+	)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXOs: %w", err)
+	}
+
+	response.UTXOs = make([]string, len(utxos))
+	for i, utxo := range utxos {
+		bytes, err := txs.Codec.Marshal(txs.CodecVersion, utxo)
+		if err != nil {
+			return fmt.Errorf("couldn't serialize UTXO %q: %w", utxo.InputID(), err)
+		}
+		response.UTXOs[i], err = formatting.Encode(args.Encoding, bytes)
+		if err != nil {
+			return fmt.Errorf("couldn't encode UTXO %s as %s: %w", utxo.InputID(), args.Encoding, err)
+		}
+	}
+
+	endAddress, err := s.addrManager.FormatLocalAddress(endAddr)
+	if err != nil {
+		return fmt.Errorf("problem formatting address: %w", err)
+	}
+
+	response.EndIndex.Address = endAddress
+	response.EndIndex.UTXO = endUTXOID.String()
+	response.NumFetched = avajson.Uint64(len(utxos))
+	response.Encoding = args.Encoding
+	return nil
+}
+
+// GetAtomicUTXOStatus returns whether a UTXO that was exported to or from the
+// given chain is pending import or was imported by this chain
+func (s *Service) GetAtomicUTXOStatus(_ *http.Request, args *api.GetAtomicUTXOStatusArgs, response *api.GetAtomicUTXOStatusReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAtomicUTXOStatus"),
+		zap.Stringer("utxoID", args.UTXOID),
+		logging.UserString("chain", args.Chain),
+	)
+
+	chainID, err := s.vm.ctx.BCLookup.Lookup(args.Chain)
+	if err != nil {
+		return fmt.Errorf("problem parsing chainID %q: %w", args.Chain, err)
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	status, err := s.vm.atomicUtxosManager.GetAtomicUTXOStatus(chainID, args.UTXOID)
+	if err != nil {
+		return fmt.Errorf("problem retrieving UTXO status: %w", err)
+	}
+	if status == avax.AtomicUTXOUnknown {
+		importTxID, err := s.vm.state.GetImportTxID(args.UTXOID)
+		if err == nil {
+			status = avax.AtomicUTXOImported
+			response.ImportTxID = &importTxID
+		} else if !errors.Is(err, database.ErrNotFound) {
+			return fmt.Errorf("problem retrieving import tx: %w", err)
+		}
+	}
+	response.Status = status.String()
+	response.ImportsIndexed = s.vm.state.ImportedUTXOsIndexed()
+	return nil
+}
+
+// GetAddressTxsArgs are the arguments for calling GetAddressTxs
+type GetAddressTxsArgs struct {
+	api.JSONAddress
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+	// PageSize num of items per page
+	PageSize avajson.Uint64 `json:"pageSize"`
+	// AssetID defaulted to AVAX if omitted or left blank
+	AssetID string `json:"assetID"`
+}
+
+// GetAddressTxsReply is the response from GetAddressTxs
+type GetAddressTxsReply struct {
+	TxIDs []ids.ID `json:"txIDs"`
+	// Cursor used as a page index / offset
+	Cursor avajson.Uint64 `json:"cursor"`
+}
+
+// GetAddressTxs returns the IDs of the accepted transactions that changed the
+// balance of the provided address. The node must have been run with
+// address transaction indexing enabled for the result to be meaningful.
+func (s *Service) GetAddressTxs(_ *http.Request, args *GetAddressTxsArgs, reply *GetAddressTxsReply) error {
+	cursor := uint64(args.Cursor)
+	pageSize := uint64(args.PageSize)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getAddressTxs"),
+		logging.UserString("address", args.Address),
+		logging.UserString("assetID", args.AssetID),
+		zap.Uint64("cursor", cursor),
+		zap.Uint64("pageSize", pageSize),
+	)
+
+	if pageSize > maxPageSize {
+		return fmt.Errorf("pageSize > maximum allowed (%d)", maxPageSize)
+	} else if pageSize == 0 {
+		pageSize = maxPageSize
+	}
+
+	address, err := avax.ParseServiceAddress(s.addrManager, args.Address)
+	if err != nil {
+		return fmt.Errorf("couldn't parse argument 'address' to address: %w", err)
+	}
+
+	assetID := s.vm.ctx.AVAXAssetID
+	if args.AssetID != "" {
+		assetID, err = ids.FromString(args.AssetID)
+		if err != nil {
+			return fmt.Errorf("specified `assetID` is invalid: %w", err)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.TxIDs, err = s.vm.addressTxsIndexer.Read(address[:], assetID, cursor, pageSize)
+	if err != nil {
+		return err
+	}
+
+	// To get the next set of tx IDs, the user should provide this cursor.
+	reply.Cursor = avajson.Uint64(cursor + uint64(len(reply.TxIDs)))
+	return nil
+}
+
 // GetSubnetArgs are the arguments to GetSubnet
 type GetSubnetArgs struct {
 	// ID of the subnet to retrieve information about
@@ -487,6 +711,253 @@ func (s *Service) GetSubnet(_ *http.Request, args *GetSubnetArgs, response *GetS
 	return nil
 }
 
+// GetSubnetDetailsArgs are the arguments to GetSubnetDetails
+type GetSubnetDetailsArgs struct {
+	// ID of the subnet to retrieve information about
+	SubnetID ids.ID `json:"subnetID"`
+}
+
+// APISubnetOwnerChange is an owner that was set on a subnet
+type APISubnetOwnerChange struct {
+	// ID of the CreateSubnetTx or TransferSubnetOwnershipTx that set the owner
+	TxID ids.ID `json:"txID"`
+	// Height that the owner was set at. Omitted for the owner set by the
+	// CreateSubnetTx, as the height of that tx isn't indexed.
+	Height *avajson.Uint64    `json:"height,omitempty"`
+	Owner  *platformapi.Owner `json:"owner"`
+}
+
+// APIStakerWeight summarizes a set of stakers of a subnet
+type APIStakerWeight struct {
+	NumValidators   avajson.Uint64 `json:"numValidators"`
+	NumDelegators   avajson.Uint64 `json:"numDelegators"`
+	ValidatorWeight avajson.Uint64 `json:"validatorWeight"`
+	DelegatorWeight avajson.Uint64 `json:"delegatorWeight"`
+}
+
+// APIElasticSubnetParameters are the staking parameters of a subnet that was
+// transformed into an elastic subnet
+type APIElasticSubnetParameters struct {
+	AssetID                  ids.ID         `json:"assetID"`
+	InitialSupply            avajson.Uint64 `json:"initialSupply"`
+	MaximumSupply            avajson.Uint64 `json:"maximumSupply"`
+	MinConsumptionRate       avajson.Uint64 `json:"minConsumptionRate"`
+	MaxConsumptionRate       avajson.Uint64 `json:"maxConsumptionRate"`
+	MinValidatorStake        avajson.Uint64 `json:"minValidatorStake"`
+	MaxValidatorStake        avajson.Uint64 `json:"maxValidatorStake"`
+	MinStakeDuration         avajson.Uint32 `json:"minStakeDuration"`
+	MaxStakeDuration         avajson.Uint32 `json:"maxStakeDuration"`
+	MinDelegationFee         avajson.Uint32 `json:"minDelegationFee"`
+	MinDelegatorStake        avajson.Uint64 `json:"minDelegatorStake"`
+	MaxValidatorWeightFactor avajson.Uint32 `json:"maxValidatorWeightFactor"`
+	UptimeRequirement        avajson.Uint32 `json:"uptimeRequirement"`
+}
+
+// GetSubnetDetailsReply is the response from calling GetSubnetDetails
+type GetSubnetDetailsReply struct {
+	// Height of the last accepted block that the details were read at
+	Height avajson.Uint64 `json:"height"`
+	// Current owner of the subnet
+	Owner *platformapi.Owner `json:"owner"`
+	// Owners of the subnet, in the order they were set
+	OwnerHistory []APISubnetOwnerChange `json:"ownerHistory"`
+	// OwnerHistoryIndexed is false while the node is indexing the ownership
+	// transfers that it accepted before it started indexing them, after
+	// upgrading to a version that serves the owner history. Until then,
+	// OwnerHistory may be missing transfers.
+	OwnerHistoryIndexed bool `json:"ownerHistoryIndexed"`
+	// Blockchains validated by the subnet
+	Blockchains []APIBlockchain `json:"blockchains"`
+	Current     APIStakerWeight `json:"current"`
+	Pending     APIStakerWeight `json:"pending"`
+	// whether it is permissioned or not
+	IsPermissioned bool `json:"isPermissioned"`
+	// subnet transformation tx ID for a permissionless subnet
+	SubnetTransformationTxID ids.ID `json:"subnetTransformationTxID"`
+	// Staking parameters of a permissionless subnet
+	ElasticParameters *APIElasticSubnetParameters `json:"elasticParameters,omitempty"`
+}
+
+// GetSubnetDetails returns the owners, blockchains, stakers and staking
+// parameters of a subnet, all read from the last accepted state.
+func (s *Service) GetSubnetDetails(r *http.Request, args *GetSubnetDetailsArgs, reply *GetSubnetDetailsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getSubnetDetails"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	if args.SubnetID == constants.PrimaryNetworkID {
+		return errPrimaryNetworkIsNotASubnet
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	height, err := s.vm.GetCurrentHeight(r.Context())
+	if err != nil {
+		return fmt.Errorf("couldn't get current height: %w", err)
+	}
+	reply.Height = avajson.Uint64(height)
+
+	subnetOwner, err := s.vm.state.GetSubnetOwner(args.SubnetID)
+	if err != nil {
+		return err
+	}
+	reply.Owner, err = s.getAPISubnetOwner(subnetOwner)
+	if err != nil {
+		return err
+	}
+
+	createSubnetTx, _, err := s.vm.state.GetTx(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get subnet %s: %w", args.SubnetID, err)
+	}
+	createSubnet, ok := createSubnetTx.Unsigned.(*txs.CreateSubnetTx)
+	if !ok {
+		return fmt.Errorf("expected *txs.CreateSubnetTx but got %T", createSubnetTx.Unsigned)
+	}
+	initialOwner, err := s.getAPISubnetOwner(createSubnet.Owner)
+	if err != nil {
+		return err
+	}
+	reply.OwnerHistory = []APISubnetOwnerChange{{
+		TxID:  args.SubnetID,
+		Owner: initialOwner,
+	}}
+
+	reply.OwnerHistoryIndexed = s.vm.state.SubnetOwnerTransfersIndexed()
+	transfers, err := s.vm.state.GetSubnetOwnerTransfers(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get subnet owner transfers: %w", err)
+	}
+	for _, transfer := range transfers {
+		transferTx, ok := transfer.Tx.Unsigned.(*txs.TransferSubnetOwnershipTx)
+		if !ok {
+			return fmt.Errorf("expected *txs.TransferSubnetOwnershipTx but got %T", transfer.Tx.Unsigned)
+		}
+		owner, err := s.getAPISubnetOwner(transferTx.Owner)
+		if err != nil {
+			return err
+		}
+		height := avajson.Uint64(transfer.Height)
+		reply.OwnerHistory = append(reply.OwnerHistory, APISubnetOwnerChange{
+			TxID:   transfer.Tx.ID(),
+			Height: &height,
+			Owner:  owner,
+		})
+	}
+
+	chains, err := s.vm.state.GetChains(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("couldn't get blockchains of subnet %s: %w", args.SubnetID, err)
+	}
+	reply.Blockchains = make([]APIBlockchain, 0, len(chains))
+	for _, chainTx := range chains {
+		chain, ok := chainTx.Unsigned.(*txs.CreateChainTx)
+		if !ok {
+			return fmt.Errorf("expected *txs.CreateChainTx but got %T", chainTx.Unsigned)
+		}
+		reply.Blockchains = append(reply.Blockchains, APIBlockchain{
+			ID:       chainTx.ID(),
+			Name:     chain.ChainName,
+			SubnetID: args.SubnetID,
+			VMID:     chain.VMID,
+		})
+	}
+
+	currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+	if err != nil {
+		return err
+	}
+	reply.Current, err = getAPIStakerWeight(currentStakerIterator, args.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	pendingStakerIterator, err := s.vm.state.GetPendingStakerIterator()
+	if err != nil {
+		return err
+	}
+	reply.Pending, err = getAPIStakerWeight(pendingStakerIterator, args.SubnetID)
+	if err != nil {
+		return err
+	}
+
+	switch subnetTransformationTx, err := s.vm.state.GetSubnetTransformation(args.SubnetID); err {
+	case nil:
+		transform, ok := subnetTransformationTx.Unsigned.(*txs.TransformSubnetTx)
+		if !ok {
+			return fmt.Errorf("expected *txs.TransformSubnetTx but got %T", subnetTransformationTx.Unsigned)
+		}
+		reply.IsPermissioned = false
+		reply.SubnetTransformationTxID = subnetTransformationTx.ID()
+		reply.ElasticParameters = &APIElasticSubnetParameters{
+			AssetID:                  transform.AssetID,
+			InitialSupply:            avajson.Uint64(transform.InitialSupply),
+			MaximumSupply:            avajson.Uint64(transform.MaximumSupply),
+			MinConsumptionRate:       avajson.Uint64(transform.MinConsumptionRate),
+			MaxConsumptionRate:       avajson.Uint64(transform.MaxConsumptionRate),
+			MinValidatorStake:        avajson.Uint64(transform.MinValidatorStake),
+			MaxValidatorStake:        avajson.Uint64(transform.MaxValidatorStake),
+			MinStakeDuration:         avajson.Uint32(transform.MinStakeDuration),
+			MaxStakeDuration:         avajson.Uint32(transform.MaxStakeDuration),
+			MinDelegationFee:         avajson.Uint32(transform.MinDelegationFee),
+			MinDelegatorStake:        avajson.Uint64(transform.MinDelegatorStake),
+			MaxValidatorWeightFactor: avajson.Uint32(transform.MaxValidatorWeightFactor),
+			UptimeRequirement:        avajson.Uint32(transform.UptimeRequirement),
+		}
+	case database.ErrNotFound:
+		reply.IsPermissioned = true
+		reply.SubnetTransformationTxID = ids.Empty
+	default:
+		return err
+	}
+	return nil
+}
+
+func (s *Service) getAPISubnetOwner(owner fx.Owner) (*platformapi.Owner, error) {
+	outputOwners, ok := owner.(*secp256k1fx.OutputOwners)
+	if !ok {
+		return nil, fmt.Errorf("expected *secp256k1fx.OutputOwners but got %T", owner)
+	}
+	return s.getAPIOwner(outputOwners)
+}
+
+// getAPIStakerWeight sums the stakers of [subnetID] returned by [it]. [it] is
+// released before returning.
+func getAPIStakerWeight(it state.StakerIterator, subnetID ids.ID) (APIStakerWeight, error) {
+	defer it.Release()
+
+	var (
+		weight APIStakerWeight
+		err    error
+	)
+	for it.Next() {
+		staker := it.Value()
+		if staker.SubnetID != subnetID {
+			continue
+		}
+
+		if staker.Priority.IsValidator() {
+			weight.NumValidators++
+			weight.ValidatorWeight, err = addAPIWeight(weight.ValidatorWeight, staker.Weight)
+		} else {
+			weight.NumDelegators++
+			weight.DelegatorWeight, err = addAPIWeight(weight.DelegatorWeight, staker.Weight)
+		}
+		if err != nil {
+			return APIStakerWeight{}, err
+		}
+	}
+	return weight, nil
+}
+
+func addAPIWeight(total avajson.Uint64, weight uint64) (avajson.Uint64, error) {
+	newTotal, err := safemath.Add64(uint64(total), weight)
+	return avajson.Uint64(newTotal), err
+}
+
 // APISubnet is a representation of a subnet used in API calls
 type APISubnet struct {
 	// ID of the subnet
@@ -1060,6 +1531,10 @@ func (s *Service) GetPendingValidators(_ *http.Request, args *GetPendingValidato
 // GetCurrentSupplyArgs are the arguments for calling GetCurrentSupply
 type GetCurrentSupplyArgs struct {
 	SubnetID ids.ID `json:"subnetID"`
+	// At most one of Height and Timestamp may be provided. If neither is
+	// provided, the supply as of the last accepted block is returned.
+	Height    *avajson.Uint64 `json:"height,omitempty"`
+	Timestamp *avajson.Uint64 `json:"timestamp,omitempty"`
 }
 
 // GetCurrentSupplyReply are the results from calling GetCurrentSupply
@@ -1078,6 +1553,21 @@ func (s *Service) GetCurrentSupply(r *http.Request, args *GetCurrentSupplyArgs,
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
+	if args.Height != nil || args.Timestamp != nil {
+		resolvedHeight, err := s.resolveHeight(args.Height, args.Timestamp)
+		if err != nil {
+			return err
+		}
+
+		supply, err := s.vm.state.GetSupplyAtHeight(args.SubnetID, resolvedHeight)
+		if err != nil {
+			return fmt.Errorf("fetching supply at height %d failed: %w", resolvedHeight, err)
+		}
+		reply.Supply = avajson.Uint64(supply)
+		reply.Height = avajson.Uint64(resolvedHeight)
+		return nil
+	}
+
 	supply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
 	if err != nil {
 		return fmt.Errorf("fetching current supply failed: %w", err)
@@ -1400,6 +1890,81 @@ func (s *Service) GetBlockchains(_ *http.Request, _ *struct{}, response *GetBloc
 	return nil
 }
 
+// BuildTxArgs are the arguments for calling BuildTx
+type BuildTxArgs struct {
+	// Addresses whose UTXOs may be spent by the tx
+	api.JSONFromAddrs
+	// Address to send change to. Defaults to one of the [From] addresses.
+	api.JSONChangeAddr
+	// Type of the tx to build, e.g. "baseTx" or "createSubnet"
+	TxType string `json:"txType"`
+	// Parameters of the tx, in the format expected by [TxType]
+	Params   json.RawMessage     `json:"params"`
+	Encoding formatting.Encoding `json:"encoding"`
+}
+
+// BuildTxReply is the response from calling BuildTx
+type BuildTxReply struct {
+	// Unsigned tx bytes. Each signer must sign the hash of these bytes.
+	UnsignedTx string              `json:"unsignedTx"`
+	Encoding   formatting.Encoding `json:"encoding"`
+	// Signatures needed to issue the tx, in the order that their credentials
+	// must be included in the signed tx
+	Signers []BuildTxSigner `json:"signers"`
+}
+
+// BuildTx builds an unsigned tx funded by the UTXOs of the provided addresses,
+// selecting UTXOs the same way as the wallet.
+func (s *Service) BuildTx(_ *http.Request, args *BuildTxArgs, reply *BuildTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "buildTx"),
+		zap.String("txType", args.TxType),
+	)
+
+	if len(args.From) == 0 {
+		return errNoAddresses
+	}
+	addrs, err := avax.ParseServiceAddresses(s.addrManager, args.From)
+	if err != nil {
+		return err
+	}
+
+	var changeAddr ids.ShortID
+	if args.ChangeAddr != "" {
+		changeAddr, err = avax.ParseServiceAddress(s.addrManager, args.ChangeAddr)
+		if err != nil {
+			return fmt.Errorf("couldn't parse changeAddr: %w", err)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	backend := newBuildTxBackend(s.vm, addrs)
+	options := buildTxOptions(changeAddr, s.vm.state.GetTimestamp())
+	utx, err := s.buildTx(walletbuilder.New(addrs, backend), args.TxType, args.Params, options)
+	if err != nil {
+		return fmt.Errorf("couldn't build tx: %w", err)
+	}
+
+	reply.Signers, err = s.getBuildTxSigners(backend, utx)
+	if err != nil {
+		return fmt.Errorf("couldn't get signers: %w", err)
+	}
+
+	unsignedBytes, err := txs.Codec.Marshal(txs.CodecVersion, &utx)
+	if err != nil {
+		return fmt.Errorf("couldn't marshal tx: %w", err)
+	}
+	reply.UnsignedTx, err = formatting.Encode(args.Encoding, unsignedBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't encode tx as %s: %w", args.Encoding, err)
+	}
+	reply.Encoding = args.Encoding
+	return nil
+}
+
 func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.JSONTxID) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
@@ -1423,12 +1988,98 @@ func (s *Service) IssueTx(_ *http.Request, args *api.FormattedTx, response *api.
 	return nil
 }
 
+// SimulateTxReply is the response from calling SimulateTx
+type SimulateTxReply struct {
+	TxID ids.ID `json:"txID"`
+	// Error is the reason that the tx failed verification against the
+	// preferred state. If empty, the tx can be executed on top of it.
+	//
+	// Before Durango, a staker that starts too far after the chain time is
+	// reported here even though it may be issued, because its changes can't
+	// be computed until the chain time is advanced.
+	Error string `json:"error,omitempty"`
+	// UTXOs that the tx consumes
+	Consumed []APISimulatedUTXO `json:"consumed"`
+	// UTXOs that the tx produces
+	Produced []APISimulatedUTXO `json:"produced"`
+	// UTXOs that the tx locks as stake. They are produced once the staker is
+	// removed.
+	Staked []APISimulatedUTXO `json:"staked"`
+	// Amount of each asset that is consumed but neither produced nor staked
+	Burned map[ids.ID]avajson.Uint64 `json:"burned"`
+	// Stakers that the tx adds
+	Stakers []APISimulatedStaker `json:"stakers"`
+}
+
+// SimulateTx verifies a tx against the currently preferred state, without
+// issuing it, and returns the changes that it would make. This is the same
+// base that the X-chain simulates txs against.
+func (s *Service) SimulateTx(_ *http.Request, args *api.FormattedTx, reply *SimulateTxReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "simulateTx"),
+	)
+
+	txBytes, err := formatting.Decode(args.Encoding, args.Tx)
+	if err != nil {
+		return fmt.Errorf("problem decoding transaction: %w", err)
+	}
+	tx, err := txs.Parse(txs.Codec, txBytes)
+	if err != nil {
+		return fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	reply.TxID = tx.ID()
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	preferredState, err := state.NewDiff(s.vm.manager.Preferred(), s.vm.manager)
+	if err != nil {
+		return fmt.Errorf("couldn't get preferred state: %w", err)
+	}
+	onAcceptState, err := s.vm.manager.SimulateTx(tx)
+	if err != nil {
+		reply.Error = err.Error()
+		return nil
+	}
+
+	reply.Consumed, err = getSimulatedConsumed(preferredState, tx)
+	if err != nil {
+		return fmt.Errorf("couldn't get consumed UTXOs: %w", err)
+	}
+	utxos := tx.UTXOs()
+	reply.Produced = make([]APISimulatedUTXO, len(utxos))
+	for i, utxo := range utxos {
+		reply.Produced[i], err = newAPISimulatedUTXO(&utxo.UTXOID, utxo.AssetID(), utxo.Out)
+		if err != nil {
+			return fmt.Errorf("couldn't get produced UTXOs: %w", err)
+		}
+	}
+	reply.Staked, err = getSimulatedStaked(tx)
+	if err != nil {
+		return fmt.Errorf("couldn't get staked UTXOs: %w", err)
+	}
+	reply.Burned, err = getBurned(reply.Consumed, reply.Produced, reply.Staked)
+	if err != nil {
+		return fmt.Errorf("couldn't calculate burned amounts: %w", err)
+	}
+	reply.Stakers, err = getSimulatedStakers(onAcceptState, reply.TxID)
+	if err != nil {
+		return fmt.Errorf("couldn't get stakers: %w", err)
+	}
+	return nil
+}
+
 func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetTxReply) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getTx"),
 	)
 
+	if args.Rich && args.Encoding != formatting.JSON {
+		return errRichTxNotJSON
+	}
+
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
@@ -1441,7 +2092,14 @@ func (s *Service) GetTx(_ *http.Request, args *api.GetTxArgs, response *api.GetT
 	var result any
 	if args.Encoding == formatting.JSON {
 		tx.Unsigned.InitCtx(s.vm.ctx)
-		result = tx
+		if args.Rich {
+			result, err = s.getRichTx(tx)
+			if err != nil {
+				return fmt.Errorf("couldn't annotate tx: %w", err)
+			}
+		} else {
+			result = tx
+		}
 	} else {
 		result, err = formatting.Encode(args.Encoding, tx.Bytes())
 		if err != nil {
@@ -1522,6 +2180,90 @@ func (s *Service) GetTxStatus(_ *http.Request, args *GetTxStatusArgs, response *
 	return nil
 }
 
+// MempoolTx describes a tx that is waiting in the mempool
+type MempoolTx struct {
+	TxID ids.ID         `json:"txID"`
+	Size avajson.Uint64 `json:"size"`
+	// NodeID is only set for staker txs
+	NodeID *ids.NodeID `json:"nodeID,omitempty"`
+	// StartTime is only set for staker txs that specify a start time
+	StartTime *avajson.Uint64 `json:"startTime,omitempty"`
+}
+
+// GetMempoolReply is the response from calling GetMempool. The txs are
+// ordered in the order that they will be considered for inclusion in a block.
+type GetMempoolReply struct {
+	DecisionTxs []MempoolTx `json:"decisionTxs"`
+	StakerTxs   []MempoolTx `json:"stakerTxs"`
+}
+
+// GetMempool returns the txs that are waiting in the mempool
+func (s *Service) GetMempool(_ *http.Request, _ *struct{}, reply *GetMempoolReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getMempool"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	reply.DecisionTxs = []MempoolTx{}
+	reply.StakerTxs = []MempoolTx{}
+	s.vm.Builder.Iterate(func(tx *txs.Tx) bool {
+		mempoolTx := MempoolTx{
+			TxID: tx.ID(),
+			Size: avajson.Uint64(len(tx.Bytes())),
+		}
+
+		staker, ok := tx.Unsigned.(txs.Staker)
+		if !ok {
+			reply.DecisionTxs = append(reply.DecisionTxs, mempoolTx)
+			return true
+		}
+
+		nodeID := staker.NodeID()
+		mempoolTx.NodeID = &nodeID
+		if scheduledStaker, ok := staker.(txs.ScheduledStaker); ok {
+			startTime := avajson.Uint64(scheduledStaker.StartTime().Unix())
+			mempoolTx.StartTime = &startTime
+		}
+		reply.StakerTxs = append(reply.StakerTxs, mempoolTx)
+		return true
+	})
+	return nil
+}
+
+// DroppedTx is a tx that was recently dropped from the mempool
+type DroppedTx struct {
+	TxID   ids.ID `json:"txID"`
+	Reason string `json:"reason"`
+}
+
+// GetDroppedTxsReply is the response from calling GetDroppedTxs
+type GetDroppedTxsReply struct {
+	// Txs are sorted from the least to the most recently dropped
+	Txs []DroppedTx `json:"txs"`
+}
+
+// GetDroppedTxs returns the txs that were most recently dropped from the
+// mempool, along with the reason that they were dropped
+func (s *Service) GetDroppedTxs(_ *http.Request, _ *struct{}, reply *GetDroppedTxsReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getDroppedTxs"),
+	)
+
+	droppedTxs := s.vm.Builder.DroppedTxs()
+	reply.Txs = make([]DroppedTx, len(droppedTxs))
+	for i, droppedTx := range droppedTxs {
+		reply.Txs[i] = DroppedTx{
+			TxID:   droppedTx.TxID,
+			Reason: droppedTx.Reason.Error(),
+		}
+	}
+	return nil
+}
+
 type GetStakeArgs struct {
 	api.JSONAddresses
 	ValidatorsOnly bool                `json:"validatorsOnly"`
@@ -1768,6 +2510,127 @@ func (s *Service) GetMaxStakeAmount(_ *http.Request, args *GetMaxStakeAmountArgs
 	return err
 }
 
+// GetDelegationCapacityArgs are the arguments for calling
+// GetDelegationCapacity
+type GetDelegationCapacityArgs struct {
+	// SubnetID defaults to the primary network
+	SubnetID ids.ID `json:"subnetID"`
+	// If empty, every current validator of the subnet is returned. Otherwise,
+	// the validators are returned in the order they were requested, ignoring
+	// duplicates.
+	NodeIDs []ids.NodeID `json:"nodeIDs"`
+}
+
+// APIDelegationCapacity is how much more stake a current validator can be
+// delegated for the rest of its staking period
+type APIDelegationCapacity struct {
+	TxID      ids.ID         `json:"txID"`
+	NodeID    ids.NodeID     `json:"nodeID"`
+	StartTime avajson.Uint64 `json:"startTime"`
+	EndTime   avajson.Uint64 `json:"endTime"`
+	// Weight of the validator, excluding its delegators
+	Weight        avajson.Uint64  `json:"weight"`
+	DelegationFee avajson.Float32 `json:"delegationFee"`
+	// MaxWeight is the maximum total weight, including delegations, that the
+	// validator may have
+	MaxWeight avajson.Uint64 `json:"maxWeight"`
+	// MaxDelegatedWeight is the maximum weight delegated to the validator at
+	// any point during the rest of its staking period
+	MaxDelegatedWeight avajson.Uint64 `json:"maxDelegatedWeight"`
+	// RemainingCapacity is the maximum weight that can be delegated to the
+	// validator for the rest of its staking period
+	RemainingCapacity avajson.Uint64 `json:"remainingCapacity"`
+}
+
+// GetDelegationCapacityReply is the response from calling
+// GetDelegationCapacity
+type GetDelegationCapacityReply struct {
+	Validators []APIDelegationCapacity `json:"validators"`
+}
+
+// GetDelegationCapacity returns how much more stake each current validator of
+// the subnet can be delegated, from the current chain time until the end of
+// its staking period. Validators that can't be delegated to are omitted.
+func (s *Service) GetDelegationCapacity(_ *http.Request, args *GetDelegationCapacityArgs, reply *GetDelegationCapacityReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getDelegationCapacity"),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var validators []*state.Staker
+	if len(args.NodeIDs) == 0 {
+		currentStakerIterator, err := s.vm.state.GetCurrentStakerIterator()
+		if err != nil {
+			return err
+		}
+		for currentStakerIterator.Next() {
+			staker := currentStakerIterator.Value()
+			if staker.SubnetID == args.SubnetID && staker.Priority.IsCurrentValidator() {
+				validators = append(validators, staker)
+			}
+		}
+		currentStakerIterator.Release()
+	} else {
+		requested := set.NewSet[ids.NodeID](len(args.NodeIDs))
+		for _, nodeID := range args.NodeIDs {
+			if requested.Contains(nodeID) {
+				continue
+			}
+			requested.Add(nodeID)
+
+			staker, err := s.vm.state.GetCurrentValidator(args.SubnetID, nodeID)
+			switch err {
+			case nil:
+				validators = append(validators, staker)
+			case database.ErrNotFound:
+			default:
+				return err
+			}
+		}
+	}
+
+	now := s.vm.state.GetTimestamp()
+	reply.Validators = make([]APIDelegationCapacity, 0, len(validators))
+	for _, validator := range validators {
+		if validator.Priority.IsPermissionedValidator() {
+			continue
+		}
+
+		attr, err := s.loadStakerTxAttributes(validator.TxID)
+		if err != nil {
+			return err
+		}
+		maxWeight, err := executor.GetMaxValidatorWeight(&s.vm.Config, s.vm.state, validator)
+		if err != nil {
+			return fmt.Errorf("couldn't get max weight of %s: %w", validator.NodeID, err)
+		}
+		maxTotalWeight, err := executor.GetMaxWeight(s.vm.state, validator, now, validator.EndTime)
+		if err != nil {
+			return fmt.Errorf("couldn't get total weight of %s: %w", validator.NodeID, err)
+		}
+
+		var remainingCapacity uint64
+		if maxTotalWeight < maxWeight {
+			remainingCapacity = maxWeight - maxTotalWeight
+		}
+		reply.Validators = append(reply.Validators, APIDelegationCapacity{
+			TxID:               validator.TxID,
+			NodeID:             validator.NodeID,
+			StartTime:          avajson.Uint64(validator.StartTime.Unix()),
+			EndTime:            avajson.Uint64(validator.EndTime.Unix()),
+			Weight:             avajson.Uint64(validator.Weight),
+			DelegationFee:      avajson.Float32(100 * float32(attr.shares) / float32(reward.PercentDenominator)),
+			MaxWeight:          avajson.Uint64(maxWeight),
+			MaxDelegatedWeight: avajson.Uint64(maxTotalWeight - validator.Weight),
+			RemainingCapacity:  avajson.Uint64(remainingCapacity),
+		})
+	}
+	return nil
+}
+
 // GetRewardUTXOsReply defines the GetRewardUTXOs replies returned from the API
 type GetRewardUTXOsReply struct {
 	// Number of UTXOs returned
@@ -1812,6 +2675,211 @@ func (s *Service) GetRewardUTXOs(_ *http.Request, args *api.GetTxArgs, reply *Ge
 	return nil
 }
 
+// GetRewardEstimateArgs are the arguments for calling GetRewardEstimate
+type GetRewardEstimateArgs struct {
+	SubnetID ids.ID         `json:"subnetID"`
+	Amount   avajson.Uint64 `json:"amount"`
+	// Duration of the staking period, in seconds
+	Duration avajson.Uint64 `json:"duration"`
+	// DelegationFee, if provided, is the delegation fee percentage of the
+	// validator being delegated to. If provided, the estimated reward is split
+	// between the delegator and the validator.
+	DelegationFee *avajson.Float32 `json:"delegationFee,omitempty"`
+}
+
+// GetRewardEstimateReply is the response from GetRewardEstimate
+type GetRewardEstimateReply struct {
+	// Supply that the estimate was calculated against
+	CurrentSupply avajson.Uint64 `json:"currentSupply"`
+	// Total reward that the stake would earn if started now
+	PotentialReward avajson.Uint64 `json:"potentialReward"`
+	// Portion of [PotentialReward] paid to the delegator. Only populated if a
+	// delegation fee was provided.
+	DelegatorReward *avajson.Uint64 `json:"delegatorReward,omitempty"`
+	// Portion of [PotentialReward] paid to the validator being delegated to.
+	// Only populated if a delegation fee was provided.
+	DelegateeReward *avajson.Uint64 `json:"delegateeReward,omitempty"`
+}
+
+// GetRewardEstimate returns the reward that staking [Amount] for [Duration]
+// would earn if the staking period started at the current chain time.
+func (s *Service) GetRewardEstimate(_ *http.Request, args *GetRewardEstimateArgs, reply *GetRewardEstimateReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getRewardEstimate"),
+		zap.Stringer("subnetID", args.SubnetID),
+	)
+
+	switch {
+	case args.Amount == 0:
+		return errZeroStakeAmount
+	case args.Duration == 0:
+		return errZeroStakeDuration
+	case args.Duration > math.MaxInt64/avajson.Uint64(time.Second):
+		return fmt.Errorf("duration %d is too large", args.Duration)
+	case args.DelegationFee != nil && (*args.DelegationFee < 0 || *args.DelegationFee > 100):
+		return errInvalidDelegationFee
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	rewards := reward.NewCalculator(s.vm.RewardConfig)
+	if args.SubnetID != constants.PrimaryNetworkID {
+		transformSubnet, err := executor.GetTransformSubnetTx(s.vm.state, args.SubnetID)
+		if err != nil {
+			return fmt.Errorf("failed fetching subnet transformation for %s: %w", args.SubnetID, err)
+		}
+		rewards = reward.NewCalculator(reward.Config{
+			MaxConsumptionRate: transformSubnet.MaxConsumptionRate,
+			MinConsumptionRate: transformSubnet.MinConsumptionRate,
+			MintingPeriod:      s.vm.RewardConfig.MintingPeriod,
+			SupplyCap:          transformSubnet.MaximumSupply,
+		})
+	}
+
+	currentSupply, err := s.vm.state.GetCurrentSupply(args.SubnetID)
+	if err != nil {
+		return fmt.Errorf("fetching current supply failed: %w", err)
+	}
+
+	potentialReward := rewards.Calculate(
+		time.Duration(args.Duration)*time.Second,
+		uint64(args.Amount),
+		currentSupply,
+	)
+	reply.CurrentSupply = avajson.Uint64(currentSupply)
+	reply.PotentialReward = avajson.Uint64(potentialReward)
+
+	if args.DelegationFee != nil {
+		shares := uint32(float32(reward.PercentDenominator) * float32(*args.DelegationFee) / 100)
+		delegateeReward, delegatorReward := reward.Split(potentialReward, shares)
+		jsonDelegatorReward := avajson.Uint64(delegatorReward)
+		jsonDelegateeReward := avajson.Uint64(delegateeReward)
+		reply.DelegatorReward = &jsonDelegatorReward
+		reply.DelegateeReward = &jsonDelegateeReward
+	}
+	return nil
+}
+
+// GetRewardHistoryArgs are the arguments for calling GetRewardHistory. Exactly
+// one of NodeID and Address must be provided.
+type GetRewardHistoryArgs struct {
+	NodeID  *ids.NodeID `json:"nodeID,omitempty"`
+	Address string      `json:"address"`
+	// StartIndex is the number of records to skip
+	StartIndex avajson.Uint64 `json:"startIndex"`
+	// Limit is the maximum number of records to return, defaulted to
+	// maxPageSize if omitted
+	Limit avajson.Uint64 `json:"limit"`
+}
+
+// APIRewardPayment is a reward output that was created when a staker was
+// removed
+type APIRewardPayment struct {
+	Amount avajson.Uint64     `json:"amount"`
+	Owner  *platformapi.Owner `json:"owner,omitempty"`
+}
+
+// APIRewardRecord describes the outcome of removing a staker
+type APIRewardRecord struct {
+	RewardTxID      ids.ID             `json:"rewardTxID"`
+	StakerTxID      ids.ID             `json:"stakerTxID"`
+	NodeID          ids.NodeID         `json:"nodeID"`
+	SubnetID        ids.ID             `json:"subnetID"`
+	IsDelegator     bool               `json:"isDelegator"`
+	Timestamp       avajson.Uint64     `json:"timestamp"`
+	Committed       bool               `json:"committed"`
+	PotentialReward avajson.Uint64     `json:"potentialReward"`
+	Payments        []APIRewardPayment `json:"payments"`
+}
+
+// GetRewardHistoryReply is the response from GetRewardHistory
+type GetRewardHistoryReply struct {
+	Rewards []APIRewardRecord `json:"rewards"`
+	// EndIndex is the StartIndex of the next page
+	EndIndex avajson.Uint64 `json:"endIndex"`
+}
+
+// GetRewardHistory returns the outcome of every RewardValidatorTx that removed
+// a staker of the provided node or that paid the provided address, ordered by
+// the time the stakers were removed.
+func (s *Service) GetRewardHistory(_ *http.Request, args *GetRewardHistoryArgs, reply *GetRewardHistoryReply) error {
+	startIndex := uint64(args.StartIndex)
+	limit := uint64(args.Limit)
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getRewardHistory"),
+		logging.UserString("address", args.Address),
+		zap.Uint64("startIndex", startIndex),
+		zap.Uint64("limit", limit),
+	)
+
+	if (args.NodeID == nil) == (args.Address == "") {
+		return errNodeIDAndAddress
+	}
+	if limit > maxPageSize {
+		return fmt.Errorf("limit > maximum allowed (%d)", maxPageSize)
+	} else if limit == 0 {
+		limit = maxPageSize
+	}
+
+	var addr ids.ShortID
+	if args.Address != "" {
+		var err error
+		addr, err = avax.ParseServiceAddress(s.addrManager, args.Address)
+		if err != nil {
+			return fmt.Errorf("couldn't parse address %q: %w", args.Address, err)
+		}
+	}
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	var (
+		records []*state.RewardRecord
+		err     error
+	)
+	if args.NodeID != nil {
+		records, err = s.vm.state.GetNodeRewardRecords(*args.NodeID, startIndex, int(limit))
+	} else {
+		records, err = s.vm.state.GetAddressRewardRecords(addr, startIndex, int(limit))
+	}
+	if err != nil {
+		return fmt.Errorf("couldn't get reward records: %w", err)
+	}
+
+	reply.Rewards = make([]APIRewardRecord, len(records))
+	for i, record := range records {
+		payments := make([]APIRewardPayment, len(record.Payments))
+		for j, payment := range record.Payments {
+			payments[j].Amount = avajson.Uint64(payment.Amount)
+			owner, ok := payment.Owner.(*secp256k1fx.OutputOwners)
+			if !ok {
+				continue
+			}
+			payments[j].Owner, err = s.getAPIOwner(owner)
+			if err != nil {
+				return err
+			}
+		}
+
+		reply.Rewards[i] = APIRewardRecord{
+			RewardTxID:      record.RewardTxID,
+			StakerTxID:      record.StakerTxID,
+			NodeID:          record.NodeID,
+			SubnetID:        record.SubnetID,
+			IsDelegator:     record.IsDelegator,
+			Timestamp:       avajson.Uint64(record.Timestamp),
+			Committed:       record.Committed,
+			PotentialReward: avajson.Uint64(record.PotentialReward),
+			Payments:        payments,
+		}
+	}
+	reply.EndIndex = avajson.Uint64(startIndex + uint64(len(records)))
+	return nil
+}
+
 // GetTimestampReply is the response from GetTimestamp
 type GetTimestampReply struct {
 	// Current timestamp
@@ -1834,8 +2902,13 @@ func (s *Service) GetTimestamp(_ *http.Request, _ *struct{}, reply *GetTimestamp
 
 // GetValidatorsAtArgs is the response from GetValidatorsAt
 type GetValidatorsAtArgs struct {
-	Height   avajson.Uint64 `json:"height"`
-	SubnetID ids.ID         `json:"subnetID"`
+	// At most one of Height and Timestamp may be provided. If neither is
+	// provided, the validator set at height 0 is returned.
+	Height   *avajson.Uint64 `json:"height,omitempty"`
+	SubnetID ids.ID          `json:"subnetID"`
+	// Timestamp, if provided, is used to look up the height of the last
+	// accepted block at or before the provided unix time.
+	Timestamp *avajson.Uint64 `json:"timestamp,omitempty"`
 }
 
 type jsonGetValidatorOutput struct {
@@ -1905,19 +2978,21 @@ type GetValidatorsAtReply struct {
 // GetValidatorsAt returns the weights of the validator set of a provided subnet
 // at the specified height.
 func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, reply *GetValidatorsAtReply) error {
-	height := uint64(args.Height)
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
 		zap.String("method", "getValidatorsAt"),
-		zap.Uint64("height", height),
 		zap.Stringer("subnetID", args.SubnetID),
 	)
 
 	s.vm.ctx.Lock.Lock()
 	defer s.vm.ctx.Lock.Unlock()
 
+	height, err := s.resolveHeight(args.Height, args.Timestamp)
+	if err != nil {
+		return err
+	}
+
 	ctx := r.Context()
-	var err error
 	reply.Validators, err = s.vm.GetValidatorSet(ctx, height, args.SubnetID)
 	if err != nil {
 		return fmt.Errorf("failed to get validator set: %w", err)
@@ -1925,6 +3000,59 @@ func (s *Service) GetValidatorsAt(r *http.Request, args *GetValidatorsAtArgs, re
 	return nil
 }
 
+// GetHeightByTimestampArgs are the arguments for calling GetHeightByTimestamp
+type GetHeightByTimestampArgs struct {
+	// Unix time, in seconds
+	Timestamp avajson.Uint64 `json:"timestamp"`
+}
+
+// GetHeightByTimestampReply is the response from GetHeightByTimestamp
+type GetHeightByTimestampReply struct {
+	Height avajson.Uint64 `json:"height"`
+}
+
+// GetHeightByTimestamp returns the height of the last accepted block whose
+// timestamp is less than or equal to the provided timestamp.
+func (s *Service) GetHeightByTimestamp(_ *http.Request, args *GetHeightByTimestampArgs, reply *GetHeightByTimestampReply) error {
+	s.vm.ctx.Log.Debug("API called",
+		zap.String("service", "platform"),
+		zap.String("method", "getHeightByTimestamp"),
+		zap.Uint64("timestamp", uint64(args.Timestamp)),
+	)
+
+	s.vm.ctx.Lock.Lock()
+	defer s.vm.ctx.Lock.Unlock()
+
+	height, err := s.resolveHeight(nil, &args.Timestamp)
+	reply.Height = avajson.Uint64(height)
+	return err
+}
+
+// resolveHeight returns [height], or 0 if it is nil, if [timestamp] is nil.
+// Otherwise, it returns the height of the last accepted block whose timestamp
+// is less than or equal to [timestamp]. Returns an error if both are provided.
+//
+// Invariant: The caller must hold the context lock.
+func (s *Service) resolveHeight(height *avajson.Uint64, timestamp *avajson.Uint64) (uint64, error) {
+	switch {
+	case height != nil && timestamp != nil:
+		return 0, errHeightAndTimestamp
+	case height != nil:
+		return uint64(*height), nil
+	case timestamp == nil:
+		return 0, nil
+	}
+	if *timestamp > math.MaxInt64 {
+		return 0, fmt.Errorf("timestamp %d is too large", *timestamp)
+	}
+
+	resolvedHeight, err := s.vm.state.GetHeightByTimestamp(time.Unix(int64(*timestamp), 0))
+	if err != nil {
+		return 0, fmt.Errorf("couldn't get height at timestamp %d: %w", *timestamp, err)
+	}
+	return resolvedHeight, nil
+}
+
 func (s *Service) GetBlock(_ *http.Request, args *api.GetBlockArgs, response *api.GetBlockResponse) error {
 	s.vm.ctx.Log.Debug("API called",
 		zap.String("service", "platform"),
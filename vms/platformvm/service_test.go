@@ -10,9 +10,11 @@ import (
 	"fmt"
 	"math"
 	"math/rand"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
@@ -27,15 +29,19 @@ import (
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/validators"
+	"github.com/ava-labs/avalanchego/utils"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
 	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
 	"github.com/ava-labs/avalanchego/utils/formatting"
 	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block/builder"
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
 	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/status"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
@@ -203,11 +209,423 @@ func TestGetTxStatus(t *testing.T) {
 	require.Zero(resp.Reason)
 }
 
+func TestGetAddressTxs(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	var err error
+	service.vm.addressTxsIndexer, err = index.NewIndexer(memdb.New(), logging.NoLog{}, "", prometheus.NewRegistry(), true)
+	require.NoError(err)
+
+	changeAddr := keys[0].PublicKey().Address()
+	tx, err := service.vm.txBuilder.NewCreateSubnetTx(
+		1,
+		[]ids.ShortID{keys[1].PublicKey().Address()},
+		[]*secp256k1.PrivateKey{keys[0]},
+		changeAddr,
+		nil,
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	changeAddrStr, err := service.addrManager.FormatLocalAddress(changeAddr)
+	require.NoError(err)
+
+	reply := GetAddressTxsReply{}
+	require.NoError(service.GetAddressTxs(nil, &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: changeAddrStr},
+	}, &reply))
+	require.Equal([]ids.ID{tx.ID()}, reply.TxIDs)
+	require.Equal(avajson.Uint64(1), reply.Cursor)
+
+	// The subnet owner doesn't own any UTXOs of the tx.
+	ownerAddrStr, err := service.addrManager.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+
+	reply = GetAddressTxsReply{}
+	require.NoError(service.GetAddressTxs(nil, &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: ownerAddrStr},
+	}, &reply))
+	require.Empty(reply.TxIDs)
+
+	err = service.GetAddressTxs(nil, &GetAddressTxsArgs{
+		JSONAddress: api.JSONAddress{Address: changeAddrStr},
+		PageSize:    avajson.Uint64(maxPageSize + 1),
+	}, &reply)
+	require.ErrorContains(err, "pageSize > maximum allowed")
+}
+
+func TestGetSubnetDetails(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	err := service.GetSubnetDetails(nil, &GetSubnetDetailsArgs{
+		SubnetID: constants.PrimaryNetworkID,
+	}, &GetSubnetDetailsReply{})
+	require.ErrorIs(err, errPrimaryNetworkIsNotASubnet)
+
+	subnetID := testSubnet1.ID()
+	reply := GetSubnetDetailsReply{}
+	require.NoError(service.GetSubnetDetails(&http.Request{}, &GetSubnetDetailsArgs{
+		SubnetID: subnetID,
+	}, &reply))
+	require.Equal(avajson.Uint32(2), reply.Owner.Threshold)
+	require.Len(reply.Owner.Addresses, 3)
+	require.Len(reply.OwnerHistory, 1)
+	require.Equal(subnetID, reply.OwnerHistory[0].TxID)
+	require.Nil(reply.OwnerHistory[0].Height)
+	require.True(reply.OwnerHistoryIndexed)
+	require.Empty(reply.Blockchains)
+	require.True(reply.IsPermissioned)
+	require.Nil(reply.ElasticParameters)
+
+	service.vm.ctx.Lock.Lock()
+	newOwner := keys[3].PublicKey().Address()
+	tx, err := service.vm.txBuilder.NewTransferSubnetOwnershipTx(
+		subnetID,
+		1,
+		[]ids.ShortID{newOwner},
+		[]*secp256k1.PrivateKey{keys[0], keys[1]},
+		keys[0].PublicKey().Address(),
+		nil,
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+	service.vm.ctx.Lock.Lock()
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+	service.vm.ctx.Lock.Unlock()
+
+	newOwnerStr, err := service.addrManager.FormatLocalAddress(newOwner)
+	require.NoError(err)
+
+	reply = GetSubnetDetailsReply{}
+	require.NoError(service.GetSubnetDetails(&http.Request{}, &GetSubnetDetailsArgs{
+		SubnetID: subnetID,
+	}, &reply))
+	require.Equal(avajson.Uint64(blk.Height()), reply.Height)
+	require.Equal([]string{newOwnerStr}, reply.Owner.Addresses)
+	require.Len(reply.OwnerHistory, 2)
+	require.Equal(tx.ID(), reply.OwnerHistory[1].TxID)
+	require.Equal(avajson.Uint64(blk.Height()), *reply.OwnerHistory[1].Height)
+	require.Equal(reply.Owner, reply.OwnerHistory[1].Owner)
+}
+
+func TestBuildTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	fundingAddr := keys[0].PublicKey().Address()
+	fundingAddrStr, err := service.addrManager.FormatLocalAddress(fundingAddr)
+	require.NoError(err)
+	ownerAddrStr, err := service.addrManager.FormatLocalAddress(keys[1].PublicKey().Address())
+	require.NoError(err)
+
+	params, err := json.Marshal(&BuildCreateSubnetTxParams{
+		Owner: pchainapi.Owner{
+			Threshold: 1,
+			Addresses: []string{ownerAddrStr},
+		},
+	})
+	require.NoError(err)
+
+	err = service.BuildTx(nil, &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fundingAddrStr}},
+		TxType:        "unknown",
+		Params:        params,
+	}, &BuildTxReply{})
+	require.ErrorIs(err, errUnknownBuildTxType)
+
+	reply := BuildTxReply{}
+	require.NoError(service.BuildTx(nil, &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fundingAddrStr}},
+		TxType:        CreateSubnetTxType,
+		Params:        params,
+		Encoding:      formatting.Hex,
+	}, &reply))
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.UnsignedTx)
+	require.NoError(err)
+	var utx txs.UnsignedTx
+	_, err = txs.Codec.Unmarshal(unsignedBytes, &utx)
+	require.NoError(err)
+	require.IsType(&txs.CreateSubnetTx{}, utx)
+	require.Len(reply.Signers, len(utx.(*txs.CreateSubnetTx).Ins))
+
+	signers := make([][]*secp256k1.PrivateKey, len(reply.Signers))
+	for i, signer := range reply.Signers {
+		require.Equal([]avajson.Uint32{0}, signer.SigIndices)
+		require.Equal([]string{fundingAddrStr}, signer.Addresses)
+		require.Nil(signer.SubnetID)
+		signers[i] = []*secp256k1.PrivateKey{keys[0]}
+	}
+
+	// The built tx can be signed and accepted.
+	tx := &txs.Tx{Unsigned: utx}
+	require.NoError(tx.Sign(txs.Codec, signers))
+	require.NoError(service.vm.Network.IssueTxFromRPC(tx))
+
+	service.vm.ctx.Lock.Lock()
+	defer service.vm.ctx.Lock.Unlock()
+
+	blk, err := service.vm.BuildBlock(context.Background())
+	require.NoError(err)
+	require.NoError(blk.Verify(context.Background()))
+	require.NoError(blk.Accept(context.Background()))
+
+	_, txStatus, err := service.vm.state.GetTx(tx.ID())
+	require.NoError(err)
+	require.Equal(status.Committed, txStatus)
+}
+
+func TestBuildTxSortsOutputAddresses(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	fundingAddrStr, err := service.addrManager.FormatLocalAddress(keys[0].PublicKey().Address())
+	require.NoError(err)
+	ownerAddrStrs := make([]string, 0, 2)
+	for _, key := range keys[1:3] {
+		addrStr, err := service.addrManager.FormatLocalAddress(key.PublicKey().Address())
+		require.NoError(err)
+		ownerAddrStrs = append(ownerAddrStrs, addrStr)
+	}
+
+	params, err := json.Marshal(&BuildBaseTxParams{
+		Outputs: []BuildTxOutput{{
+			AssetID: service.vm.ctx.AVAXAssetID,
+			Amount:  1,
+			Owner: pchainapi.Owner{
+				Threshold: 1,
+				Addresses: ownerAddrStrs,
+			},
+		}},
+	})
+	require.NoError(err)
+
+	reply := BuildTxReply{}
+	require.NoError(service.BuildTx(nil, &BuildTxArgs{
+		JSONFromAddrs: api.JSONFromAddrs{From: []string{fundingAddrStr}},
+		TxType:        BaseTxType,
+		Params:        params,
+		Encoding:      formatting.Hex,
+	}, &reply))
+
+	unsignedBytes, err := formatting.Decode(reply.Encoding, reply.UnsignedTx)
+	require.NoError(err)
+	var utx txs.UnsignedTx
+	_, err = txs.Codec.Unmarshal(unsignedBytes, &utx)
+	require.NoError(err)
+	// Prior to Durango, the wallet builds base txs as CreateSubnetTxs.
+	require.IsType(&txs.CreateSubnetTx{}, utx)
+	for _, out := range utx.(*txs.CreateSubnetTx).Outs {
+		require.True(utils.IsSortedAndUnique(out.Out.(*secp256k1fx.TransferOutput).Addrs))
+	}
+
+	signers := make([][]*secp256k1.PrivateKey, len(reply.Signers))
+	for i := range signers {
+		signers[i] = []*secp256k1.PrivateKey{keys[0]}
+	}
+
+	// The built tx passes verification.
+	tx := &txs.Tx{Unsigned: utx}
+	require.NoError(tx.Sign(txs.Codec, signers))
+	require.NoError(tx.SyntacticVerify(service.vm.ctx))
+}
+
+func TestSimulateTx(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	nodeID := ids.GenerateTestNodeID()
+	tx, err := service.vm.txBuilder.NewAddPermissionlessValidatorTx(
+		service.vm.MinValidatorStake,
+		uint64(service.vm.clock.Time().Add(txexecutor.SyncBound).Unix()),
+		uint64(service.vm.clock.Time().Add(txexecutor.SyncBound).Add(defaultMinStakingDuration).Unix()),
+		nodeID,
+		signer.NewProofOfPossession(sk),
+		ids.GenerateTestShortID(),
+		0,
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+		nil,
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args := &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}
+	reply := &SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, args, reply))
+	require.Equal(tx.ID(), reply.TxID)
+	require.Empty(reply.Error)
+	require.NotEmpty(reply.Consumed)
+	require.Len(reply.Produced, len(tx.UTXOs()))
+	require.Len(reply.Staked, 1)
+	require.Equal(avajson.Uint64(service.vm.MinValidatorStake), reply.Staked[0].Amount)
+	// Adding a primary network validator is free in the default config.
+	require.Empty(reply.Burned)
+	require.Len(reply.Stakers, 1)
+	require.Equal(nodeID, reply.Stakers[0].NodeID)
+	require.Equal(constants.PrimaryNetworkID, reply.Stakers[0].SubnetID)
+
+	// Simulating the tx must not issue it.
+	_, ok := service.vm.Builder.Get(tx.ID())
+	require.False(ok)
+
+	// A tx with invalid credentials reports the verification error.
+	tx.Creds = nil
+	require.NoError(tx.Initialize(txs.Codec))
+	txStr, err = formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	args.Tx = txStr
+	reply = &SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, args, reply))
+	require.NotEmpty(reply.Error)
+	require.Empty(reply.Consumed)
+}
+
+// Before Durango, a staker that starts too far in the future can be issued, but
+// its changes can't be simulated, so the verification error is reported.
+func TestSimulateTxFutureStakeTime(t *testing.T) {
+	require := require.New(t)
+
+	vm, _, _ := defaultVM(t, cortina)
+	service := &Service{
+		vm:          vm,
+		addrManager: avax.NewAddressManager(vm.ctx),
+	}
+
+	vm.ctx.Lock.Lock()
+	startTime := vm.clock.Time().Add(txexecutor.MaxFutureStartTime).Add(time.Hour)
+	tx, err := vm.txBuilder.NewAddValidatorTx(
+		vm.MinValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+		ids.GenerateTestNodeID(),
+		ids.GenerateTestShortID(),
+		reward.PercentDenominator,
+		[]*secp256k1.PrivateKey{keys[0]},
+		ids.ShortEmpty, // change addr
+		nil,
+	)
+	require.NoError(err)
+	require.NoError(vm.manager.VerifyTx(tx))
+	_, err = vm.manager.SimulateTx(tx)
+	require.ErrorIs(err, txexecutor.ErrFutureStakeTime)
+	vm.ctx.Lock.Unlock()
+
+	txStr, err := formatting.Encode(formatting.Hex, tx.Bytes())
+	require.NoError(err)
+	reply := &SimulateTxReply{}
+	require.NoError(service.SimulateTx(nil, &api.FormattedTx{
+		Tx:       txStr,
+		Encoding: formatting.Hex,
+	}, reply))
+	require.Contains(reply.Error, txexecutor.ErrFutureStakeTime.Error())
+	require.Empty(reply.Consumed)
+}
+
+func TestGetMempool(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	sk, err := bls.NewSecretKey()
+	require.NoError(err)
+
+	service.vm.ctx.Lock.Lock()
+	decisionTx, err := service.vm.txBuilder.NewCreateSubnetTx(
+		1,
+		[]ids.ShortID{keys[1].PublicKey().Address()},
+		[]*secp256k1.PrivateKey{keys[1]},
+		keys[1].PublicKey().Address(), // change addr
+		nil,
+	)
+	require.NoError(err)
+
+	nodeID := ids.GenerateTestNodeID()
+	startTime := service.vm.clock.Time().Add(txexecutor.SyncBound)
+	stakerTx, err := service.vm.txBuilder.NewAddPermissionlessValidatorTx(
+		service.vm.MinValidatorStake,
+		uint64(startTime.Unix()),
+		uint64(startTime.Add(defaultMinStakingDuration).Unix()),
+		nodeID,
+		signer.NewProofOfPossession(sk),
+		ids.GenerateTestShortID(),
+		0,
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+		nil,
+	)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	reply := &GetMempoolReply{}
+	require.NoError(service.GetMempool(nil, nil, reply))
+	require.Empty(reply.DecisionTxs)
+	require.Empty(reply.StakerTxs)
+
+	require.NoError(service.vm.Builder.Add(decisionTx))
+	require.NoError(service.vm.Builder.Add(stakerTx))
+
+	reply = &GetMempoolReply{}
+	require.NoError(service.GetMempool(nil, nil, reply))
+	require.Equal([]MempoolTx{{
+		TxID: decisionTx.ID(),
+		Size: avajson.Uint64(len(decisionTx.Bytes())),
+	}}, reply.DecisionTxs)
+	stakerStartTime := avajson.Uint64(startTime.Unix())
+	require.Equal([]MempoolTx{{
+		TxID:      stakerTx.ID(),
+		Size:      avajson.Uint64(len(stakerTx.Bytes())),
+		NodeID:    &nodeID,
+		StartTime: &stakerStartTime,
+	}}, reply.StakerTxs)
+
+	// Dropped txs are reported with the reason that they were dropped
+	droppedReply := &GetDroppedTxsReply{}
+	require.NoError(service.GetDroppedTxs(nil, nil, droppedReply))
+	require.Empty(droppedReply.Txs)
+
+	errTestDropped := errors.New("test")
+	service.vm.Builder.Remove(stakerTx)
+	service.vm.Builder.MarkDropped(stakerTx.ID(), errTestDropped)
+
+	droppedReply = &GetDroppedTxsReply{}
+	require.NoError(service.GetDroppedTxs(nil, nil, droppedReply))
+	require.Equal([]DroppedTx{{
+		TxID:   stakerTx.ID(),
+		Reason: errTestDropped.Error(),
+	}}, droppedReply.Txs)
+}
+
 // Test issuing and then retrieving a transaction
 func TestGetTx(t *testing.T) {
 	type test struct {
 		description string
 		createTx    func(service *Service) (*txs.Tx, error)
+		fee         func(service *Service) uint64
 	}
 
 	tests := []test{
@@ -225,6 +643,9 @@ func TestGetTx(t *testing.T) {
 					nil,
 				)
 			},
+			func(service *Service) uint64 {
+				return service.vm.GetCreateBlockchainTxFee(service.vm.clock.Time())
+			},
 		},
 		{
 			"proposal block",
@@ -245,6 +666,9 @@ func TestGetTx(t *testing.T) {
 					nil,
 				)
 			},
+			func(service *Service) uint64 {
+				return service.vm.AddPrimaryNetworkValidatorFee
+			},
 		},
 		{
 			"atomic block",
@@ -258,15 +682,35 @@ func TestGetTx(t *testing.T) {
 					nil,
 				)
 			},
+			func(service *Service) uint64 {
+				return service.vm.TxFee
+			},
 		},
 	}
 
+	type format struct {
+		encoding formatting.Encoding
+		rich     bool
+	}
+	formats := make([]format, 0, len(encodings)+1)
+	for _, encoding := range encodings {
+		formats = append(formats, format{encoding: encoding})
+	}
+	formats = append(formats, format{encoding: formatting.JSON, rich: true})
+
 	for _, test := range tests {
-		for _, encoding := range encodings {
+		for _, format := range formats {
+			encoding := format.encoding
 			testName := fmt.Sprintf("test '%s - %s'",
 				test.description,
 				encoding.String(),
 			)
+			if format.rich {
+				testName = fmt.Sprintf("test '%s - rich %s'",
+					test.description,
+					encoding.String(),
+				)
+			}
 			t.Run(testName, func(t *testing.T) {
 				require := require.New(t)
 				service, _ := defaultService(t)
@@ -275,11 +719,21 @@ func TestGetTx(t *testing.T) {
 				tx, err := test.createTx(service)
 				require.NoError(err)
 
+				// Spent UTXOs are removed from the state once the tx is
+				// accepted, so they are read now.
+				spentUTXOs := make(map[string]*avax.UTXO)
+				for inputID := range tx.Unsigned.InputIDs() {
+					utxo, err := service.vm.state.GetUTXO(inputID)
+					require.NoError(err)
+					spentUTXOs[utxo.UTXOID.String()] = utxo
+				}
+
 				service.vm.ctx.Lock.Unlock()
 
 				arg := &api.GetTxArgs{
 					TxID:     tx.ID(),
 					Encoding: encoding,
+					Rich:     format.rich,
 				}
 				var response api.GetTxReply
 				err = service.GetTx(nil, arg, &response)
@@ -311,8 +765,8 @@ func TestGetTx(t *testing.T) {
 
 				require.NoError(service.GetTx(nil, arg, &response))
 
-				switch encoding {
-				case formatting.Hex:
+				switch {
+				case encoding == formatting.Hex:
 					// we're always guaranteed a string for hex encodings.
 					var txStr string
 					require.NoError(json.Unmarshal(response.Tx, &txStr))
@@ -320,7 +774,47 @@ func TestGetTx(t *testing.T) {
 					require.NoError(err)
 					require.Equal(tx.Bytes(), responseTxBytes)
 
-				case formatting.JSON:
+				case format.rich:
+					var richTx struct {
+						Inputs []avax.RichInput  `json:"inputs"`
+						Burned []avax.RichAmount `json:"burned"`
+						Staker *RichStaker       `json:"staker"`
+					}
+					require.NoError(json.Unmarshal(response.Tx, &richTx))
+
+					// Each input is resolved to the UTXO that it spends
+					require.Len(richTx.Inputs, len(spentUTXOs))
+					for _, in := range richTx.Inputs {
+						utxo, ok := spentUTXOs[in.UTXOID]
+						require.True(ok)
+						out := utxo.Out.(*secp256k1fx.TransferOutput)
+						require.Equal(avajson.Uint64(out.Amount()), in.Amount)
+
+						expectedOwner, err := avax.NewRichOwner(service.addrManager, service.vm.ctx.ChainID, &out.OutputOwners)
+						require.NoError(err)
+						require.Equal(expectedOwner, in.Owner)
+					}
+
+					// Only the AVAX fee is burned
+					if fee := test.fee(service); fee > 0 {
+						require.Equal([]avax.RichAmount{{
+							AssetID:     service.vm.ctx.AVAXAssetID,
+							AssetSymbol: avaxSymbol,
+							Amount:      avajson.Uint64(fee),
+						}}, richTx.Burned)
+					} else {
+						require.Empty(richTx.Burned)
+					}
+
+					staker, isStaker := tx.Unsigned.(txs.Staker)
+					require.Equal(isStaker, richTx.Staker != nil)
+					if isStaker {
+						require.Equal(staker.NodeID(), richTx.Staker.NodeID)
+						require.Equal(avajson.Uint64(staker.Weight()), richTx.Staker.Weight)
+						require.NotNil(richTx.Staker.RewardOwner)
+					}
+
+				case encoding == formatting.JSON:
 					tx.Unsigned.InitCtx(service.vm.ctx)
 					expectedTxJSON, err := json.Marshal(tx)
 					require.NoError(err)
@@ -359,6 +853,98 @@ func TestGetBalance(t *testing.T) {
 	}
 }
 
+func TestGetUTXOsSpendability(t *testing.T) {
+	now := time.Unix(1_000_000, 0)
+	nowUnix := uint64(now.Unix())
+	future := nowUnix + 3600
+
+	tests := []struct {
+		name                 string
+		out                  func(secp256k1fx.OutputOwners) avax.TransferableOut
+		expectedSpendability api.Spendability
+	}{
+		{
+			name: "unlocked",
+			out: func(owners secp256k1fx.OutputOwners) avax.TransferableOut {
+				return &secp256k1fx.TransferOutput{
+					Amt:          1,
+					OutputOwners: owners,
+				}
+			},
+			expectedSpendability: api.Spendability{
+				Spendable: true,
+				Threshold: 1,
+			},
+		},
+		{
+			name: "time-locked",
+			out: func(owners secp256k1fx.OutputOwners) avax.TransferableOut {
+				owners.Locktime = future
+				return &secp256k1fx.TransferOutput{
+					Amt:          1,
+					OutputOwners: owners,
+				}
+			},
+			expectedSpendability: api.Spendability{
+				Spendable: false,
+				Locktime:  avajson.Uint64(future),
+				Threshold: 1,
+			},
+		},
+		{
+			name: "stakeable-locked",
+			out: func(owners secp256k1fx.OutputOwners) avax.TransferableOut {
+				return &stakeable.LockOut{
+					Locktime: future,
+					TransferableOut: &secp256k1fx.TransferOutput{
+						Amt:          1,
+						OutputOwners: owners,
+					},
+				}
+			},
+			expectedSpendability: api.Spendability{
+				Spendable:         false,
+				StakeableLocktime: avajson.Uint64(future),
+				Threshold:         1,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			service, _ := defaultService(t)
+
+			addr := ids.GenerateTestShortID()
+			addrStr, err := service.addrManager.FormatLocalAddress(addr)
+			require.NoError(err)
+
+			service.vm.ctx.Lock.Lock()
+			service.vm.clock.Set(now)
+			service.vm.state.AddUTXO(&avax.UTXO{
+				UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+				Asset:  avax.Asset{ID: service.vm.ctx.AVAXAssetID},
+				Out: test.out(secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{addr},
+				}),
+			})
+			require.NoError(service.vm.state.Commit())
+			service.vm.ctx.Lock.Unlock()
+
+			reply := api.GetUTXOsReply{}
+			require.NoError(service.GetUTXOs(nil, &api.GetUTXOsArgs{
+				Addresses: []string{addrStr},
+				Encoding:  formatting.Hex,
+			}, &reply))
+			require.Len(reply.UTXOs, 1)
+
+			expectedSpendability := test.expectedSpendability
+			expectedSpendability.Addresses = []string{addrStr}
+			require.Equal([]*api.Spendability{&expectedSpendability}, reply.Spendability)
+		})
+	}
+}
+
 func TestGetStake(t *testing.T) {
 	require := require.New(t)
 	service, _ := defaultService(t)
@@ -677,6 +1263,226 @@ func TestGetTimestamp(t *testing.T) {
 	require.Equal(newTimestamp, reply.Timestamp)
 }
 
+func TestGetHeightByTimestamp(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	service.vm.ctx.Lock.Lock()
+	chainTimestamp := service.vm.state.GetTimestamp()
+	lastAcceptedHeight, err := service.vm.GetCurrentHeight(context.Background())
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	reply := GetHeightByTimestampReply{}
+	require.NoError(service.GetHeightByTimestamp(nil, &GetHeightByTimestampArgs{
+		Timestamp: avajson.Uint64(chainTimestamp.Add(time.Hour).Unix()),
+	}, &reply))
+	require.Equal(avajson.Uint64(lastAcceptedHeight), reply.Height)
+
+	err = service.GetHeightByTimestamp(nil, &GetHeightByTimestampArgs{
+		Timestamp: avajson.Uint64(defaultGenesisTime.Add(-time.Second).Unix()),
+	}, &reply)
+	require.ErrorIs(err, state.ErrTimestampNotIndexed)
+
+	// Providing both a height and a timestamp is an error, even if the height
+	// is 0
+	height := avajson.Uint64(0)
+	timestamp := avajson.Uint64(chainTimestamp.Unix())
+	err = service.GetValidatorsAt(nil, &GetValidatorsAtArgs{
+		Height:    &height,
+		SubnetID:  constants.PrimaryNetworkID,
+		Timestamp: &timestamp,
+	}, &GetValidatorsAtReply{})
+	require.ErrorIs(err, errHeightAndTimestamp)
+
+	err = service.GetCurrentSupply(nil, &GetCurrentSupplyArgs{
+		SubnetID:  constants.PrimaryNetworkID,
+		Height:    &height,
+		Timestamp: &timestamp,
+	}, &GetCurrentSupplyReply{})
+	require.ErrorIs(err, errHeightAndTimestamp)
+}
+
+func TestGetRewardEstimate(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	args := &GetRewardEstimateArgs{
+		SubnetID: constants.PrimaryNetworkID,
+		Amount:   avajson.Uint64(service.vm.MinValidatorStake),
+		Duration: avajson.Uint64(defaultMinStakingDuration / time.Second),
+	}
+	reply := GetRewardEstimateReply{}
+	require.NoError(service.GetRewardEstimate(nil, args, &reply))
+
+	service.vm.ctx.Lock.Lock()
+	currentSupply, err := service.vm.state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+	service.vm.ctx.Lock.Unlock()
+
+	expectedReward := reward.NewCalculator(service.vm.RewardConfig).Calculate(
+		defaultMinStakingDuration,
+		service.vm.MinValidatorStake,
+		currentSupply,
+	)
+	require.Equal(avajson.Uint64(currentSupply), reply.CurrentSupply)
+	require.Equal(avajson.Uint64(expectedReward), reply.PotentialReward)
+	require.Nil(reply.DelegatorReward)
+	require.Nil(reply.DelegateeReward)
+
+	delegationFee := avajson.Float32(2)
+	args.DelegationFee = &delegationFee
+	require.NoError(service.GetRewardEstimate(nil, args, &reply))
+
+	expectedDelegateeReward, expectedDelegatorReward := reward.Split(expectedReward, 20_000)
+	require.Equal(avajson.Uint64(expectedDelegatorReward), *reply.DelegatorReward)
+	require.Equal(avajson.Uint64(expectedDelegateeReward), *reply.DelegateeReward)
+
+	delegationFee = 101
+	err = service.GetRewardEstimate(nil, args, &reply)
+	require.ErrorIs(err, errInvalidDelegationFee)
+}
+
+func TestGetRewardHistory(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	var (
+		nodeID   = ids.GenerateTestNodeID()
+		rewarded = keys[0].PublicKey().Address()
+	)
+	rewardedStr, err := service.addrManager.FormatLocalAddress(rewarded)
+	require.NoError(err)
+
+	getHistory := func(args *GetRewardHistoryArgs) *GetRewardHistoryReply {
+		reply := &GetRewardHistoryReply{}
+		require.NoError(service.GetRewardHistory(nil, args, reply))
+		return reply
+	}
+
+	// No stakers of the node have been removed yet.
+	reply := getHistory(&GetRewardHistoryArgs{NodeID: &nodeID})
+	require.Empty(reply.Rewards)
+	require.Zero(reply.EndIndex)
+
+	reply = getHistory(&GetRewardHistoryArgs{Address: rewardedStr})
+	require.Empty(reply.Rewards)
+	require.Zero(reply.EndIndex)
+
+	// Remove 3 stakers of the node, the last of which isn't rewarded.
+	records := make([]*state.RewardRecord, 3)
+	for i := range records {
+		records[i] = &state.RewardRecord{
+			RewardTxID:      ids.GenerateTestID(),
+			StakerTxID:      ids.GenerateTestID(),
+			NodeID:          nodeID,
+			SubnetID:        constants.PrimaryNetworkID,
+			IsDelegator:     i > 0,
+			Timestamp:       uint64(i + 1),
+			Committed:       i < 2,
+			PotentialReward: uint64(i + 1),
+		}
+		if records[i].Committed {
+			records[i].Payments = []*state.RewardPayment{{
+				Amount: records[i].PotentialReward,
+				Owner: &secp256k1fx.OutputOwners{
+					Threshold: 1,
+					Addrs:     []ids.ShortID{rewarded},
+				},
+			}}
+		}
+	}
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddRewardRecord(records[0])
+	require.NoError(service.vm.state.Commit())
+	service.vm.ctx.Lock.Unlock()
+
+	// Single reward
+	reply = getHistory(&GetRewardHistoryArgs{NodeID: &nodeID})
+	require.Len(reply.Rewards, 1)
+	require.Equal(avajson.Uint64(1), reply.EndIndex)
+
+	rewardedOwner, err := service.getAPIOwner(&secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{rewarded},
+	})
+	require.NoError(err)
+	require.Equal(APIRewardRecord{
+		RewardTxID:      records[0].RewardTxID,
+		StakerTxID:      records[0].StakerTxID,
+		NodeID:          nodeID,
+		SubnetID:        constants.PrimaryNetworkID,
+		IsDelegator:     false,
+		Timestamp:       1,
+		Committed:       true,
+		PotentialReward: 1,
+		Payments: []APIRewardPayment{{
+			Amount: 1,
+			Owner:  rewardedOwner,
+		}},
+	}, reply.Rewards[0])
+
+	service.vm.ctx.Lock.Lock()
+	service.vm.state.AddRewardRecord(records[1])
+	service.vm.state.AddRewardRecord(records[2])
+	require.NoError(service.vm.state.Commit())
+	service.vm.ctx.Lock.Unlock()
+
+	// Multiple rewards, ordered by the time the stakers were removed
+	reply = getHistory(&GetRewardHistoryArgs{NodeID: &nodeID})
+	require.Len(reply.Rewards, 3)
+	require.Equal(avajson.Uint64(3), reply.EndIndex)
+	for i, record := range reply.Rewards {
+		require.Equal(records[i].StakerTxID, record.StakerTxID)
+	}
+	require.Empty(reply.Rewards[2].Payments)
+
+	// The aborted staker didn't pay the address.
+	reply = getHistory(&GetRewardHistoryArgs{Address: rewardedStr})
+	require.Len(reply.Rewards, 2)
+	require.Equal(records[0].StakerTxID, reply.Rewards[0].StakerTxID)
+	require.Equal(records[1].StakerTxID, reply.Rewards[1].StakerTxID)
+
+	// Paginate through the node's history.
+	reply = getHistory(&GetRewardHistoryArgs{
+		NodeID: &nodeID,
+		Limit:  2,
+	})
+	require.Len(reply.Rewards, 2)
+	require.Equal(records[0].StakerTxID, reply.Rewards[0].StakerTxID)
+	require.Equal(records[1].StakerTxID, reply.Rewards[1].StakerTxID)
+	require.Equal(avajson.Uint64(2), reply.EndIndex)
+
+	reply = getHistory(&GetRewardHistoryArgs{
+		NodeID:     &nodeID,
+		StartIndex: reply.EndIndex,
+		Limit:      2,
+	})
+	require.Len(reply.Rewards, 1)
+	require.Equal(records[2].StakerTxID, reply.Rewards[0].StakerTxID)
+	require.Equal(avajson.Uint64(3), reply.EndIndex)
+
+	reply = getHistory(&GetRewardHistoryArgs{
+		NodeID:     &nodeID,
+		StartIndex: reply.EndIndex,
+	})
+	require.Empty(reply.Rewards)
+	require.Equal(avajson.Uint64(3), reply.EndIndex)
+
+	err = service.GetRewardHistory(nil, &GetRewardHistoryArgs{
+		NodeID: &nodeID,
+		Limit:  avajson.Uint64(maxPageSize + 1),
+	}, &GetRewardHistoryReply{})
+	require.ErrorContains(err, "limit > maximum allowed")
+
+	err = service.GetRewardHistory(nil, &GetRewardHistoryArgs{
+		NodeID:  &nodeID,
+		Address: rewardedStr,
+	}, &GetRewardHistoryReply{})
+	require.ErrorIs(err, errNodeIDAndAddress)
+}
+
 func TestGetBlock(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -758,6 +1564,95 @@ func TestGetBlock(t *testing.T) {
 	}
 }
 
+func TestGetDelegationCapacity(t *testing.T) {
+	require := require.New(t)
+	service, _ := defaultService(t)
+
+	genesis, _ := defaultGenesis(t, service.vm.ctx.AVAXAssetID)
+
+	args := GetDelegationCapacityArgs{SubnetID: constants.PrimaryNetworkID}
+	reply := GetDelegationCapacityReply{}
+	require.NoError(service.GetDelegationCapacity(nil, &args, &reply))
+	require.Len(reply.Validators, len(genesis.Validators))
+
+	validatorNodeID := genesisNodeIDs[1]
+	var validator APIDelegationCapacity
+	for _, vdr := range reply.Validators {
+		if vdr.NodeID == validatorNodeID {
+			validator = vdr
+		}
+	}
+	require.Equal(validatorNodeID, validator.NodeID)
+	maxWeight := min(
+		txexecutor.MaxValidatorWeightFactor*uint64(validator.Weight),
+		service.vm.MaxValidatorStake,
+	)
+	require.Equal(avajson.Uint64(maxWeight), validator.MaxWeight)
+	require.Zero(validator.MaxDelegatedWeight)
+	require.Equal(maxWeight-uint64(validator.Weight), uint64(validator.RemainingCapacity))
+
+	// Add a delegator. The delegator is added directly to the state, so it
+	// doesn't need to stake the minimum amount.
+	stakeAmount := uint64(12345)
+	delegatorStartTime := defaultValidateStartTime
+	delegatorEndTime := delegatorStartTime.Add(defaultMinStakingDuration)
+
+	service.vm.ctx.Lock.Lock()
+
+	delTx, err := service.vm.txBuilder.NewAddDelegatorTx(
+		stakeAmount,
+		uint64(delegatorStartTime.Unix()),
+		uint64(delegatorEndTime.Unix()),
+		validatorNodeID,
+		ids.GenerateTestShortID(),
+		[]*secp256k1.PrivateKey{keys[0]},
+		keys[0].PublicKey().Address(), // change addr
+		nil,
+	)
+	require.NoError(err)
+
+	staker, err := state.NewCurrentStaker(
+		delTx.ID(),
+		delTx.Unsigned.(*txs.AddDelegatorTx),
+		delegatorStartTime,
+		0,
+	)
+	require.NoError(err)
+
+	service.vm.state.PutCurrentDelegator(staker)
+	service.vm.state.AddTx(delTx, status.Committed)
+	require.NoError(service.vm.state.Commit())
+
+	service.vm.ctx.Lock.Unlock()
+
+	// The delegation reduces the remaining capacity of only its validator
+	args.NodeIDs = []ids.NodeID{validatorNodeID}
+	reply = GetDelegationCapacityReply{}
+	require.NoError(service.GetDelegationCapacity(nil, &args, &reply))
+	require.Len(reply.Validators, 1)
+	validator = reply.Validators[0]
+	require.Equal(validatorNodeID, validator.NodeID)
+	require.Equal(avajson.Uint64(stakeAmount), validator.MaxDelegatedWeight)
+	require.Equal(maxWeight-uint64(validator.Weight)-stakeAmount, uint64(validator.RemainingCapacity))
+
+	// Validators are returned in the requested order, without duplicates, and
+	// unknown nodes are omitted
+	args.NodeIDs = []ids.NodeID{
+		genesisNodeIDs[2],
+		ids.GenerateTestNodeID(),
+		genesisNodeIDs[0],
+		genesisNodeIDs[2],
+		genesisNodeIDs[1],
+		genesisNodeIDs[0],
+	}
+	reply = GetDelegationCapacityReply{}
+	require.NoError(service.GetDelegationCapacity(nil, &args, &reply))
+	require.Len(reply.Validators, 3)
+	require.Equal(genesisNodeIDs[2], reply.Validators[0].NodeID)
+	require.Equal(genesisNodeIDs[0], reply.Validators[1].NodeID)
+	require.Equal(genesisNodeIDs[1], reply.Validators[2].NodeID)
+}
+
 func TestGetValidatorsAtReplyMarshalling(t *testing.T) {
 	require := require.New(t)
 
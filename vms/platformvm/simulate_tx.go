@@ -0,0 +1,189 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package platformvm
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/state"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+
+	avajson "github.com/ava-labs/avalanchego/utils/json"
+	safemath "github.com/ava-labs/avalanchego/utils/math"
+	platformapi "github.com/ava-labs/avalanchego/vms/platformvm/api"
+)
+
+// amounter is implemented by every output that holds funds, including locked
+// outputs.
+type amounter interface {
+	Amount() uint64
+}
+
+// APISimulatedUTXO is a UTXO consumed or produced by a simulated tx
+type APISimulatedUTXO struct {
+	UTXOID  string         `json:"utxoID"`
+	AssetID ids.ID         `json:"assetID"`
+	Amount  avajson.Uint64 `json:"amount"`
+}
+
+// APISimulatedStaker is a staker added by a simulated tx
+type APISimulatedStaker struct {
+	platformapi.Staker
+	SubnetID ids.ID `json:"subnetID"`
+	// Pending is true if the staker would be added to the pending staker set
+	// rather than the current staker set.
+	Pending bool `json:"pending"`
+}
+
+// getSimulatedConsumed returns the UTXOs that [tx] consumes. Local UTXOs are
+// read from [chain], which must be the state that [tx] is executed on.
+func getSimulatedConsumed(chain state.Chain, tx *txs.Tx) ([]APISimulatedUTXO, error) {
+	var (
+		consumed []APISimulatedUTXO
+		imported set.Set[ids.ID]
+	)
+	if importTx, ok := tx.Unsigned.(*txs.ImportTx); ok {
+		for _, in := range importTx.ImportedInputs {
+			imported.Add(in.InputID())
+			consumed = append(consumed, APISimulatedUTXO{
+				UTXOID:  in.UTXOID.String(),
+				AssetID: in.AssetID(),
+				Amount:  avajson.Uint64(in.Input().Amount()),
+			})
+		}
+	}
+
+	inputIDs := tx.Unsigned.InputIDs().List()
+	utils.Sort(inputIDs)
+	for _, inputID := range inputIDs {
+		if imported.Contains(inputID) {
+			continue
+		}
+		utxo, err := chain.GetUTXO(inputID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get UTXO %s: %w", inputID, err)
+		}
+		apiUTXO, err := newAPISimulatedUTXO(&utxo.UTXOID, utxo.AssetID(), utxo.Out)
+		if err != nil {
+			return nil, err
+		}
+		consumed = append(consumed, apiUTXO)
+	}
+	return consumed, nil
+}
+
+// getSimulatedStaked returns the outputs that [tx] locks as stake. They are
+// only produced once the staker is removed.
+func getSimulatedStaked(tx *txs.Tx) ([]APISimulatedUTXO, error) {
+	stakerTx, ok := tx.Unsigned.(txs.PermissionlessStaker)
+	if !ok {
+		return nil, nil
+	}
+
+	var (
+		txID         = tx.ID()
+		outputsIndex = len(tx.Unsigned.Outputs())
+		stake        = stakerTx.Stake()
+		staked       = make([]APISimulatedUTXO, len(stake))
+	)
+	for i, out := range stake {
+		utxoID := avax.UTXOID{
+			TxID:        txID,
+			OutputIndex: uint32(outputsIndex + i),
+		}
+		apiUTXO, err := newAPISimulatedUTXO(&utxoID, out.AssetID(), out.Output())
+		if err != nil {
+			return nil, err
+		}
+		staked[i] = apiUTXO
+	}
+	return staked, nil
+}
+
+// getSimulatedStakers returns the stakers that [txID] added to [chain].
+func getSimulatedStakers(chain state.Chain, txID ids.ID) ([]APISimulatedStaker, error) {
+	currentIt, err := chain.GetCurrentStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	stakers := getStakersWithTxID(currentIt, txID, false)
+
+	pendingIt, err := chain.GetPendingStakerIterator()
+	if err != nil {
+		return nil, err
+	}
+	return append(stakers, getStakersWithTxID(pendingIt, txID, true)...), nil
+}
+
+func getStakersWithTxID(it state.StakerIterator, txID ids.ID, pending bool) []APISimulatedStaker {
+	defer it.Release()
+
+	var stakers []APISimulatedStaker
+	for it.Next() {
+		staker := it.Value()
+		if staker.TxID != txID {
+			continue
+		}
+		weight := avajson.Uint64(staker.Weight)
+		stakers = append(stakers, APISimulatedStaker{
+			Staker: platformapi.Staker{
+				TxID:        staker.TxID,
+				StartTime:   avajson.Uint64(staker.StartTime.Unix()),
+				EndTime:     avajson.Uint64(staker.EndTime.Unix()),
+				Weight:      weight,
+				StakeAmount: &weight,
+				NodeID:      staker.NodeID,
+			},
+			SubnetID: staker.SubnetID,
+			Pending:  pending,
+		})
+	}
+	return stakers
+}
+
+// getBurned returns the amount of each asset that is consumed but neither
+// produced nor staked.
+func getBurned(consumed, produced, staked []APISimulatedUTXO) (map[ids.ID]avajson.Uint64, error) {
+	amounts := make(map[ids.ID]uint64)
+	for _, utxo := range consumed {
+		amount, err := safemath.Add64(amounts[utxo.AssetID], uint64(utxo.Amount))
+		if err != nil {
+			return nil, err
+		}
+		amounts[utxo.AssetID] = amount
+	}
+	for _, utxos := range [][]APISimulatedUTXO{produced, staked} {
+		for _, utxo := range utxos {
+			amount, err := safemath.Sub(amounts[utxo.AssetID], uint64(utxo.Amount))
+			if err != nil {
+				return nil, err
+			}
+			amounts[utxo.AssetID] = amount
+		}
+	}
+
+	burned := make(map[ids.ID]avajson.Uint64)
+	for assetID, amount := range amounts {
+		if amount > 0 {
+			burned[assetID] = avajson.Uint64(amount)
+		}
+	}
+	return burned, nil
+}
+
+func newAPISimulatedUTXO(utxoID *avax.UTXOID, assetID ids.ID, out interface{}) (APISimulatedUTXO, error) {
+	amountOut, ok := out.(amounter)
+	if !ok {
+		return APISimulatedUTXO{}, fmt.Errorf("%w: %T", errUnknownOutputType, out)
+	}
+	return APISimulatedUTXO{
+		UTXOID:  utxoID.String(),
+		AssetID: assetID,
+		Amount:  avajson.Uint64(amountOut.Amount()),
+	}, nil
+}
@@ -53,6 +53,8 @@ type diff struct {
 
 	addedRewardUTXOs map[ids.ID][]*avax.UTXO
 
+	addedRewardRecords []*RewardRecord
+
 	addedTxs map[ids.ID]*txAndStatus
 
 	// map of modified UTXOID -> *UTXO if the UTXO is nil, it has been removed
@@ -364,6 +366,10 @@ func (d *diff) AddRewardUTXO(txID ids.ID, utxo *avax.UTXO) {
 	d.addedRewardUTXOs[txID] = append(d.addedRewardUTXOs[txID], utxo)
 }
 
+func (d *diff) AddRewardRecord(record *RewardRecord) {
+	d.addedRewardRecords = append(d.addedRewardRecords, record)
+}
+
 func (d *diff) GetUTXO(utxoID ids.ID) (*avax.UTXO, error) {
 	utxo, modified := d.modifiedUTXOs[utxoID]
 	if !modified {
@@ -470,6 +476,9 @@ func (d *diff) Apply(baseState Chain) error {
 			baseState.AddRewardUTXO(txID, utxo)
 		}
 	}
+	for _, record := range d.addedRewardRecords {
+		baseState.AddRewardRecord(record)
+	}
 	for utxoID, utxo := range d.modifiedUTXOs {
 		if utxo != nil {
 			baseState.AddUTXO(utxo)
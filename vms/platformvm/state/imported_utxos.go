@@ -0,0 +1,168 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func (s *state) GetImportTxID(utxoID ids.ID) (ids.ID, error) {
+	txIDBytes, err := s.importedUTXODB.Get(utxoID[:])
+	if err != nil {
+		return ids.Empty, err
+	}
+	return ids.ToID(txIDBytes)
+}
+
+// writeImportedUTXOs indexes the UTXOs that [txID] imported if it is an
+// ImportTx.
+func (s *state) writeImportedUTXOs(txID ids.ID, txStatus *txAndStatus) error {
+	if txStatus.status != status.Committed {
+		return nil
+	}
+	importTx, ok := txStatus.tx.Unsigned.(*txs.ImportTx)
+	if !ok {
+		return nil
+	}
+	return s.putImportedUTXOs(txID, importTx)
+}
+
+func (s *state) putImportedUTXOs(txID ids.ID, importTx *txs.ImportTx) error {
+	for _, in := range importTx.ImportedInputs {
+		utxoID := in.InputID()
+		if err := s.importedUTXODB.Put(utxoID[:], txID[:]); err != nil {
+			return fmt.Errorf("failed to index imported UTXO: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *state) ImportedUTXOsIndexed() bool {
+	return s.importedUTXOsIndexed
+}
+
+func (s *state) doneIndexImportedUTXOs() error {
+	if err := s.singletonDB.Put(ImportedUTXOsIndexedKey, nil); err != nil {
+		return fmt.Errorf("failed to mark imported UTXOs as indexed: %w", err)
+	}
+	s.importedUTXOsIndexed = true
+	return nil
+}
+
+// IndexImportedUTXOs scans every accepted tx on disk and indexes the UTXOs
+// imported by the committed ImportTxs. Txs that are accepted while the scan is
+// running are indexed when they are written. Indexing a UTXO twice is a no-op,
+// so if the node stops before the scan finishes it is simply restarted on the
+// next startup.
+func (s *state) IndexImportedUTXOs(lock sync.Locker, log logging.Logger) error {
+	lock.Lock()
+	if s.importedUTXOsIndexed {
+		lock.Unlock()
+		return nil
+	}
+	txIterator := s.txDB.NewIterator()
+	// Releasing is done using a closure to ensure that updating txIterator will
+	// result in having the most recent iterator released when executing the
+	// deferred function.
+	defer func() {
+		txIterator.Release()
+	}()
+	lock.Unlock()
+
+	log.Info("starting imported UTXO indexing")
+
+	var (
+		startTime   = time.Now()
+		lastUpdate  = startTime
+		numScanned  = 0
+		numImported = 0
+	)
+	for txIterator.Next() {
+		txID, err := ids.ToID(txIterator.Key())
+		if err != nil {
+			return err
+		}
+
+		stx := txBytesAndStatus{}
+		if _, err := txs.GenesisCodec.Unmarshal(txIterator.Value(), &stx); err != nil {
+			return err
+		}
+		if stx.Status == status.Committed {
+			tx, err := txs.Parse(txs.GenesisCodec, stx.Tx)
+			if err != nil {
+				return err
+			}
+			if importTx, ok := tx.Unsigned.(*txs.ImportTx); ok {
+				if err := s.putImportedUTXOs(txID, importTx); err != nil {
+					return err
+				}
+				numImported += len(importTx.ImportedInputs)
+			}
+		}
+
+		numScanned++
+		if numScanned%pruneCommitLimit != 0 {
+			continue
+		}
+
+		// We must hold the lock during committing to make sure we don't
+		// attempt to commit to disk while a block is concurrently being
+		// accepted.
+		lock.Lock()
+		err = utils.Err(
+			s.Commit(),
+			txIterator.Error(),
+		)
+		lock.Unlock()
+		if err != nil {
+			return err
+		}
+
+		// We release the iterator here to allow the underlying database to
+		// clean up deleted state.
+		txIterator.Release()
+		txIterator = s.txDB.NewIteratorWithStart(txID[:])
+
+		if now := time.Now(); now.Sub(lastUpdate) > pruneUpdateFrequency {
+			lastUpdate = now
+			log.Info("committing imported UTXO indexing",
+				zap.Int("numScanned", numScanned),
+				zap.Int("numImported", numImported),
+			)
+		}
+	}
+
+	// Ensure we fully iterated over all txs before marking the imported UTXOs
+	// as indexed.
+	if err := txIterator.Error(); err != nil {
+		return err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := s.doneIndexImportedUTXOs(); err != nil {
+		return err
+	}
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	log.Info("finished imported UTXO indexing",
+		zap.Int("numScanned", numScanned),
+		zap.Int("numImported", numImported),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
@@ -62,6 +62,18 @@ func (mr *MockChainMockRecorder) AddChain(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockChain)(nil).AddChain), arg0)
 }
 
+// AddRewardRecord mocks base method.
+func (m *MockChain) AddRewardRecord(arg0 *RewardRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddRewardRecord", arg0)
+}
+
+// AddRewardRecord indicates an expected call of AddRewardRecord.
+func (mr *MockChainMockRecorder) AddRewardRecord(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRewardRecord", reflect.TypeOf((*MockChain)(nil).AddRewardRecord), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockChain) AddRewardUTXO(arg0 ids.ID, arg1 *avax.UTXO) {
 	m.ctrl.T.Helper()
@@ -510,6 +522,18 @@ func (mr *MockDiffMockRecorder) AddChain(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockDiff)(nil).AddChain), arg0)
 }
 
+// AddRewardRecord mocks base method.
+func (m *MockDiff) AddRewardRecord(arg0 *RewardRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddRewardRecord", arg0)
+}
+
+// AddRewardRecord indicates an expected call of AddRewardRecord.
+func (mr *MockDiffMockRecorder) AddRewardRecord(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRewardRecord", reflect.TypeOf((*MockDiff)(nil).AddRewardRecord), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockDiff) AddRewardUTXO(arg0 ids.ID, arg1 *avax.UTXO) {
 	m.ctrl.T.Helper()
@@ -984,6 +1008,18 @@ func (mr *MockStateMockRecorder) AddChain(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChain", reflect.TypeOf((*MockState)(nil).AddChain), arg0)
 }
 
+// AddRewardRecord mocks base method.
+func (m *MockState) AddRewardRecord(arg0 *RewardRecord) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AddRewardRecord", arg0)
+}
+
+// AddRewardRecord indicates an expected call of AddRewardRecord.
+func (mr *MockStateMockRecorder) AddRewardRecord(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRewardRecord", reflect.TypeOf((*MockState)(nil).AddRewardRecord), arg0)
+}
+
 // AddRewardUTXO mocks base method.
 func (m *MockState) AddRewardUTXO(arg0 ids.ID, arg1 *avax.UTXO) {
 	m.ctrl.T.Helper()
@@ -1201,6 +1237,21 @@ func (mr *MockStateMockRecorder) DeleteUTXO(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUTXO", reflect.TypeOf((*MockState)(nil).DeleteUTXO), arg0)
 }
 
+// GetAddressRewardRecords mocks base method.
+func (m *MockState) GetAddressRewardRecords(arg0 ids.ShortID, arg1 uint64, arg2 int) ([]*RewardRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAddressRewardRecords", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*RewardRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAddressRewardRecords indicates an expected call of GetAddressRewardRecords.
+func (mr *MockStateMockRecorder) GetAddressRewardRecords(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAddressRewardRecords", reflect.TypeOf((*MockState)(nil).GetAddressRewardRecords), arg0, arg1, arg2)
+}
+
 // GetBlockIDAtHeight mocks base method.
 func (m *MockState) GetBlockIDAtHeight(arg0 uint64) (ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -1306,6 +1357,36 @@ func (mr *MockStateMockRecorder) GetDelegateeReward(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDelegateeReward", reflect.TypeOf((*MockState)(nil).GetDelegateeReward), arg0, arg1)
 }
 
+// GetHeightByTimestamp mocks base method.
+func (m *MockState) GetHeightByTimestamp(arg0 time.Time) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeightByTimestamp", arg0)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeightByTimestamp indicates an expected call of GetHeightByTimestamp.
+func (mr *MockStateMockRecorder) GetHeightByTimestamp(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeightByTimestamp", reflect.TypeOf((*MockState)(nil).GetHeightByTimestamp), arg0)
+}
+
+// GetImportTxID mocks base method.
+func (m *MockState) GetImportTxID(arg0 ids.ID) (ids.ID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetImportTxID", arg0)
+	ret0, _ := ret[0].(ids.ID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetImportTxID indicates an expected call of GetImportTxID.
+func (mr *MockStateMockRecorder) GetImportTxID(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImportTxID", reflect.TypeOf((*MockState)(nil).GetImportTxID), arg0)
+}
+
 // GetLastAccepted mocks base method.
 func (m *MockState) GetLastAccepted() ids.ID {
 	m.ctrl.T.Helper()
@@ -1320,6 +1401,21 @@ func (mr *MockStateMockRecorder) GetLastAccepted() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastAccepted", reflect.TypeOf((*MockState)(nil).GetLastAccepted))
 }
 
+// GetNodeRewardRecords mocks base method.
+func (m *MockState) GetNodeRewardRecords(arg0 ids.NodeID, arg1 uint64, arg2 int) ([]*RewardRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNodeRewardRecords", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*RewardRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNodeRewardRecords indicates an expected call of GetNodeRewardRecords.
+func (mr *MockStateMockRecorder) GetNodeRewardRecords(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeRewardRecords", reflect.TypeOf((*MockState)(nil).GetNodeRewardRecords), arg0, arg1, arg2)
+}
+
 // GetPendingDelegatorIterator mocks base method.
 func (m *MockState) GetPendingDelegatorIterator(arg0 ids.ID, arg1 ids.NodeID) (StakerIterator, error) {
 	m.ctrl.T.Helper()
@@ -1365,6 +1461,21 @@ func (mr *MockStateMockRecorder) GetPendingValidator(arg0, arg1 any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingValidator", reflect.TypeOf((*MockState)(nil).GetPendingValidator), arg0, arg1)
 }
 
+// GetRewardRecord mocks base method.
+func (m *MockState) GetRewardRecord(arg0 ids.ID) (*RewardRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRewardRecord", arg0)
+	ret0, _ := ret[0].(*RewardRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRewardRecord indicates an expected call of GetRewardRecord.
+func (mr *MockStateMockRecorder) GetRewardRecord(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRewardRecord", reflect.TypeOf((*MockState)(nil).GetRewardRecord), arg0)
+}
+
 // GetRewardUTXOs mocks base method.
 func (m *MockState) GetRewardUTXOs(arg0 ids.ID) ([]*avax.UTXO, error) {
 	m.ctrl.T.Helper()
@@ -1425,6 +1536,21 @@ func (mr *MockStateMockRecorder) GetSubnetOwner(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwner", reflect.TypeOf((*MockState)(nil).GetSubnetOwner), arg0)
 }
 
+// GetSubnetOwnerTransfers mocks base method.
+func (m *MockState) GetSubnetOwnerTransfers(arg0 ids.ID) ([]*SubnetOwnerTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubnetOwnerTransfers", arg0)
+	ret0, _ := ret[0].([]*SubnetOwnerTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubnetOwnerTransfers indicates an expected call of GetSubnetOwnerTransfers.
+func (mr *MockStateMockRecorder) GetSubnetOwnerTransfers(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnetOwnerTransfers", reflect.TypeOf((*MockState)(nil).GetSubnetOwnerTransfers), arg0)
+}
+
 // GetSubnetTransformation mocks base method.
 func (m *MockState) GetSubnetTransformation(arg0 ids.ID) (*txs.Tx, error) {
 	m.ctrl.T.Helper()
@@ -1455,6 +1581,21 @@ func (mr *MockStateMockRecorder) GetSubnets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubnets", reflect.TypeOf((*MockState)(nil).GetSubnets))
 }
 
+// GetSupplyAtHeight mocks base method.
+func (m *MockState) GetSupplyAtHeight(arg0 ids.ID, arg1 uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSupplyAtHeight", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSupplyAtHeight indicates an expected call of GetSupplyAtHeight.
+func (mr *MockStateMockRecorder) GetSupplyAtHeight(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSupplyAtHeight", reflect.TypeOf((*MockState)(nil).GetSupplyAtHeight), arg0, arg1)
+}
+
 // GetTimestamp mocks base method.
 func (m *MockState) GetTimestamp() time.Time {
 	m.ctrl.T.Helper()
@@ -1516,6 +1657,48 @@ func (mr *MockStateMockRecorder) GetUptime(arg0, arg1 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUptime", reflect.TypeOf((*MockState)(nil).GetUptime), arg0, arg1)
 }
 
+// ImportedUTXOsIndexed mocks base method.
+func (m *MockState) ImportedUTXOsIndexed() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportedUTXOsIndexed")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// ImportedUTXOsIndexed indicates an expected call of ImportedUTXOsIndexed.
+func (mr *MockStateMockRecorder) ImportedUTXOsIndexed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportedUTXOsIndexed", reflect.TypeOf((*MockState)(nil).ImportedUTXOsIndexed))
+}
+
+// IndexImportedUTXOs mocks base method.
+func (m *MockState) IndexImportedUTXOs(arg0 sync.Locker, arg1 logging.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexImportedUTXOs", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexImportedUTXOs indicates an expected call of IndexImportedUTXOs.
+func (mr *MockStateMockRecorder) IndexImportedUTXOs(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexImportedUTXOs", reflect.TypeOf((*MockState)(nil).IndexImportedUTXOs), arg0, arg1)
+}
+
+// IndexSubnetOwnerTransfers mocks base method.
+func (m *MockState) IndexSubnetOwnerTransfers(arg0 sync.Locker, arg1 logging.Logger) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IndexSubnetOwnerTransfers", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IndexSubnetOwnerTransfers indicates an expected call of IndexSubnetOwnerTransfers.
+func (mr *MockStateMockRecorder) IndexSubnetOwnerTransfers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IndexSubnetOwnerTransfers", reflect.TypeOf((*MockState)(nil).IndexSubnetOwnerTransfers), arg0, arg1)
+}
+
 // PruneAndIndex mocks base method.
 func (m *MockState) PruneAndIndex(arg0 sync.Locker, arg1 logging.Logger) error {
 	m.ctrl.T.Helper()
@@ -1681,6 +1864,20 @@ func (mr *MockStateMockRecorder) ShouldPrune() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShouldPrune", reflect.TypeOf((*MockState)(nil).ShouldPrune))
 }
 
+// SubnetOwnerTransfersIndexed mocks base method.
+func (m *MockState) SubnetOwnerTransfersIndexed() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubnetOwnerTransfersIndexed")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// SubnetOwnerTransfersIndexed indicates an expected call of SubnetOwnerTransfersIndexed.
+func (mr *MockStateMockRecorder) SubnetOwnerTransfersIndexed() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubnetOwnerTransfersIndexed", reflect.TypeOf((*MockState)(nil).SubnetOwnerTransfersIndexed))
+}
+
 // UTXOIDs mocks base method.
 func (m *MockState) UTXOIDs(arg0 []byte, arg1 ids.ID, arg2 int) ([]ids.ID, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,153 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+// RewardRecord describes the outcome of removing a staker from the current
+// staker set with a RewardValidatorTx.
+type RewardRecord struct {
+	// ID of the RewardValidatorTx that removed the staker
+	RewardTxID ids.ID `serialize:"true"`
+	// ID of the tx that added the staker
+	StakerTxID  ids.ID     `serialize:"true"`
+	NodeID      ids.NodeID `serialize:"true"`
+	SubnetID    ids.ID     `serialize:"true"`
+	IsDelegator bool       `serialize:"true"`
+	// Unix time that the staker was removed at
+	Timestamp uint64 `serialize:"true"`
+	// Committed is true if the RewardValidatorTx was committed, meaning that
+	// the staker was rewarded.
+	Committed       bool   `serialize:"true"`
+	PotentialReward uint64 `serialize:"true"`
+	// Payments are the reward outputs that were created when the staker was
+	// removed.
+	Payments []*RewardPayment `serialize:"true"`
+}
+
+type RewardPayment struct {
+	Amount uint64   `serialize:"true"`
+	Owner  fx.Owner `serialize:"true"`
+}
+
+// Addresses returns the set of addresses that were paid by this record.
+func (r *RewardRecord) Addresses() set.Set[ids.ShortID] {
+	var addrs set.Set[ids.ShortID]
+	for _, payment := range r.Payments {
+		owner, ok := payment.Owner.(*secp256k1fx.OutputOwners)
+		if !ok {
+			continue
+		}
+		addrs.Add(owner.Addrs...)
+	}
+	return addrs
+}
+
+// rewardRecordIndexKey returns a key that orders the records of [prefix] by
+// the time the staker was removed.
+func rewardRecordIndexKey(prefix []byte, timestamp uint64, stakerTxID ids.ID) []byte {
+	key := make([]byte, len(prefix)+database.Uint64Size+ids.IDLen)
+	copy(key, prefix)
+	binary.BigEndian.PutUint64(key[len(prefix):], timestamp)
+	copy(key[len(prefix)+database.Uint64Size:], stakerTxID[:])
+	return key
+}
+
+func (s *state) AddRewardRecord(record *RewardRecord) {
+	s.addedRewardRecords = append(s.addedRewardRecords, record)
+}
+
+func (s *state) GetRewardRecord(stakerTxID ids.ID) (*RewardRecord, error) {
+	for _, record := range s.addedRewardRecords {
+		if record.StakerTxID == stakerTxID {
+			return record, nil
+		}
+	}
+
+	recordBytes, err := s.rewardRecordDB.Get(stakerTxID[:])
+	if err != nil {
+		return nil, err
+	}
+
+	record := &RewardRecord{}
+	if _, err := txs.GenesisCodec.Unmarshal(recordBytes, record); err != nil {
+		return nil, fmt.Errorf("failed to parse reward record: %w", err)
+	}
+	return record, nil
+}
+
+func (s *state) GetNodeRewardRecords(nodeID ids.NodeID, startIndex uint64, limit int) ([]*RewardRecord, error) {
+	return s.getIndexedRewardRecords(s.nodeRewardRecordDB, nodeID[:], startIndex, limit)
+}
+
+func (s *state) GetAddressRewardRecords(addr ids.ShortID, startIndex uint64, limit int) ([]*RewardRecord, error) {
+	return s.getIndexedRewardRecords(s.addressRewardRecordDB, addr[:], startIndex, limit)
+}
+
+func (s *state) getIndexedRewardRecords(
+	db database.Database,
+	prefix []byte,
+	startIndex uint64,
+	limit int,
+) ([]*RewardRecord, error) {
+	it := db.NewIteratorWithPrefix(prefix)
+	defer it.Release()
+
+	var records []*RewardRecord
+	for index := uint64(0); len(records) < limit && it.Next(); index++ {
+		// Only the keys of the skipped records are read.
+		if index < startIndex {
+			continue
+		}
+
+		key := it.Key()
+		stakerTxID, err := ids.ToID(key[len(prefix)+database.Uint64Size:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse stakerTxID: %w", err)
+		}
+
+		record, err := s.GetRewardRecord(stakerTxID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get reward record %s: %w", stakerTxID, err)
+		}
+		records = append(records, record)
+	}
+	return records, it.Error()
+}
+
+func (s *state) writeRewardRecords() error {
+	for _, record := range s.addedRewardRecords {
+		recordBytes, err := txs.GenesisCodec.Marshal(txs.CodecVersion, record)
+		if err != nil {
+			return fmt.Errorf("failed to serialize reward record: %w", err)
+		}
+		if err := s.rewardRecordDB.Put(record.StakerTxID[:], recordBytes); err != nil {
+			return fmt.Errorf("failed to write reward record: %w", err)
+		}
+
+		nodeKey := rewardRecordIndexKey(record.NodeID[:], record.Timestamp, record.StakerTxID)
+		if err := s.nodeRewardRecordDB.Put(nodeKey, nil); err != nil {
+			return fmt.Errorf("failed to index reward record by nodeID: %w", err)
+		}
+
+		for addr := range record.Addresses() {
+			addrKey := rewardRecordIndexKey(addr[:], record.Timestamp, record.StakerTxID)
+			if err := s.addressRewardRecordDB.Put(addrKey, nil); err != nil {
+				return fmt.Errorf("failed to index reward record by address: %w", err)
+			}
+		}
+	}
+	s.addedRewardRecords = nil
+	return nil
+}
@@ -5,6 +5,7 @@ package state
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
 	"math"
@@ -58,6 +59,8 @@ var (
 
 	errValidatorSetAlreadyPopulated = errors.New("validator set already populated")
 	errIsNotSubnet                  = errors.New("is not a subnet")
+	ErrTimestampNotIndexed          = errors.New("timestamp is before the first indexed block")
+	errHeightNotIndexed             = errors.New("height is outside of the indexed range")
 
 	BlockIDPrefix                       = []byte("blockID")
 	BlockPrefix                         = []byte("block")
@@ -77,10 +80,17 @@ var (
 	UTXOPrefix                          = []byte("utxo")
 	SubnetPrefix                        = []byte("subnet")
 	SubnetOwnerPrefix                   = []byte("subnetOwner")
+	SubnetOwnerTransferPrefix           = []byte("subnetOwnerTransfer")
+	ImportedUTXOPrefix                  = []byte("importedUTXO")
 	TransformedSubnetPrefix             = []byte("transformedSubnet")
 	SupplyPrefix                        = []byte("supply")
 	ChainPrefix                         = []byte("chain")
 	SingletonPrefix                     = []byte("singleton")
+	TimestampHeightPrefix               = []byte("timestampHeight")
+	SupplyHistoryPrefix                 = []byte("supplyHistory")
+	RewardRecordPrefix                  = []byte("rewardRecord")
+	NodeRewardRecordPrefix              = []byte("nodeRewardRecord")
+	AddressRewardRecordPrefix           = []byte("addressRewardRecord")
 
 	TimestampKey      = []byte("timestamp")
 	CurrentSupplyKey  = []byte("current supply")
@@ -88,6 +98,10 @@ var (
 	HeightsIndexedKey = []byte("heights indexed")
 	InitializedKey    = []byte("initialized")
 	PrunedKey         = []byte("pruned")
+
+	SupplyHistoryIndexedKey        = []byte("supply history indexed")
+	SubnetOwnerTransfersIndexedKey = []byte("subnet owner transfers indexed")
+	ImportedUTXOsIndexedKey        = []byte("imported utxos indexed")
 )
 
 // Chain collects all methods to manage the state of the chain for block
@@ -106,6 +120,8 @@ type Chain interface {
 
 	AddRewardUTXO(txID ids.ID, utxo *avax.UTXO)
 
+	AddRewardRecord(record *RewardRecord)
+
 	AddSubnet(createSubnetTx *txs.Tx)
 
 	GetSubnetOwner(subnetID ids.ID) (fx.Owner, error)
@@ -135,10 +151,48 @@ type State interface {
 
 	GetBlockIDAtHeight(height uint64) (ids.ID, error)
 
+	// GetHeightByTimestamp returns the height of the most recently accepted
+	// block whose timestamp is less than or equal to [timestamp].
+	//
+	// Note: Only blocks accepted after the timestamp index was introduced are
+	// indexed.
+	GetHeightByTimestamp(timestamp time.Time) (uint64, error)
+
+	// GetSupplyAtHeight returns the supply of [subnetID] after the block at
+	// [height] was accepted.
+	//
+	// Note: Only heights accepted after the supply history was introduced are
+	// indexed.
+	GetSupplyAtHeight(subnetID ids.ID, height uint64) (uint64, error)
+
 	GetRewardUTXOs(txID ids.ID) ([]*avax.UTXO, error)
+
+	// GetRewardRecord returns the outcome of rewarding the staker that was
+	// added by [stakerTxID].
+	GetRewardRecord(stakerTxID ids.ID) (*RewardRecord, error)
+	// GetNodeRewardRecords returns at most [limit] reward records of the
+	// stakers of [nodeID], ordered by the time they were removed, skipping the
+	// first [startIndex] records.
+	GetNodeRewardRecords(nodeID ids.NodeID, startIndex uint64, limit int) ([]*RewardRecord, error)
+	// GetAddressRewardRecords returns at most [limit] reward records that paid
+	// [addr], ordered by the time the stakers were removed, skipping the first
+	// [startIndex] records.
+	GetAddressRewardRecords(addr ids.ShortID, startIndex uint64, limit int) ([]*RewardRecord, error)
+
 	GetSubnets() ([]*txs.Tx, error)
 	GetChains(subnetID ids.ID) ([]*txs.Tx, error)
 
+	// GetSubnetOwnerTransfers returns the accepted TransferSubnetOwnershipTxs
+	// of [subnetID], ordered by the height they were accepted at.
+	GetSubnetOwnerTransfers(subnetID ids.ID) ([]*SubnetOwnerTransfer, error)
+
+	// GetImportTxID returns the ID of the accepted ImportTx that imported
+	// [utxoID] from another chain.
+	//
+	// Note: UTXOs imported before the import index was introduced are only
+	// returned once ImportedUTXOsIndexed returns true.
+	GetImportTxID(utxoID ids.ID) (ids.ID, error)
+
 	// ApplyValidatorWeightDiffs iterates from [startHeight] towards the genesis
 	// block until it has applied all of the diffs up to and including
 	// [endHeight]. Applying the diffs modifies [validators].
@@ -193,6 +247,24 @@ type State interface {
 	// TODO: Remove after v1.11.x is activated
 	PruneAndIndex(sync.Locker, logging.Logger) error
 
+	// SubnetOwnerTransfersIndexed returns true if every accepted
+	// TransferSubnetOwnershipTx is returned by GetSubnetOwnerTransfers.
+	SubnetOwnerTransfersIndexed() bool
+
+	// IndexSubnetOwnerTransfers indexes the TransferSubnetOwnershipTxs that
+	// were accepted before the transfer index was introduced. This function
+	// supports being (and is recommended to be) called asynchronously.
+	IndexSubnetOwnerTransfers(sync.Locker, logging.Logger) error
+
+	// ImportedUTXOsIndexed returns true if every UTXO imported by an accepted
+	// ImportTx is returned by GetImportTxID.
+	ImportedUTXOsIndexed() bool
+
+	// IndexImportedUTXOs indexes the UTXOs that were imported before the
+	// import index was introduced. This function supports being (and is
+	// recommended to be) called asynchronously.
+	IndexImportedUTXOs(sync.Locker, logging.Logger) error
+
 	// Commit changes to the base database.
 	Commit() error
 
@@ -263,6 +335,12 @@ type stateBlk struct {
  * | '-. txID
  * |   '-. list
  * |     '-- utxoID -> utxo bytes
+ * |-. rewardRecords
+ * | '-- stakerTxID -> reward record bytes
+ * |-. nodeRewardRecords
+ * | '-- nodeID + timestamp + stakerTxID -> nil
+ * |-. addressRewardRecords
+ * | '-- address + timestamp + stakerTxID -> nil
  * |- utxos
  * | '-- utxoDB
  * |-. subnets
@@ -270,17 +348,28 @@ type stateBlk struct {
  * |   '-- txID -> nil
  * |-. subnetOwners
  * | '-. subnetID -> owner
+ * |-. subnetOwnerTransfers
+ * | '-- subnetID + height + txID -> nil
+ * |-. importedUTXOs
+ * | '-- utxoID -> txID
  * |-. chains
  * | '-. subnetID
  * |   '-. list
  * |     '-- txID -> nil
+ * |-. timestampHeights
+ * | '-- ^timestamp -> height
+ * |-. supplyHistory
+ * | '-- subnetID + ^height -> supply
  * '-. singletons
  *   |-- initializedKey -> nil
  *   |-- prunedKey -> nil
  *   |-- timestampKey -> timestamp
  *   |-- currentSupplyKey -> currentSupply
  *   |-- lastAcceptedKey -> lastAccepted
- *   '-- heightsIndexKey -> startIndexHeight + endIndexHeight
+ *   |-- heightsIndexKey -> startIndexHeight + endIndexHeight
+ *   |-- supplyHistoryIndexedKey -> startIndexHeight
+ *   |-- subnetOwnerTransfersIndexedKey -> nil
+ *   '-- importedUTXOsIndexedKey -> nil
  */
 type state struct {
 	validatorState
@@ -339,6 +428,11 @@ type state struct {
 	rewardUTXOsCache cache.Cacher[ids.ID, []*avax.UTXO] // txID -> []*UTXO
 	rewardUTXODB     database.Database
 
+	addedRewardRecords    []*RewardRecord
+	rewardRecordDB        database.Database
+	nodeRewardRecordDB    database.Database
+	addressRewardRecordDB database.Database
+
 	modifiedUTXOs map[ids.ID]*avax.UTXO // map of modified UTXOID -> *UTXO if the UTXO is nil, it has been removed
 	utxoDB        database.Database
 	utxoState     avax.UTXOState
@@ -353,6 +447,16 @@ type state struct {
 	subnetOwnerCache cache.Cacher[ids.ID, fxOwnerAndSize] // cache of subnetID -> owner if the entry is nil, it is not in the database
 	subnetOwnerDB    database.Database
 
+	subnetOwnerTransferDB database.Database
+	// [subnetOwnerTransfersIndexed] is true once the transfers that were
+	// accepted before the transfer index was introduced have been indexed.
+	subnetOwnerTransfersIndexed bool
+
+	importedUTXODB database.Database
+	// [importedUTXOsIndexed] is true once the UTXOs that were imported before
+	// the import index was introduced have been indexed.
+	importedUTXOsIndexed bool
+
 	transformedSubnets     map[ids.ID]*txs.Tx            // map of subnetID -> transformSubnetTx
 	transformedSubnetCache cache.Cacher[ids.ID, *txs.Tx] // cache of subnetID -> transformSubnetTx if the entry is nil, it is not in the database
 	transformedSubnetDB    database.Database
@@ -366,6 +470,14 @@ type state struct {
 	chainDBCache cache.Cacher[ids.ID, linkeddb.LinkedDB] // cache of subnetID -> linkedDB
 	chainDB      database.Database
 
+	// Keys are inverted so that a forward iteration starting at a requested
+	// value returns the closest entry that is less than or equal to it.
+	timestampHeightDB database.Database // ^timestamp -> height
+	supplyHistoryDB   database.Database // subnetID + ^height -> supply
+	// [supplyHistoryLowerBound] is the first height that has its supplies
+	// indexed. It is nil if the supply history hasn't been initialized yet.
+	supplyHistoryLowerBound *uint64
+
 	// The persisted fields represent the current database value
 	timestamp, persistedTimestamp         time.Time
 	currentSupply, persistedCurrentSupply uint64
@@ -677,6 +789,10 @@ func newState(
 		rewardUTXODB:     rewardUTXODB,
 		rewardUTXOsCache: rewardUTXOsCache,
 
+		rewardRecordDB:        prefixdb.New(RewardRecordPrefix, baseDB),
+		nodeRewardRecordDB:    prefixdb.New(NodeRewardRecordPrefix, baseDB),
+		addressRewardRecordDB: prefixdb.New(AddressRewardRecordPrefix, baseDB),
+
 		modifiedUTXOs: make(map[ids.ID]*avax.UTXO),
 		utxoDB:        utxoDB,
 		utxoState:     utxoState,
@@ -688,6 +804,10 @@ func newState(
 		subnetOwnerDB:    subnetOwnerDB,
 		subnetOwnerCache: subnetOwnerCache,
 
+		subnetOwnerTransferDB: prefixdb.New(SubnetOwnerTransferPrefix, baseDB),
+
+		importedUTXODB: prefixdb.New(ImportedUTXOPrefix, baseDB),
+
 		transformedSubnets:     make(map[ids.ID]*txs.Tx),
 		transformedSubnetCache: transformedSubnetCache,
 		transformedSubnetDB:    prefixdb.New(TransformedSubnetPrefix, baseDB),
@@ -701,6 +821,9 @@ func newState(
 		chainCache:   chainCache,
 		chainDBCache: chainDBCache,
 
+		timestampHeightDB: prefixdb.New(TimestampHeightPrefix, baseDB),
+		supplyHistoryDB:   prefixdb.New(SupplyHistoryPrefix, baseDB),
+
 		singletonDB: prefixdb.New(SingletonPrefix, baseDB),
 	}, nil
 }
@@ -1425,6 +1548,31 @@ func (s *state) loadMetadata() error {
 	s.persistedLastAccepted = lastAccepted
 	s.lastAccepted = lastAccepted
 
+	lastAcceptedBlock, err := s.GetStatelessBlock(lastAccepted)
+	if err != nil {
+		return err
+	}
+	s.currentHeight = lastAcceptedBlock.Height()
+
+	s.subnetOwnerTransfersIndexed, err = s.singletonDB.Has(SubnetOwnerTransfersIndexedKey)
+	if err != nil {
+		return err
+	}
+
+	s.importedUTXOsIndexed, err = s.singletonDB.Has(ImportedUTXOsIndexedKey)
+	if err != nil {
+		return err
+	}
+
+	supplyHistoryLowerBound, err := database.GetUInt64(s.singletonDB, SupplyHistoryIndexedKey)
+	switch err {
+	case nil:
+		s.supplyHistoryLowerBound = &supplyHistoryLowerBound
+	case database.ErrNotFound:
+	default:
+		return err
+	}
+
 	// Lookup the most recently indexed range on disk. If we haven't started
 	// indexing the weights, then we keep the indexed heights as nil.
 	indexedHeightsBytes, err := s.singletonDB.Get(HeightsIndexedKey)
@@ -1443,11 +1591,7 @@ func (s *state) loadMetadata() error {
 
 	// If the indexed range is not up to date, then we will act as if the range
 	// doesn't exist.
-	lastAcceptedBlock, err := s.GetStatelessBlock(lastAccepted)
-	if err != nil {
-		return err
-	}
-	if indexedHeights.UpperBound != lastAcceptedBlock.Height() {
+	if indexedHeights.UpperBound != s.currentHeight {
 		return nil
 	}
 	s.indexedHeights = indexedHeights
@@ -1751,19 +1895,21 @@ func (s *state) write(updateValidators bool, height uint64) error {
 	}
 
 	return utils.Err(
+		s.writeSupplyHistoryBaseline(height),
 		s.writeBlocks(),
 		s.writeCurrentStakers(updateValidators, height, codecVersion),
 		s.writePendingStakers(),
 		s.WriteValidatorMetadata(s.currentValidatorList, s.currentSubnetValidatorList, codecVersion), // Must be called after writeCurrentStakers
 		s.writeTXs(),
 		s.writeRewardUTXOs(),
+		s.writeRewardRecords(),
 		s.writeUTXOs(),
 		s.writeSubnets(),
 		s.writeSubnetOwners(),
 		s.writeTransformedSubnets(),
-		s.writeSubnetSupplies(),
+		s.writeSubnetSupplies(height),
 		s.writeChains(),
-		s.writeMetadata(),
+		s.writeMetadata(height),
 	)
 }
 
@@ -1782,11 +1928,18 @@ func (s *state) Close() error {
 		s.validatorsDB.Close(),
 		s.txDB.Close(),
 		s.rewardUTXODB.Close(),
+		s.rewardRecordDB.Close(),
+		s.nodeRewardRecordDB.Close(),
+		s.addressRewardRecordDB.Close(),
 		s.utxoDB.Close(),
 		s.subnetBaseDB.Close(),
+		s.subnetOwnerTransferDB.Close(),
+		s.importedUTXODB.Close(),
 		s.transformedSubnetDB.Close(),
 		s.supplyDB.Close(),
 		s.chainDB.Close(),
+		s.timestampHeightDB.Close(),
+		s.supplyHistoryDB.Close(),
 		s.singletonDB.Close(),
 		s.blockDB.Close(),
 		s.blockIDDB.Close(),
@@ -1840,6 +1993,15 @@ func (s *state) init(genesisBytes []byte) error {
 		return err
 	}
 
+	// Every block accepted by a new chain has its transfers and imported UTXOs
+	// indexed when it is written.
+	if err := s.doneIndexSubnetOwnerTransfers(); err != nil {
+		return err
+	}
+	if err := s.doneIndexImportedUTXOs(); err != nil {
+		return err
+	}
+
 	if err := s.doneInit(); err != nil {
 		return err
 	}
@@ -1980,6 +2142,47 @@ func (s *state) GetBlockIDAtHeight(height uint64) (ids.ID, error) {
 	return blkID, nil
 }
 
+func (s *state) GetHeightByTimestamp(timestamp time.Time) (uint64, error) {
+	unixTime := timestamp.Unix()
+	if unixTime < 0 {
+		return 0, ErrTimestampNotIndexed
+	}
+
+	it := s.timestampHeightDB.NewIteratorWithStart(
+		database.PackUInt64(^uint64(unixTime)),
+	)
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, err
+		}
+		return 0, ErrTimestampNotIndexed
+	}
+	return database.ParseUInt64(it.Value())
+}
+
+func (s *state) GetSupplyAtHeight(subnetID ids.ID, height uint64) (uint64, error) {
+	if s.supplyHistoryLowerBound == nil || height < *s.supplyHistoryLowerBound || height > s.currentHeight {
+		return 0, errHeightNotIndexed
+	}
+
+	it := s.supplyHistoryDB.NewIteratorWithStartAndPrefix(
+		supplyHistoryKey(subnetID, height),
+		subnetID[:],
+	)
+	defer it.Release()
+
+	if !it.Next() {
+		if err := it.Error(); err != nil {
+			return 0, err
+		}
+		// The subnet did not have a supply at [height].
+		return 0, database.ErrNotFound
+	}
+	return database.ParseUInt64(it.Value())
+}
+
 func (s *state) writeCurrentStakers(updateValidators bool, height uint64, codecVersion uint16) error {
 	heightBytes := database.PackUInt64(height)
 	rawNestedPublicKeyDiffDB := prefixdb.New(heightBytes, s.nestedValidatorPublicKeyDiffsDB)
@@ -2298,6 +2501,13 @@ func (s *state) writeTXs() error {
 		if err := s.txDB.Put(txID[:], txBytes); err != nil {
 			return fmt.Errorf("failed to add tx: %w", err)
 		}
+
+		if err := s.writeSubnetOwnerTransfer(txID, txStatus); err != nil {
+			return err
+		}
+		if err := s.writeImportedUTXOs(txID, txStatus); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -2391,7 +2601,7 @@ func (s *state) writeTransformedSubnets() error {
 	return nil
 }
 
-func (s *state) writeSubnetSupplies() error {
+func (s *state) writeSubnetSupplies(height uint64) error {
 	for subnetID, supply := range s.modifiedSupplies {
 		supply := supply
 		delete(s.modifiedSupplies, subnetID)
@@ -2399,10 +2609,70 @@ func (s *state) writeSubnetSupplies() error {
 		if err := database.PutUInt64(s.supplyDB, subnetID[:], supply); err != nil {
 			return fmt.Errorf("failed to write subnet supply: %w", err)
 		}
+		if err := s.writeSupplyHistory(subnetID, height, supply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeSupplyHistoryBaseline records the supplies of every subnet at [height]
+// if the supply history hasn't been initialized yet. This allows nodes that
+// accepted blocks prior to the introduction of the supply history to answer
+// queries for all heights after [height].
+func (s *state) writeSupplyHistoryBaseline(height uint64) error {
+	if s.supplyHistoryLowerBound != nil {
+		return nil
+	}
+
+	if err := s.writeSupplyHistory(constants.PrimaryNetworkID, height, s.currentSupply); err != nil {
+		return err
+	}
+
+	// Note: Any supplies modified in this block will be overwritten by
+	// writeSubnetSupplies.
+	supplyIt := s.supplyDB.NewIterator()
+	defer supplyIt.Release()
+
+	for supplyIt.Next() {
+		subnetID, err := ids.ToID(supplyIt.Key())
+		if err != nil {
+			return fmt.Errorf("failed to parse subnetID: %w", err)
+		}
+		supply, err := database.ParseUInt64(supplyIt.Value())
+		if err != nil {
+			return fmt.Errorf("failed to parse subnet supply: %w", err)
+		}
+		if err := s.writeSupplyHistory(subnetID, height, supply); err != nil {
+			return err
+		}
 	}
+	if err := supplyIt.Error(); err != nil {
+		return err
+	}
+
+	if err := database.PutUInt64(s.singletonDB, SupplyHistoryIndexedKey, height); err != nil {
+		return fmt.Errorf("failed to write supply history lower bound: %w", err)
+	}
+	s.supplyHistoryLowerBound = &height
 	return nil
 }
 
+func (s *state) writeSupplyHistory(subnetID ids.ID, height uint64, supply uint64) error {
+	key := supplyHistoryKey(subnetID, height)
+	if err := database.PutUInt64(s.supplyHistoryDB, key, supply); err != nil {
+		return fmt.Errorf("failed to write supply history: %w", err)
+	}
+	return nil
+}
+
+func supplyHistoryKey(subnetID ids.ID, height uint64) []byte {
+	key := make([]byte, ids.IDLen+database.Uint64Size)
+	copy(key, subnetID[:])
+	binary.BigEndian.PutUint64(key[ids.IDLen:], ^height)
+	return key
+}
+
 func (s *state) writeChains() error {
 	for subnetID, chains := range s.addedChains {
 		for _, chain := range chains {
@@ -2418,17 +2688,27 @@ func (s *state) writeChains() error {
 	return nil
 }
 
-func (s *state) writeMetadata() error {
+func (s *state) writeMetadata(height uint64) error {
 	if !s.persistedTimestamp.Equal(s.timestamp) {
 		if err := database.PutTimestamp(s.singletonDB, TimestampKey, s.timestamp); err != nil {
 			return fmt.Errorf("failed to write timestamp: %w", err)
 		}
 		s.persistedTimestamp = s.timestamp
 	}
+	// Because the chain time is monotonically non-decreasing, overwriting the
+	// entry for the current timestamp results in it mapping to the highest
+	// height with that timestamp.
+	timestampKey := database.PackUInt64(^uint64(s.timestamp.Unix()))
+	if err := database.PutUInt64(s.timestampHeightDB, timestampKey, height); err != nil {
+		return fmt.Errorf("failed to write timestamp height: %w", err)
+	}
 	if s.persistedCurrentSupply != s.currentSupply {
 		if err := database.PutUInt64(s.singletonDB, CurrentSupplyKey, s.currentSupply); err != nil {
 			return fmt.Errorf("failed to write current supply: %w", err)
 		}
+		if err := s.writeSupplyHistory(constants.PrimaryNetworkID, height, s.currentSupply); err != nil {
+			return err
+		}
 		s.persistedCurrentSupply = s.currentSupply
 	}
 	if s.persistedLastAccepted != s.lastAccepted {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/ava-labs/avalanchego/snow/validators"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/crypto/bls"
+	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/utils/units"
 	"github.com/ava-labs/avalanchego/utils/wrappers"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
@@ -1441,3 +1443,271 @@ func TestStateSubnetOwner(t *testing.T) {
 	require.NoError(err)
 	require.Equal(owner2, owner)
 }
+
+func TestStateImportedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	state := newInitializedState(require)
+
+	utxoID := avax.UTXOID{TxID: ids.GenerateTestID()}
+	importTx := &txs.Tx{
+		Unsigned: &txs.ImportTx{
+			SourceChain: ids.GenerateTestID(),
+			ImportedInputs: []*avax.TransferableInput{{
+				UTXOID: utxoID,
+				Asset:  avax.Asset{ID: ids.GenerateTestID()},
+				In:     &secp256k1fx.TransferInput{Amt: 1},
+			}},
+		},
+	}
+	require.NoError(importTx.Initialize(txs.Codec))
+
+	_, err := state.GetImportTxID(utxoID.InputID())
+	require.ErrorIs(err, database.ErrNotFound)
+
+	// Aborted imports don't consume their UTXOs
+	abortedTx := &txs.Tx{Unsigned: importTx.Unsigned}
+	require.NoError(abortedTx.Initialize(txs.Codec))
+	state.AddTx(abortedTx, status.Aborted)
+	require.NoError(state.Commit())
+
+	_, err = state.GetImportTxID(utxoID.InputID())
+	require.ErrorIs(err, database.ErrNotFound)
+
+	state.AddTx(importTx, status.Committed)
+	require.NoError(state.Commit())
+
+	importTxID, err := state.GetImportTxID(utxoID.InputID())
+	require.NoError(err)
+	require.Equal(importTx.ID(), importTxID)
+}
+
+func TestStateIndexSubnetOwnerTransfers(t *testing.T) {
+	require := require.New(t)
+
+	s := newInitializedState(require).(*state)
+	require.False(s.SubnetOwnerTransfersIndexed())
+
+	subnetID := ids.GenerateTestID()
+	transferTx := &txs.Tx{
+		Unsigned: &txs.TransferSubnetOwnershipTx{
+			Subnet:     subnetID,
+			SubnetAuth: &secp256k1fx.Input{},
+			Owner:      &secp256k1fx.OutputOwners{},
+		},
+	}
+	require.NoError(transferTx.Initialize(txs.Codec))
+
+	blk, err := block.NewBanffStandardBlock(initialTime, ids.GenerateTestID(), 1, []*txs.Tx{transferTx})
+	require.NoError(err)
+
+	s.AddStatelessBlock(blk)
+	s.SetHeight(blk.Height())
+	s.AddTx(transferTx, status.Committed)
+	require.NoError(s.Commit())
+
+	// Remove the transfer from the index, as if it was accepted before the
+	// index was introduced.
+	require.NoError(s.subnetOwnerTransferDB.Delete(subnetOwnerTransferKey(subnetID, blk.Height(), transferTx.ID())))
+	transfers, err := s.GetSubnetOwnerTransfers(subnetID)
+	require.NoError(err)
+	require.Empty(transfers)
+
+	require.NoError(s.IndexSubnetOwnerTransfers(&sync.Mutex{}, logging.NoLog{}))
+	require.True(s.SubnetOwnerTransfersIndexed())
+
+	transfers, err = s.GetSubnetOwnerTransfers(subnetID)
+	require.NoError(err)
+	require.Len(transfers, 1)
+	require.Equal(blk.Height(), transfers[0].Height)
+	require.Equal(transferTx.ID(), transfers[0].Tx.ID())
+
+	indexed, err := s.singletonDB.Has(SubnetOwnerTransfersIndexedKey)
+	require.NoError(err)
+	require.True(indexed)
+}
+
+func TestStateIndexImportedUTXOs(t *testing.T) {
+	require := require.New(t)
+
+	s := newInitializedState(require).(*state)
+	require.False(s.ImportedUTXOsIndexed())
+
+	newImportTx := func() (*txs.Tx, ids.ID) {
+		utxoID := avax.UTXOID{TxID: ids.GenerateTestID()}
+		tx := &txs.Tx{
+			Unsigned: &txs.ImportTx{
+				SourceChain: ids.GenerateTestID(),
+				ImportedInputs: []*avax.TransferableInput{{
+					UTXOID: utxoID,
+					Asset:  avax.Asset{ID: ids.GenerateTestID()},
+					In:     &secp256k1fx.TransferInput{Amt: 1},
+				}},
+			},
+		}
+		require.NoError(tx.Initialize(txs.Codec))
+		return tx, utxoID.InputID()
+	}
+	importTx, importedUTXOID := newImportTx()
+	abortedTx, abortedUTXOID := newImportTx()
+
+	s.AddTx(importTx, status.Committed)
+	s.AddTx(abortedTx, status.Aborted)
+	require.NoError(s.Commit())
+
+	// Remove the UTXO from the index, as if it was imported before the index
+	// was introduced.
+	require.NoError(s.importedUTXODB.Delete(importedUTXOID[:]))
+	_, err := s.GetImportTxID(importedUTXOID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	require.NoError(s.IndexImportedUTXOs(&sync.Mutex{}, logging.NoLog{}))
+	require.True(s.ImportedUTXOsIndexed())
+
+	importTxID, err := s.GetImportTxID(importedUTXOID)
+	require.NoError(err)
+	require.Equal(importTx.ID(), importTxID)
+
+	// Aborted imports don't consume their UTXOs
+	_, err = s.GetImportTxID(abortedUTXOID)
+	require.ErrorIs(err, database.ErrNotFound)
+
+	indexed, err := s.singletonDB.Has(ImportedUTXOsIndexedKey)
+	require.NoError(err)
+	require.True(indexed)
+}
+
+func TestStateTimestampHeightIndex(t *testing.T) {
+	require := require.New(t)
+
+	state := newInitializedState(require)
+
+	// Heights 1 and 2 share a timestamp, so lookups for that timestamp should
+	// return the higher height.
+	for height, timestamp := range []time.Time{
+		initialTime,
+		initialTime.Add(10 * time.Second),
+		initialTime.Add(10 * time.Second),
+		initialTime.Add(20 * time.Second),
+	}[1:] {
+		state.SetHeight(uint64(height + 1))
+		state.SetTimestamp(timestamp)
+		require.NoError(state.Commit())
+	}
+
+	tests := []struct {
+		timestamp      time.Time
+		expectedHeight uint64
+		expectedErr    error
+	}{
+		{
+			timestamp:   initialTime.Add(-time.Second),
+			expectedErr: ErrTimestampNotIndexed,
+		},
+		{
+			timestamp:      initialTime,
+			expectedHeight: 0,
+		},
+		{
+			timestamp:      initialTime.Add(5 * time.Second),
+			expectedHeight: 0,
+		},
+		{
+			timestamp:      initialTime.Add(10 * time.Second),
+			expectedHeight: 2,
+		},
+		{
+			timestamp:      initialTime.Add(15 * time.Second),
+			expectedHeight: 2,
+		},
+		{
+			timestamp:      initialTime.Add(20 * time.Second),
+			expectedHeight: 3,
+		},
+		{
+			timestamp:      initialTime.Add(time.Hour),
+			expectedHeight: 3,
+		},
+	}
+	for _, test := range tests {
+		height, err := state.GetHeightByTimestamp(test.timestamp)
+		require.ErrorIs(err, test.expectedErr)
+		require.Equal(test.expectedHeight, height)
+	}
+}
+
+func TestStateSupplyHistory(t *testing.T) {
+	require := require.New(t)
+
+	state := newInitializedState(require)
+
+	initialSupply, err := state.GetCurrentSupply(constants.PrimaryNetworkID)
+	require.NoError(err)
+
+	subnetID := ids.GenerateTestID()
+
+	state.SetHeight(1)
+	require.NoError(state.Commit())
+
+	state.SetHeight(2)
+	state.SetCurrentSupply(constants.PrimaryNetworkID, initialSupply+1)
+	state.SetCurrentSupply(subnetID, 100)
+	require.NoError(state.Commit())
+
+	state.SetHeight(3)
+	state.SetCurrentSupply(subnetID, 200)
+	require.NoError(state.Commit())
+
+	tests := []struct {
+		subnetID       ids.ID
+		height         uint64
+		expectedSupply uint64
+		expectedErr    error
+	}{
+		{
+			subnetID:       constants.PrimaryNetworkID,
+			height:         0,
+			expectedSupply: initialSupply,
+		},
+		{
+			subnetID:       constants.PrimaryNetworkID,
+			height:         1,
+			expectedSupply: initialSupply,
+		},
+		{
+			subnetID:       constants.PrimaryNetworkID,
+			height:         2,
+			expectedSupply: initialSupply + 1,
+		},
+		{
+			subnetID:       constants.PrimaryNetworkID,
+			height:         3,
+			expectedSupply: initialSupply + 1,
+		},
+		{
+			subnetID:    constants.PrimaryNetworkID,
+			height:      4,
+			expectedErr: errHeightNotIndexed,
+		},
+		{
+			subnetID:    subnetID,
+			height:      1,
+			expectedErr: database.ErrNotFound,
+		},
+		{
+			subnetID:       subnetID,
+			height:         2,
+			expectedSupply: 100,
+		},
+		{
+			subnetID:       subnetID,
+			height:         3,
+			expectedSupply: 200,
+		},
+	}
+	for _, test := range tests {
+		supply, err := state.GetSupplyAtHeight(test.subnetID, test.height)
+		require.ErrorIs(err, test.expectedErr)
+		require.Equal(test.expectedSupply, supply)
+	}
+}
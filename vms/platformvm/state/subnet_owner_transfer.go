@@ -0,0 +1,202 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow/choices"
+	"github.com/ava-labs/avalanchego/utils"
+	"github.com/ava-labs/avalanchego/utils/logging"
+	"github.com/ava-labs/avalanchego/vms/platformvm/status"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// SubnetOwnerTransfer is an accepted TransferSubnetOwnershipTx.
+type SubnetOwnerTransfer struct {
+	// Height of the block that accepted the transfer
+	Height uint64
+	Tx     *txs.Tx
+}
+
+func subnetOwnerTransferKey(subnetID ids.ID, height uint64, txID ids.ID) []byte {
+	key := make([]byte, ids.IDLen+database.Uint64Size+ids.IDLen)
+	copy(key, subnetID[:])
+	binary.BigEndian.PutUint64(key[ids.IDLen:], height)
+	copy(key[ids.IDLen+database.Uint64Size:], txID[:])
+	return key
+}
+
+func (s *state) GetSubnetOwnerTransfers(subnetID ids.ID) ([]*SubnetOwnerTransfer, error) {
+	it := s.subnetOwnerTransferDB.NewIteratorWithPrefix(subnetID[:])
+	defer it.Release()
+
+	var transfers []*SubnetOwnerTransfer
+	for it.Next() {
+		key := it.Key()
+		height := binary.BigEndian.Uint64(key[ids.IDLen:])
+		txID, err := ids.ToID(key[ids.IDLen+database.Uint64Size:])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse txID: %w", err)
+		}
+
+		tx, _, err := s.GetTx(txID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get subnet owner transfer %s: %w", txID, err)
+		}
+		transfers = append(transfers, &SubnetOwnerTransfer{
+			Height: height,
+			Tx:     tx,
+		})
+	}
+	return transfers, it.Error()
+}
+
+// writeSubnetOwnerTransfer indexes [txID] if it transferred the ownership of
+// a subnet. Must be called while [s.currentHeight] is the height of the block
+// that accepted [txID].
+func (s *state) writeSubnetOwnerTransfer(txID ids.ID, txStatus *txAndStatus) error {
+	if txStatus.status != status.Committed {
+		return nil
+	}
+	transferTx, ok := txStatus.tx.Unsigned.(*txs.TransferSubnetOwnershipTx)
+	if !ok {
+		return nil
+	}
+
+	return s.putSubnetOwnerTransfer(transferTx.Subnet, s.currentHeight, txID)
+}
+
+func (s *state) putSubnetOwnerTransfer(subnetID ids.ID, height uint64, txID ids.ID) error {
+	key := subnetOwnerTransferKey(subnetID, height, txID)
+	if err := s.subnetOwnerTransferDB.Put(key, nil); err != nil {
+		return fmt.Errorf("failed to index subnet owner transfer: %w", err)
+	}
+	return nil
+}
+
+func (s *state) SubnetOwnerTransfersIndexed() bool {
+	return s.subnetOwnerTransfersIndexed
+}
+
+func (s *state) doneIndexSubnetOwnerTransfers() error {
+	if err := s.singletonDB.Put(SubnetOwnerTransfersIndexedKey, nil); err != nil {
+		return fmt.Errorf("failed to mark subnet owner transfers as indexed: %w", err)
+	}
+	s.subnetOwnerTransfersIndexed = true
+	return nil
+}
+
+// IndexSubnetOwnerTransfers scans every accepted block on disk and indexes
+// the TransferSubnetOwnershipTxs it contains. Blocks that are accepted while
+// the scan is running are indexed when they are written. Indexing a transfer
+// twice is a no-op, so if the node stops before the scan finishes it is simply
+// restarted on the next startup.
+func (s *state) IndexSubnetOwnerTransfers(lock sync.Locker, log logging.Logger) error {
+	lock.Lock()
+	if s.subnetOwnerTransfersIndexed {
+		lock.Unlock()
+		return nil
+	}
+	blockIterator := s.blockDB.NewIterator()
+	// Releasing is done using a closure to ensure that updating blockIterator will
+	// result in having the most recent iterator released when executing the
+	// deferred function.
+	defer func() {
+		blockIterator.Release()
+	}()
+	lock.Unlock()
+
+	log.Info("starting subnet owner transfer indexing")
+
+	var (
+		startTime    = time.Now()
+		lastUpdate   = startTime
+		numScanned   = 0
+		numTransfers = 0
+	)
+	for blockIterator.Next() {
+		blk, status, _, err := parseStoredBlock(blockIterator.Value())
+		if err != nil {
+			return err
+		}
+
+		// TransferSubnetOwnershipTxs are only included in standard blocks, so
+		// they were committed if their block was accepted.
+		if status == choices.Accepted {
+			for _, tx := range blk.Txs() {
+				transferTx, ok := tx.Unsigned.(*txs.TransferSubnetOwnershipTx)
+				if !ok {
+					continue
+				}
+				if err := s.putSubnetOwnerTransfer(transferTx.Subnet, blk.Height(), tx.ID()); err != nil {
+					return err
+				}
+				numTransfers++
+			}
+		}
+
+		numScanned++
+		if numScanned%pruneCommitLimit != 0 {
+			continue
+		}
+
+		// We must hold the lock during committing to make sure we don't
+		// attempt to commit to disk while a block is concurrently being
+		// accepted.
+		lock.Lock()
+		err = utils.Err(
+			s.Commit(),
+			blockIterator.Error(),
+		)
+		lock.Unlock()
+		if err != nil {
+			return err
+		}
+
+		// We release the iterator here to allow the underlying database to
+		// clean up deleted state.
+		blkID := blk.ID()
+		blockIterator.Release()
+		blockIterator = s.blockDB.NewIteratorWithStart(blkID[:])
+
+		if now := time.Now(); now.Sub(lastUpdate) > pruneUpdateFrequency {
+			lastUpdate = now
+			log.Info("committing subnet owner transfer indexing",
+				zap.Int("numScanned", numScanned),
+				zap.Int("numTransfers", numTransfers),
+			)
+		}
+	}
+
+	// Ensure we fully iterated over all blocks before marking the transfers as
+	// indexed.
+	if err := blockIterator.Error(); err != nil {
+		return err
+	}
+
+	lock.Lock()
+	defer lock.Unlock()
+
+	if err := s.doneIndexSubnetOwnerTransfers(); err != nil {
+		return err
+	}
+	if err := s.Commit(); err != nil {
+		return err
+	}
+
+	log.Info("finished subnet owner transfer indexing",
+		zap.Int("numScanned", numScanned),
+		zap.Int("numTransfers", numTransfers),
+		zap.Duration("duration", time.Since(startTime)),
+	)
+	return nil
+}
@@ -334,11 +334,32 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		return fmt.Errorf("failed to get next removed staker tx: %w", err)
 	}
 
+	var (
+		rewardTxID     = e.Tx.ID()
+		onCommitRecord = &state.RewardRecord{
+			RewardTxID:      rewardTxID,
+			StakerTxID:      stakerToReward.TxID,
+			NodeID:          stakerToReward.NodeID,
+			SubnetID:        stakerToReward.SubnetID,
+			Timestamp:       uint64(currentChainTime.Unix()),
+			Committed:       true,
+			PotentialReward: stakerToReward.PotentialReward,
+		}
+		onAbortRecord = &state.RewardRecord{
+			RewardTxID:      rewardTxID,
+			StakerTxID:      stakerToReward.TxID,
+			NodeID:          stakerToReward.NodeID,
+			SubnetID:        stakerToReward.SubnetID,
+			Timestamp:       uint64(currentChainTime.Unix()),
+			PotentialReward: stakerToReward.PotentialReward,
+		}
+	)
+
 	// Invariant: A [txs.DelegatorTx] does not also implement the
 	//            [txs.ValidatorTx] interface.
 	switch uStakerTx := stakerTx.Unsigned.(type) {
 	case txs.ValidatorTx:
-		if err := e.rewardValidatorTx(uStakerTx, stakerToReward); err != nil {
+		if err := e.rewardValidatorTx(uStakerTx, stakerToReward, onCommitRecord, onAbortRecord); err != nil {
 			return err
 		}
 
@@ -346,7 +367,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		e.OnCommitState.DeleteCurrentValidator(stakerToReward)
 		e.OnAbortState.DeleteCurrentValidator(stakerToReward)
 	case txs.DelegatorTx:
-		if err := e.rewardDelegatorTx(uStakerTx, stakerToReward); err != nil {
+		onCommitRecord.IsDelegator = true
+		onAbortRecord.IsDelegator = true
+		if err := e.rewardDelegatorTx(uStakerTx, stakerToReward, onCommitRecord); err != nil {
 			return err
 		}
 
@@ -361,6 +384,9 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 		return ErrShouldBePermissionlessStaker
 	}
 
+	e.OnCommitState.AddRewardRecord(onCommitRecord)
+	e.OnAbortState.AddRewardRecord(onAbortRecord)
+
 	// If the reward is aborted, then the current supply should be decreased.
 	currentSupply, err := e.OnAbortState.GetCurrentSupply(stakerToReward.SubnetID)
 	if err != nil {
@@ -374,7 +400,12 @@ func (e *ProposalTxExecutor) RewardValidatorTx(tx *txs.RewardValidatorTx) error
 	return nil
 }
 
-func (e *ProposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, validator *state.Staker) error {
+func (e *ProposalTxExecutor) rewardValidatorTx(
+	uValidatorTx txs.ValidatorTx,
+	validator *state.Staker,
+	onCommitRecord *state.RewardRecord,
+	onAbortRecord *state.RewardRecord,
+) error {
 	var (
 		txID    = validator.TxID
 		stake   = uValidatorTx.Stake()
@@ -423,6 +454,10 @@ func (e *ProposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 		}
 		e.OnCommitState.AddUTXO(utxo)
 		e.OnCommitState.AddRewardUTXO(txID, utxo)
+		onCommitRecord.Payments = append(onCommitRecord.Payments, &state.RewardPayment{
+			Amount: reward,
+			Owner:  validationRewardsOwner,
+		})
 
 		utxosOffset++
 	}
@@ -461,6 +496,12 @@ func (e *ProposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 	e.OnCommitState.AddUTXO(onCommitUtxo)
 	e.OnCommitState.AddRewardUTXO(txID, onCommitUtxo)
 
+	delegateePayment := &state.RewardPayment{
+		Amount: delegateeReward,
+		Owner:  delegationRewardsOwner,
+	}
+	onCommitRecord.Payments = append(onCommitRecord.Payments, delegateePayment)
+
 	// Note: There is no [offset] if the RewardValidatorTx is
 	// aborted, because the validator reward is not awarded.
 	onAbortUtxo := &avax.UTXO{
@@ -473,10 +514,17 @@ func (e *ProposalTxExecutor) rewardValidatorTx(uValidatorTx txs.ValidatorTx, val
 	}
 	e.OnAbortState.AddUTXO(onAbortUtxo)
 	e.OnAbortState.AddRewardUTXO(txID, onAbortUtxo)
+	onAbortRecord.Payments = append(onAbortRecord.Payments, delegateePayment)
 	return nil
 }
 
-func (e *ProposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, delegator *state.Staker) error {
+// Note: If the RewardValidatorTx is aborted, the delegator isn't paid, so only
+// [onCommitRecord] is modified.
+func (e *ProposalTxExecutor) rewardDelegatorTx(
+	uDelegatorTx txs.DelegatorTx,
+	delegator *state.Staker,
+	onCommitRecord *state.RewardRecord,
+) error {
 	var (
 		txID    = delegator.TxID
 		stake   = uDelegatorTx.Stake()
@@ -549,6 +597,10 @@ func (e *ProposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, del
 
 		e.OnCommitState.AddUTXO(utxo)
 		e.OnCommitState.AddRewardUTXO(txID, utxo)
+		onCommitRecord.Payments = append(onCommitRecord.Payments, &state.RewardPayment{
+			Amount: reward,
+			Owner:  rewardsOwner,
+		})
 
 		utxosOffset++
 	}
@@ -605,6 +657,10 @@ func (e *ProposalTxExecutor) rewardDelegatorTx(uDelegatorTx txs.DelegatorTx, del
 
 		e.OnCommitState.AddUTXO(utxo)
 		e.OnCommitState.AddRewardUTXO(txID, utxo)
+		onCommitRecord.Payments = append(onCommitRecord.Payments, &state.RewardPayment{
+			Amount: delegateeReward,
+			Owner:  delegationRewardsOwner,
+		})
 	}
 	return nil
 }
@@ -131,6 +131,19 @@ func TestRewardValidatorTxExecuteOnCommit(t *testing.T) {
 	onCommitBalance, err := avax.GetBalance(env.state, stakeOwners)
 	require.NoError(err)
 	require.Equal(oldBalance+stakerToRemove.Weight+27697, onCommitBalance)
+
+	record, err := env.state.GetRewardRecord(stakerToRemove.TxID)
+	require.NoError(err)
+	require.Equal(tx.ID(), record.RewardTxID)
+	require.Equal(stakerToRemove.NodeID, record.NodeID)
+	require.True(record.Committed)
+	require.False(record.IsDelegator)
+	require.Len(record.Payments, 1)
+	require.Equal(uint64(27697), record.Payments[0].Amount)
+
+	nodeRecords, err := env.state.GetNodeRewardRecords(stakerToRemove.NodeID, 0, 10)
+	require.NoError(err)
+	require.Equal([]*state.RewardRecord{record}, nodeRecords)
 }
 
 func TestRewardValidatorTxExecuteOnAbort(t *testing.T) {
@@ -225,6 +238,11 @@ func TestRewardValidatorTxExecuteOnAbort(t *testing.T) {
 	onAbortBalance, err := avax.GetBalance(env.state, stakeOwners)
 	require.NoError(err)
 	require.Equal(oldBalance+stakerToRemove.Weight, onAbortBalance)
+
+	record, err := env.state.GetRewardRecord(stakerToRemove.TxID)
+	require.NoError(err)
+	require.False(record.Committed)
+	require.Empty(record.Payments)
 }
 
 func TestRewardDelegatorTxExecuteOnCommitPreDelegateeDeferral(t *testing.T) {
@@ -15,8 +15,6 @@ import (
 	"github.com/ava-labs/avalanchego/vms/components/avax"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
-
-	safemath "github.com/ava-labs/avalanchego/utils/math"
 )
 
 var (
@@ -29,7 +27,6 @@ var (
 	ErrFutureStakeTime                 = fmt.Errorf("staker is attempting to start staking more than %s ahead of the current chain time", MaxFutureStartTime)
 	ErrNotValidator                    = errors.New("isn't a current or pending validator")
 	ErrRemovePermissionlessValidator   = errors.New("attempting to remove permissionless validator")
-	ErrStakeOverflow                   = errors.New("validator stake exceeds limit")
 	ErrPeriodMismatch                  = errors.New("proposed staking period is not inside dependant staking period")
 	ErrOverDelegated                   = errors.New("validator would be over delegated")
 	ErrIsNotTransformSubnetTx          = errors.New("is not a transform subnet tx")
@@ -413,14 +410,15 @@ func verifyAddDelegatorTx(
 		)
 	}
 
-	maximumWeight, err := safemath.Mul64(MaxValidatorWeightFactor, primaryNetworkValidator.Weight)
-	if err != nil {
-		return nil, ErrStakeOverflow
-	}
-
+	maxValidatorStake := uint64(math.MaxUint64)
 	if backend.Config.IsApricotPhase3Activated(currentTimestamp) {
-		maximumWeight = min(maximumWeight, backend.Config.MaxValidatorStake)
+		maxValidatorStake = backend.Config.MaxValidatorStake
 	}
+	maximumWeight := maxValidatorWeight(
+		MaxValidatorWeightFactor,
+		maxValidatorStake,
+		primaryNetworkValidator.Weight,
+	)
 
 	if !txs.BoundedBy(
 		startTime,
@@ -665,14 +663,11 @@ func verifyAddPermissionlessDelegatorTx(
 		)
 	}
 
-	maximumWeight, err := safemath.Mul64(
-		uint64(delegatorRules.maxValidatorWeightFactor),
+	maximumWeight := maxValidatorWeight(
+		delegatorRules.maxValidatorWeightFactor,
+		delegatorRules.maxValidatorStake,
 		validator.Weight,
 	)
-	if err != nil {
-		maximumWeight = math.MaxUint64
-	}
-	maximumWeight = min(maximumWeight, delegatorRules.maxValidatorStake)
 
 	if !txs.BoundedBy(
 		startTime,
@@ -10,6 +10,7 @@ import (
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/utils/constants"
 	"github.com/ava-labs/avalanchego/utils/math"
+	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 )
@@ -166,6 +167,43 @@ func overDelegated(
 	return newMaxWeight > weightLimit, nil
 }
 
+// GetMaxValidatorWeight returns the maximum total weight, including the weight
+// of its delegators, that [validator] may have.
+func GetMaxValidatorWeight(
+	cfg *config.Config,
+	chainState state.Chain,
+	validator *state.Staker,
+) (uint64, error) {
+	var (
+		maxValidatorWeightFactor = byte(MaxValidatorWeightFactor)
+		maxValidatorStake        = cfg.MaxValidatorStake
+	)
+	if validator.SubnetID != constants.PrimaryNetworkID {
+		transformSubnet, err := GetTransformSubnetTx(chainState, validator.SubnetID)
+		if err != nil {
+			return 0, err
+		}
+		maxValidatorWeightFactor = transformSubnet.MaxValidatorWeightFactor
+		maxValidatorStake = transformSubnet.MaxValidatorStake
+	}
+
+	return maxValidatorWeight(maxValidatorWeightFactor, maxValidatorStake, validator.Weight), nil
+}
+
+// maxValidatorWeight returns the maximum total weight, including the weight of
+// its delegators, that a validator staking [validatorWeight] may have.
+func maxValidatorWeight(
+	maxValidatorWeightFactor byte,
+	maxValidatorStake uint64,
+	validatorWeight uint64,
+) uint64 {
+	maxWeight, err := math.Mul64(uint64(maxValidatorWeightFactor), validatorWeight)
+	if err != nil {
+		return maxValidatorStake
+	}
+	return min(maxWeight, maxValidatorStake)
+}
+
 // GetMaxWeight returns the maximum total weight of the [validator], including
 // its own weight, between [startTime] and [endTime].
 // The weight changes are applied in the order they will be applied as chain
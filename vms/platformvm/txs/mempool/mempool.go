@@ -10,7 +10,6 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
-	"github.com/ava-labs/avalanchego/cache"
 	"github.com/ava-labs/avalanchego/ids"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
 	"github.com/ava-labs/avalanchego/utils"
@@ -43,6 +42,12 @@ var (
 	ErrCantIssueRewardValidatorTx = errors.New("can not issue a reward validator tx")
 )
 
+// DroppedTx is a tx that was recently dropped from the mempool
+type DroppedTx struct {
+	TxID   ids.ID
+	Reason error
+}
+
 type Mempool interface {
 	Add(tx *txs.Tx) error
 	Get(txID ids.ID) (*txs.Tx, bool)
@@ -67,6 +72,9 @@ type Mempool interface {
 	// possibly reissued.
 	MarkDropped(txID ids.ID, reason error)
 	GetDropReason(txID ids.ID) error
+	// DroppedTxs returns the most recently dropped txs, from the least to the
+	// most recently dropped.
+	DroppedTxs() []DroppedTx
 
 	// Len returns the number of txs in the mempool.
 	Len() int
@@ -79,7 +87,7 @@ type mempool struct {
 	unissuedTxs    linkedhashmap.LinkedHashmap[ids.ID, *txs.Tx]
 	consumedUTXOs  *setmap.SetMap[ids.ID, ids.ID] // TxID -> Consumed UTXOs
 	bytesAvailable int
-	droppedTxIDs   *cache.LRU[ids.ID, error] // TxID -> verification error
+	droppedTxIDs   linkedhashmap.LinkedHashmap[ids.ID, error] // TxID -> verification error
 
 	toEngine chan<- common.Message
 
@@ -96,7 +104,7 @@ func New(
 		unissuedTxs:    linkedhashmap.New[ids.ID, *txs.Tx](),
 		consumedUTXOs:  setmap.New[ids.ID, ids.ID](),
 		bytesAvailable: maxMempoolSize,
-		droppedTxIDs:   &cache.LRU[ids.ID, error]{Size: droppedTxIDsCacheSize},
+		droppedTxIDs:   linkedhashmap.New[ids.ID, error](),
 		toEngine:       toEngine,
 		numTxs: prometheus.NewGauge(prometheus.GaugeOpts{
 			Namespace: namespace,
@@ -168,7 +176,7 @@ func (m *mempool) Add(tx *txs.Tx) error {
 	m.consumedUTXOs.Put(txID, inputs)
 
 	// An explicitly added tx must not be marked as dropped.
-	m.droppedTxIDs.Evict(txID)
+	m.droppedTxIDs.Delete(txID)
 
 	return nil
 }
@@ -224,21 +232,45 @@ func (m *mempool) MarkDropped(txID ids.ID, reason error) {
 		return
 	}
 
-	m.lock.RLock()
-	defer m.lock.RUnlock()
+	m.lock.Lock()
+	defer m.lock.Unlock()
 
 	if _, ok := m.unissuedTxs.Get(txID); ok {
 		return
 	}
 
+	// Re-insert the tx so that it is treated as the most recently dropped
+	m.droppedTxIDs.Delete(txID)
 	m.droppedTxIDs.Put(txID, reason)
+	if m.droppedTxIDs.Len() > droppedTxIDsCacheSize {
+		oldestTxID, _, _ := m.droppedTxIDs.Oldest()
+		m.droppedTxIDs.Delete(oldestTxID)
+	}
 }
 
 func (m *mempool) GetDropReason(txID ids.ID) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
 	err, _ := m.droppedTxIDs.Get(txID)
 	return err
 }
 
+func (m *mempool) DroppedTxs() []DroppedTx {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	droppedTxs := make([]DroppedTx, 0, m.droppedTxIDs.Len())
+	itr := m.droppedTxIDs.NewIterator()
+	for itr.Next() {
+		droppedTxs = append(droppedTxs, DroppedTx{
+			TxID:   itr.Key(),
+			Reason: itr.Value(),
+		})
+	}
+	return droppedTxs
+}
+
 func (m *mempool) RequestBuildBlock(emptyBlockPermitted bool) {
 	if !emptyBlockPermitted && m.unissuedTxs.Len() == 0 {
 		return
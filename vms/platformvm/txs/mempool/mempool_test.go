@@ -4,6 +4,7 @@
 package mempool
 
 import (
+	"errors"
 	"testing"
 	"time"
 
@@ -297,3 +298,37 @@ func TestIterate(t *testing.T) {
 
 	require.Equal(expectedSet, set)
 }
+
+func TestDroppedTxs(t *testing.T) {
+	require := require.New(t)
+
+	registerer := prometheus.NewRegistry()
+	mpool, err := New("mempool", registerer, nil)
+	require.NoError(err)
+
+	require.Empty(mpool.DroppedTxs())
+
+	errTest := errors.New("test")
+	txIDs := make([]ids.ID, droppedTxIDsCacheSize+1)
+	for i := range txIDs {
+		txIDs[i] = ids.GenerateTestID()
+		mpool.MarkDropped(txIDs[i], errTest)
+	}
+
+	// The least recently dropped tx is evicted
+	droppedTxs := mpool.DroppedTxs()
+	require.Len(droppedTxs, droppedTxIDsCacheSize)
+	require.NoError(mpool.GetDropReason(txIDs[0]))
+	for i, droppedTx := range droppedTxs {
+		require.Equal(DroppedTx{
+			TxID:   txIDs[i+1],
+			Reason: errTest,
+		}, droppedTx)
+	}
+
+	// Dropping a tx again marks it as the most recently dropped
+	mpool.MarkDropped(txIDs[1], errTest)
+	droppedTxs = mpool.DroppedTxs()
+	require.Equal(txIDs[2], droppedTxs[0].TxID)
+	require.Equal(txIDs[1], droppedTxs[len(droppedTxs)-1].TxID)
+}
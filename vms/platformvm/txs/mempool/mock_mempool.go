@@ -54,6 +54,20 @@ func (mr *MockMempoolMockRecorder) Add(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockMempool)(nil).Add), arg0)
 }
 
+// DroppedTxs mocks base method.
+func (m *MockMempool) DroppedTxs() []DroppedTx {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DroppedTxs")
+	ret0, _ := ret[0].([]DroppedTx)
+	return ret0
+}
+
+// DroppedTxs indicates an expected call of DroppedTxs.
+func (mr *MockMempoolMockRecorder) DroppedTxs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DroppedTxs", reflect.TypeOf((*MockMempool)(nil).DroppedTxs))
+}
+
 // Get mocks base method.
 func (m *MockMempool) Get(arg0 ids.ID) (*txs.Tx, bool) {
 	m.ctrl.T.Helper()
@@ -19,7 +19,9 @@ import (
 	"github.com/ava-labs/avalanchego/codec"
 	"github.com/ava-labs/avalanchego/codec/linearcodec"
 	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/database/prefixdb"
 	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/pubsub"
 	"github.com/ava-labs/avalanchego/snow"
 	"github.com/ava-labs/avalanchego/snow/consensus/snowman"
 	"github.com/ava-labs/avalanchego/snow/engine/common"
@@ -32,12 +34,14 @@ import (
 	"github.com/ava-labs/avalanchego/utils/timer/mockable"
 	"github.com/ava-labs/avalanchego/version"
 	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/components/index"
 	"github.com/ava-labs/avalanchego/vms/platformvm/block"
 	"github.com/ava-labs/avalanchego/vms/platformvm/config"
 	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
 	"github.com/ava-labs/avalanchego/vms/platformvm/metrics"
 	"github.com/ava-labs/avalanchego/vms/platformvm/network"
 	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/stakeable"
 	"github.com/ava-labs/avalanchego/vms/platformvm/state"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
 	"github.com/ava-labs/avalanchego/vms/platformvm/txs/mempool"
@@ -57,6 +61,8 @@ var (
 	_ secp256k1fx.VM             = (*VM)(nil)
 	_ validators.State           = (*VM)(nil)
 	_ validators.SubnetConnector = (*VM)(nil)
+
+	addressTxsPrefix = []byte("addressTxs")
 )
 
 type VM struct {
@@ -77,6 +83,9 @@ type VM struct {
 	ctx *snow.Context
 	db  database.Database
 
+	// Used to resolve the UTXOs that were created in genesis
+	genesisBytes []byte
+
 	state state.State
 
 	fx            fx.Fx
@@ -88,6 +97,15 @@ type VM struct {
 	txBuilder txbuilder.Builder
 	manager   blockexecutor.Manager
 
+	addressTxsIndexer index.AddressTxsIndexer
+
+	// pubsub serves the /events endpoint. Although the pubsub server is
+	// deprecated, it is what the X-chain serves its /events endpoint with, so
+	// clients subscribe to both chains the same way. The node's other
+	// notification paths, such as the acceptor groups and validator set
+	// callbacks, only notify listeners within the node.
+	pubsub *pubsub.Server
+
 	// Cancelled on shutdown
 	onShutdownCtx context.Context
 	// Call [onShutdownCtxCancel] to cancel [onShutdownCtx] during Shutdown()
@@ -130,7 +148,9 @@ func (vm *VM) Initialize(
 	}
 
 	vm.ctx = chainCtx
+	vm.pubsub = pubsub.New(chainCtx.Log)
 	vm.db = db
+	vm.genesisBytes = genesisBytes
 
 	// Note: this codec is never used to serialize anything
 	vm.codecRegistry = linearcodec.NewDefault(time.Time{})
@@ -188,12 +208,30 @@ func (vm *VM) Initialize(
 		return fmt.Errorf("failed to create mempool: %w", err)
 	}
 
+	// use no op impl when disabled in config
+	addressTxsDB := prefixdb.New(addressTxsPrefix, vm.db)
+	if execConfig.IndexTransactions {
+		chainCtx.Log.Info("address transaction indexing is enabled")
+		vm.addressTxsIndexer, err = index.NewIndexer(addressTxsDB, chainCtx.Log, "", registerer, execConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize address transaction indexer: %w", err)
+		}
+	} else {
+		chainCtx.Log.Info("address transaction indexing is disabled")
+		vm.addressTxsIndexer, err = index.NewNoIndexer(addressTxsDB, execConfig.IndexAllowIncomplete)
+		if err != nil {
+			return fmt.Errorf("failed to initialize disabled indexer: %w", err)
+		}
+	}
+
 	vm.manager = blockexecutor.NewManager(
 		mempool,
 		vm.metrics,
 		vm.state,
 		txExecutorBackend,
 		validatorManager,
+		vm.onAccept,
+		vm.onAcceptBlock,
 	)
 
 	txVerifier := network.NewLockedTxVerifier(&txExecutorBackend.Ctx.Lock, vm.manager)
@@ -248,6 +286,28 @@ func (vm *VM) Initialize(
 	// [periodicallyPruneMempool] grabs the context lock.
 	go vm.periodicallyPruneMempool(execConfig.MempoolPruneFrequency)
 
+	if !vm.state.SubnetOwnerTransfersIndexed() {
+		go func() {
+			err := vm.state.IndexSubnetOwnerTransfers(&vm.ctx.Lock, vm.ctx.Log)
+			if err != nil {
+				vm.ctx.Log.Error("subnet owner transfer indexing failed",
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
+	if !vm.state.ImportedUTXOsIndexed() {
+		go func() {
+			err := vm.state.IndexImportedUTXOs(&vm.ctx.Lock, vm.ctx.Log)
+			if err != nil {
+				vm.ctx.Log.Error("imported UTXO indexing failed",
+					zap.Error(err),
+				)
+			}
+		}()
+	}
+
 	shouldPrune, err := vm.state.ShouldPrune()
 	if err != nil {
 		return fmt.Errorf(
@@ -499,7 +559,8 @@ func (vm *VM) CreateHandlers(context.Context) (map[string]http.Handler, error) {
 	}
 	err := server.RegisterService(service, "platform")
 	return map[string]http.Handler{
-		"": server,
+		"":        server,
+		"/events": vm.pubsub,
 	}, err
 }
 
@@ -554,3 +615,132 @@ func (vm *VM) issueTxFromRPC(tx *txs.Tx) error {
 
 	return nil
 }
+
+// onAccept is called when [tx] is accepted, before its state changes are
+// applied, so that the UTXOs it consumes can still be read from [vm.state].
+func (vm *VM) onAccept(tx *txs.Tx) error {
+	txID := tx.ID()
+	inputUTXOIDs := tx.Unsigned.InputIDs()
+	inputUTXOs := make([]*avax.UTXO, 0, inputUTXOIDs.Len())
+	for utxoID := range inputUTXOIDs {
+		utxo, err := vm.state.GetUTXO(utxoID)
+		if err == database.ErrNotFound {
+			// Imported UTXOs live in shared memory rather than in [vm.state].
+			vm.ctx.Log.Debug("dropping utxo from index",
+				zap.Stringer("txID", txID),
+				zap.Stringer("utxoID", utxoID),
+			)
+			continue
+		}
+		if err != nil {
+			// should never happen because the UTXO was previously verified to
+			// exist
+			return fmt.Errorf("error finding UTXO %s: %w", utxoID, err)
+		}
+		inputUTXOs = append(inputUTXOs, unlockedUTXO(utxo))
+	}
+
+	outputUTXOs := tx.UTXOs()
+	switch utx := tx.Unsigned.(type) {
+	case txs.PermissionlessStaker:
+		outputUTXOs = append(outputUTXOs, stakeUTXOs(txID, utx)...)
+	case *txs.RewardValidatorTx:
+		// The stake is returned to its owners when the staker is removed.
+		// Reward outputs are not indexed here as whether they were paid is
+		// only known once the proposal is decided; they are tracked by the
+		// reward records instead.
+		stakerTx, _, err := vm.state.GetTx(utx.TxID)
+		if err != nil {
+			return fmt.Errorf("failed to get staker tx %s: %w", utx.TxID, err)
+		}
+		if staker, ok := stakerTx.Unsigned.(txs.PermissionlessStaker); ok {
+			outputUTXOs = append(outputUTXOs, stakeUTXOs(utx.TxID, staker)...)
+		}
+	}
+	for i, utxo := range outputUTXOs {
+		outputUTXOs[i] = unlockedUTXO(utxo)
+	}
+
+	if err := vm.addressTxsIndexer.Accept(txID, inputUTXOs, outputUTXOs); err != nil {
+		return fmt.Errorf("error indexing tx: %w", err)
+	}
+	return nil
+}
+
+// stakeUTXOs returns the UTXOs that hold the stake of [staker], which was
+// issued in tx [txID].
+func stakeUTXOs(txID ids.ID, staker txs.PermissionlessStaker) []*avax.UTXO {
+	outputs := staker.Outputs()
+	stake := staker.Stake()
+	utxos := make([]*avax.UTXO, len(stake))
+	for i, out := range stake {
+		utxos[i] = &avax.UTXO{
+			UTXOID: avax.UTXOID{
+				TxID:        txID,
+				OutputIndex: uint32(len(outputs) + i),
+			},
+			Asset: out.Asset,
+			Out:   out.Output(),
+		}
+	}
+	return utxos
+}
+
+// unlockedUTXO strips the lock from [utxo] so that the indexer can read the
+// owners of its output.
+func unlockedUTXO(utxo *avax.UTXO) *avax.UTXO {
+	lockedOut, ok := utxo.Out.(*stakeable.LockOut)
+	if !ok {
+		return utxo
+	}
+	return &avax.UTXO{
+		UTXOID: utxo.UTXOID,
+		Asset:  utxo.Asset,
+		Out:    lockedOut.TransferableOut,
+	}
+}
+
+// onAcceptBlock is called after the state changes of [blk] have been
+// committed. It publishes the events caused by [blk] to subscribers. If
+// [aborted] is true, [blk] is a proposal block whose proposed changes weren't
+// applied.
+func (vm *VM) onAcceptBlock(blk block.Block, aborted bool) {
+	var (
+		blkID  = blk.ID()
+		blkTxs = blk.Txs()
+		txIDs  = make([]ids.ID, len(blkTxs))
+		// Every block is published to subscribers of the primary network.
+		blkKeys   = [][]byte{constants.PrimaryNetworkID[:]}
+		filterers []pubsub.Filterer
+	)
+	for i, tx := range blkTxs {
+		txIDs[i] = tx.ID()
+		filterer, keys, err := vm.newTxEventFilterer(blkID, tx, aborted)
+		if err != nil {
+			vm.ctx.Log.Error("failed to create tx event",
+				zap.Stringer("blkID", blkID),
+				zap.Stringer("txID", txIDs[i]),
+				zap.Error(err),
+			)
+			continue
+		}
+		if filterer != nil {
+			filterers = append(filterers, filterer)
+			blkKeys = append(blkKeys, keys...)
+		}
+	}
+
+	vm.pubsub.Publish(newEventFilterer(
+		&APIBlockEvent{
+			Type:     BlockEventType,
+			BlockID:  blkID,
+			ParentID: blk.Parent(),
+			Height:   json.Uint64(blk.Height()),
+			TxIDs:    txIDs,
+		},
+		blkKeys...,
+	))
+	for _, filterer := range filterers {
+		vm.pubsub.Publish(filterer)
+	}
+}
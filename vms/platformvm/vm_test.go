@@ -1366,6 +1366,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		true,
 		set.Set[ids.ID]{},
 		nil,
+		nil,
 		router.HealthConfig{},
 		"",
 		prometheus.NewRegistry(),
@@ -1450,6 +1451,7 @@ func TestBootstrapPartiallyAccepted(t *testing.T) {
 		vm,
 		subnets.New(ctx.NodeID, subnets.Config{}),
 		tracker.NewPeers(),
+		nil,
 	)
 	require.NoError(err)
 
@@ -19,3 +19,16 @@ func (b JSONByteSlice) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(hexData)
 }
+
+func (b *JSONByteSlice) UnmarshalJSON(jsonBytes []byte) error {
+	var hexData string
+	if err := json.Unmarshal(jsonBytes, &hexData); err != nil {
+		return err
+	}
+	bytes, err := formatting.Decode(formatting.HexNC, hexData)
+	if err != nil {
+		return err
+	}
+	*b = bytes
+	return nil
+}
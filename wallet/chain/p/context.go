@@ -6,43 +6,15 @@ package p
 import (
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/snow"
-	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/wallet/chain/p/builder"
 
 	stdcontext "context"
 )
 
-const Alias = "P"
+const Alias = builder.Alias
 
-var _ Context = (*context)(nil)
-
-type Context interface {
-	NetworkID() uint32
-	AVAXAssetID() ids.ID
-	BaseTxFee() uint64
-	CreateSubnetTxFee() uint64
-	TransformSubnetTxFee() uint64
-	CreateBlockchainTxFee() uint64
-	AddPrimaryNetworkValidatorFee() uint64
-	AddPrimaryNetworkDelegatorFee() uint64
-	AddSubnetValidatorFee() uint64
-	AddSubnetDelegatorFee() uint64
-}
-
-type context struct {
-	networkID                     uint32
-	avaxAssetID                   ids.ID
-	baseTxFee                     uint64
-	createSubnetTxFee             uint64
-	transformSubnetTxFee          uint64
-	createBlockchainTxFee         uint64
-	addPrimaryNetworkValidatorFee uint64
-	addPrimaryNetworkDelegatorFee uint64
-	addSubnetValidatorFee         uint64
-	addSubnetDelegatorFee         uint64
-}
+type Context = builder.Context
 
 func NewContextFromURI(ctx stdcontext.Context, uri string) (Context, error) {
 	infoClient := info.NewClient(uri)
@@ -96,68 +68,16 @@ func NewContext(
 	addSubnetValidatorFee uint64,
 	addSubnetDelegatorFee uint64,
 ) Context {
-	return &context{
-		networkID:                     networkID,
-		avaxAssetID:                   avaxAssetID,
-		baseTxFee:                     baseTxFee,
-		createSubnetTxFee:             createSubnetTxFee,
-		transformSubnetTxFee:          transformSubnetTxFee,
-		createBlockchainTxFee:         createBlockchainTxFee,
-		addPrimaryNetworkValidatorFee: addPrimaryNetworkValidatorFee,
-		addPrimaryNetworkDelegatorFee: addPrimaryNetworkDelegatorFee,
-		addSubnetValidatorFee:         addSubnetValidatorFee,
-		addSubnetDelegatorFee:         addSubnetDelegatorFee,
-	}
-}
-
-func (c *context) NetworkID() uint32 {
-	return c.networkID
-}
-
-func (c *context) AVAXAssetID() ids.ID {
-	return c.avaxAssetID
-}
-
-func (c *context) BaseTxFee() uint64 {
-	return c.baseTxFee
-}
-
-func (c *context) CreateSubnetTxFee() uint64 {
-	return c.createSubnetTxFee
-}
-
-func (c *context) TransformSubnetTxFee() uint64 {
-	return c.transformSubnetTxFee
-}
-
-func (c *context) CreateBlockchainTxFee() uint64 {
-	return c.createBlockchainTxFee
-}
-
-func (c *context) AddPrimaryNetworkValidatorFee() uint64 {
-	return c.addPrimaryNetworkValidatorFee
-}
-
-func (c *context) AddPrimaryNetworkDelegatorFee() uint64 {
-	return c.addPrimaryNetworkDelegatorFee
-}
-
-func (c *context) AddSubnetValidatorFee() uint64 {
-	return c.addSubnetValidatorFee
-}
-
-func (c *context) AddSubnetDelegatorFee() uint64 {
-	return c.addSubnetDelegatorFee
-}
-
-func newSnowContext(c Context) (*snow.Context, error) {
-	lookup := ids.NewAliaser()
-	return &snow.Context{
-		NetworkID:   c.NetworkID(),
-		SubnetID:    constants.PrimaryNetworkID,
-		ChainID:     constants.PlatformChainID,
-		AVAXAssetID: c.AVAXAssetID(),
-		Log:         logging.NoLog{},
-		BCLookup:    lookup,
-	}, lookup.Alias(constants.PlatformChainID, Alias)
+	return builder.NewContext(
+		networkID,
+		avaxAssetID,
+		baseTxFee,
+		createSubnetTxFee,
+		transformSubnetTxFee,
+		createBlockchainTxFee,
+		addPrimaryNetworkValidatorFee,
+		addPrimaryNetworkDelegatorFee,
+		addSubnetValidatorFee,
+		addSubnetDelegatorFee,
+	)
 }
@@ -3,15 +3,7 @@
 
 package x
 
-import (
-	"time"
-
-	"github.com/ava-labs/avalanchego/vms/avm/block"
-	"github.com/ava-labs/avalanchego/vms/avm/fxs"
-	"github.com/ava-labs/avalanchego/vms/nftfx"
-	"github.com/ava-labs/avalanchego/vms/propertyfx"
-	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
-)
+import "github.com/ava-labs/avalanchego/wallet/chain/x/builder"
 
 const (
 	SECP256K1FxIndex = 0
@@ -20,19 +12,4 @@ const (
 )
 
 // Parser to support serialization and deserialization
-var Parser block.Parser
-
-func init() {
-	var err error
-	Parser, err = block.NewParser(
-		time.Time{},
-		[]fxs.Fx{
-			&secp256k1fx.Fx{},
-			&nftfx.Fx{},
-			&propertyfx.Fx{},
-		},
-	)
-	if err != nil {
-		panic(err)
-	}
-}
+var Parser = builder.Parser
@@ -6,33 +6,15 @@ package x
 import (
 	"github.com/ava-labs/avalanchego/api/info"
 	"github.com/ava-labs/avalanchego/ids"
-	"github.com/ava-labs/avalanchego/snow"
-	"github.com/ava-labs/avalanchego/utils/constants"
-	"github.com/ava-labs/avalanchego/utils/logging"
 	"github.com/ava-labs/avalanchego/vms/avm"
+	"github.com/ava-labs/avalanchego/wallet/chain/x/builder"
 
 	stdcontext "context"
 )
 
-const Alias = "X"
+const Alias = builder.Alias
 
-var _ Context = (*context)(nil)
-
-type Context interface {
-	NetworkID() uint32
-	BlockchainID() ids.ID
-	AVAXAssetID() ids.ID
-	BaseTxFee() uint64
-	CreateAssetTxFee() uint64
-}
-
-type context struct {
-	networkID        uint32
-	blockchainID     ids.ID
-	avaxAssetID      ids.ID
-	baseTxFee        uint64
-	createAssetTxFee uint64
-}
+type Context = builder.Context
 
 func NewContextFromURI(ctx stdcontext.Context, uri string) (Context, error) {
 	infoClient := info.NewClient(uri)
@@ -81,45 +63,11 @@ func NewContext(
 	baseTxFee uint64,
 	createAssetTxFee uint64,
 ) Context {
-	return &context{
-		networkID:        networkID,
-		blockchainID:     blockchainID,
-		avaxAssetID:      avaxAssetID,
-		baseTxFee:        baseTxFee,
-		createAssetTxFee: createAssetTxFee,
-	}
-}
-
-func (c *context) NetworkID() uint32 {
-	return c.networkID
-}
-
-func (c *context) BlockchainID() ids.ID {
-	return c.blockchainID
-}
-
-func (c *context) AVAXAssetID() ids.ID {
-	return c.avaxAssetID
-}
-
-func (c *context) BaseTxFee() uint64 {
-	return c.baseTxFee
-}
-
-func (c *context) CreateAssetTxFee() uint64 {
-	return c.createAssetTxFee
-}
-
-func newSnowContext(c Context) (*snow.Context, error) {
-	chainID := c.BlockchainID()
-	lookup := ids.NewAliaser()
-	return &snow.Context{
-		NetworkID:   c.NetworkID(),
-		SubnetID:    constants.PrimaryNetworkID,
-		ChainID:     chainID,
-		XChainID:    chainID,
-		AVAXAssetID: c.AVAXAssetID(),
-		Log:         logging.NoLog{},
-		BCLookup:    lookup,
-	}, lookup.Alias(chainID, Alias)
+	return builder.NewContext(
+		networkID,
+		blockchainID,
+		avaxAssetID,
+		baseTxFee,
+		createAssetTxFee,
+	)
 }
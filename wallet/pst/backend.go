@@ -0,0 +1,173 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/database"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+
+	stdcontext "context"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// Both chains serialize UTXOs and owners with the same codec version
+const codecVersion = ptxs.CodecVersion
+
+var (
+	_ p.SignerBackend   = (*backend)(nil)
+	_ p.SignerBackend   = (*recorder)(nil)
+	_ keychain.Keychain = addressKeychain{}
+	_ keychain.Signer   = addressSigner{}
+
+	errNoSubnetOwners = errors.New("chain doesn't have subnet owners")
+)
+
+// backend provides the UTXOs and subnet owners that were recorded in a
+// partially signed tx
+type backend struct {
+	utxos        map[ids.ID]map[ids.ID]*avax.UTXO // chainID -> utxoID -> UTXO
+	subnetOwners map[ids.ID]fx.Owner
+}
+
+func (t *Tx) backend() (*backend, error) {
+	c := x.Parser.Codec()
+	if t.Chain == PChain {
+		c = ptxs.Codec
+	}
+
+	b := &backend{
+		utxos:        make(map[ids.ID]map[ids.ID]*avax.UTXO),
+		subnetOwners: make(map[ids.ID]fx.Owner, len(t.SubnetOwners)),
+	}
+	for _, recorded := range t.UTXOs {
+		utxo := &avax.UTXO{}
+		if _, err := c.Unmarshal(recorded.UTXO, utxo); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal UTXO: %w", err)
+		}
+		chainUTXOs, ok := b.utxos[recorded.ChainID]
+		if !ok {
+			chainUTXOs = make(map[ids.ID]*avax.UTXO)
+			b.utxos[recorded.ChainID] = chainUTXOs
+		}
+		chainUTXOs[utxo.InputID()] = utxo
+	}
+	for _, recorded := range t.SubnetOwners {
+		var owner fx.Owner
+		if _, err := c.Unmarshal(recorded.Owner, &owner); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal owner of subnet %s: %w", recorded.SubnetID, err)
+		}
+		b.subnetOwners[recorded.SubnetID] = owner
+	}
+	return b, nil
+}
+
+func (b *backend) GetUTXO(_ stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, ok := b.utxos[chainID][utxoID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return utxo, nil
+}
+
+func (b *backend) GetSubnetOwner(_ stdcontext.Context, subnetID ids.ID) (fx.Owner, error) {
+	owner, ok := b.subnetOwners[subnetID]
+	if !ok {
+		return nil, database.ErrNotFound
+	}
+	return owner, nil
+}
+
+// recorder records the UTXOs and subnet owners that are fetched from the
+// wrapped backends
+type recorder struct {
+	utxos  x.SignerBackend
+	owners p.SignerBackend // nil if the chain doesn't have subnets
+	codec  codec.Manager
+
+	recordedUTXOIDs set.Set[ids.ID]
+	recordedUTXOs   []UTXO
+	recordedOwners  []SubnetOwner
+}
+
+func (r *recorder) GetUTXO(ctx stdcontext.Context, chainID, utxoID ids.ID) (*avax.UTXO, error) {
+	utxo, err := r.utxos.GetUTXO(ctx, chainID, utxoID)
+	if err != nil || r.recordedUTXOIDs.Contains(utxoID) {
+		return utxo, err
+	}
+
+	utxoBytes, err := r.codec.Marshal(codecVersion, utxo)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal UTXO %s: %w", utxoID, err)
+	}
+	r.recordedUTXOIDs.Add(utxoID)
+	r.recordedUTXOs = append(r.recordedUTXOs, UTXO{
+		ChainID: chainID,
+		UTXO:    utxoBytes,
+	})
+	return utxo, nil
+}
+
+func (r *recorder) GetSubnetOwner(ctx stdcontext.Context, subnetID ids.ID) (fx.Owner, error) {
+	if r.owners == nil {
+		return nil, errNoSubnetOwners
+	}
+	owner, err := r.owners.GetSubnetOwner(ctx, subnetID)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerBytes, err := r.codec.Marshal(codecVersion, &owner)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't marshal owner of subnet %s: %w", subnetID, err)
+	}
+	r.recordedOwners = append(r.recordedOwners, SubnetOwner{
+		SubnetID: subnetID,
+		Owner:    ownerBytes,
+	})
+	return owner, nil
+}
+
+// addressKeychain has a signer for every address. The signatures of each
+// signer hold the address of the signer, which allows the signers that a tx
+// requires to be found by signing it.
+type addressKeychain struct{}
+
+func (addressKeychain) Get(addr ids.ShortID) (keychain.Signer, bool) {
+	return addressSigner{addr: addr}, true
+}
+
+func (addressKeychain) Addresses() set.Set[ids.ShortID] {
+	return nil
+}
+
+type addressSigner struct {
+	addr ids.ShortID
+}
+
+func (s addressSigner) SignHash([]byte) ([]byte, error) {
+	sig := make([]byte, secp256k1.SignatureLen)
+	copy(sig, s.addr[:])
+	// Ensure the signature isn't empty, even for the empty address
+	sig[secp256k1.SignatureLen-1] = 1
+	return sig, nil
+}
+
+func (s addressSigner) Sign([]byte) ([]byte, error) {
+	return s.SignHash(nil)
+}
+
+func (s addressSigner) Address() ids.ShortID {
+	return s.addr
+}
@@ -0,0 +1,125 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"errors"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/vms/nftfx"
+	"github.com/ava-labs/avalanchego/vms/propertyfx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+
+	stdcontext "context"
+	xtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+var (
+	_ signedTx = (*pTx)(nil)
+	_ signedTx = (*xTx)(nil)
+
+	errUnknownCredentialType = errors.New("unknown credential type")
+)
+
+// signedTx is a tx of one of the chains that partially signed txs support
+type signedTx interface {
+	signed() interface{}
+	unsigned() interface{}
+	unsignedBytes() []byte
+	// credentials returns the credentials of the tx. Modifying the returned
+	// credentials modifies the tx.
+	credentials() ([]*secp256k1fx.Credential, error)
+	sign(ctx stdcontext.Context, kc keychain.Keychain, backend p.SignerBackend) error
+	marshal() ([]byte, error)
+}
+
+type pTx struct {
+	tx *ptxs.Tx
+}
+
+func parsePTx(txBytes []byte) (*pTx, error) {
+	tx, err := ptxs.Parse(ptxs.Codec, txBytes)
+	return &pTx{tx: tx}, err
+}
+
+func (t *pTx) signed() interface{} {
+	return t.tx
+}
+
+func (t *pTx) unsigned() interface{} {
+	return t.tx.Unsigned
+}
+
+func (t *pTx) unsignedBytes() []byte {
+	return t.tx.Unsigned.Bytes()
+}
+
+func (t *pTx) credentials() ([]*secp256k1fx.Credential, error) {
+	creds := make([]*secp256k1fx.Credential, len(t.tx.Creds))
+	for i, credIntf := range t.tx.Creds {
+		cred, ok := credIntf.(*secp256k1fx.Credential)
+		if !ok {
+			return nil, errUnknownCredentialType
+		}
+		creds[i] = cred
+	}
+	return creds, nil
+}
+
+func (t *pTx) sign(ctx stdcontext.Context, kc keychain.Keychain, backend p.SignerBackend) error {
+	return p.NewSigner(kc, backend).Sign(ctx, t.tx)
+}
+
+func (t *pTx) marshal() ([]byte, error) {
+	return ptxs.Codec.Marshal(ptxs.CodecVersion, t.tx)
+}
+
+type xTx struct {
+	tx *xtxs.Tx
+}
+
+func parseXTx(txBytes []byte) (*xTx, error) {
+	tx, err := x.Parser.ParseTx(txBytes)
+	return &xTx{tx: tx}, err
+}
+
+func (t *xTx) signed() interface{} {
+	return t.tx
+}
+
+func (t *xTx) unsigned() interface{} {
+	return t.tx.Unsigned
+}
+
+func (t *xTx) unsignedBytes() []byte {
+	return t.tx.Unsigned.Bytes()
+}
+
+func (t *xTx) credentials() ([]*secp256k1fx.Credential, error) {
+	creds := make([]*secp256k1fx.Credential, len(t.tx.Creds))
+	for i, fxCred := range t.tx.Creds {
+		switch cred := fxCred.Credential.(type) {
+		case *secp256k1fx.Credential:
+			creds[i] = cred
+		case *nftfx.Credential:
+			creds[i] = &cred.Credential
+		case *propertyfx.Credential:
+			creds[i] = &cred.Credential
+		default:
+			return nil, errUnknownCredentialType
+		}
+	}
+	return creds, nil
+}
+
+func (t *xTx) sign(ctx stdcontext.Context, kc keychain.Keychain, backend p.SignerBackend) error {
+	return x.NewSigner(kc, backend).Sign(ctx, t.tx)
+}
+
+func (t *xTx) marshal() ([]byte, error) {
+	return x.Parser.Codec().Marshal(xtxs.CodecVersion, t.tx)
+}
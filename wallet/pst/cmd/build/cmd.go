@@ -0,0 +1,25 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package build
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/delegate"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/send"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/validate"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "build",
+		Short: "Builds and signs transactions offline from an exported context",
+	}
+	c.AddCommand(
+		send.Command(),
+		delegate.Command(),
+		validate.Command(),
+	)
+	return c
+}
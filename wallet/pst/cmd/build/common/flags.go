@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/keys"
+)
+
+const (
+	ContextKey   = "context"
+	OutputKey    = "output"
+	PSTOutputKey = "pst-output"
+
+	NodeIDKey         = "node-id"
+	StartTimeKey      = "start-time"
+	EndTimeKey        = "end-time"
+	AmountKey         = "amount"
+	RewardsAddressKey = "rewards-address"
+)
+
+func AddFlags(flags *pflag.FlagSet) {
+	keys.AddFlags(flags)
+	flags.String(ContextKey, "context.json", "Context exported by export-context to build the transaction with")
+	flags.String(OutputKey, "tx.hex", "File to write the signed transaction to")
+	flags.String(PSTOutputKey, "tx.pst", "File to write the partially signed transaction to if signatures are missing")
+}
+
+type Config struct {
+	Context       *pst.Context
+	Keys          *keys.Config
+	OutputPath    string
+	PSTOutputPath string
+}
+
+// ParseFlags returns the config of [flags]. The flags must have already been
+// parsed.
+func ParseFlags(flags *pflag.FlagSet) (*Config, error) {
+	contextPath, err := flags.GetString(ContextKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := pst.ReadContextFile(contextPath)
+	if err != nil {
+		return nil, err
+	}
+
+	outputPath, err := flags.GetString(OutputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pstOutputPath, err := flags.GetString(PSTOutputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	keysConfig, err := keys.ParseFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Context:       ctx,
+		Keys:          keysConfig,
+		OutputPath:    outputPath,
+		PSTOutputPath: pstOutputPath,
+	}, nil
+}
+
+func AddStakingFlags(flags *pflag.FlagSet) {
+	flags.String(NodeIDKey, "", "Node to stake on")
+	flags.String(StartTimeKey, "", "Time the staking period starts, in RFC3339 format")
+	flags.String(EndTimeKey, "", "Time the staking period ends, in RFC3339 format")
+	flags.Uint64(AmountKey, 0, "Amount of nAVAX to stake")
+	flags.String(RewardsAddressKey, "", "Address to send the staking rewards to")
+}
+
+type StakingConfig struct {
+	NodeID       ids.NodeID
+	StartTime    time.Time
+	EndTime      time.Time
+	Amount       uint64
+	RewardsOwner *secp256k1fx.OutputOwners
+}
+
+// ParseStakingFlags returns the staking config of [flags]. The flags must have
+// already been parsed.
+func ParseStakingFlags(flags *pflag.FlagSet) (*StakingConfig, error) {
+	nodeIDStr, err := flags.GetString(NodeIDKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeID, err := ids.NodeIDFromString(nodeIDStr)
+	if err != nil {
+		return nil, err
+	}
+
+	startTime, err := getTime(flags, StartTimeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, err := getTime(flags, EndTimeKey)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := flags.GetUint64(AmountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rewardsOwner, err := GetOwner(flags, RewardsAddressKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StakingConfig{
+		NodeID:       nodeID,
+		StartTime:    startTime,
+		EndTime:      endTime,
+		Amount:       amount,
+		RewardsOwner: rewardsOwner,
+	}, nil
+}
+
+// GetOwner returns the owner of the address of flag [key]
+func GetOwner(flags *pflag.FlagSet, key string) (*secp256k1fx.OutputOwners, error) {
+	addrStr, err := flags.GetString(key)
+	if err != nil {
+		return nil, err
+	}
+
+	addr, err := address.ParseToID(addrStr)
+	if err != nil {
+		return nil, err
+	}
+	return &secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{addr},
+	}, nil
+}
+
+func getTime(flags *pflag.FlagSet, key string) (time.Time, error) {
+	timeStr, err := flags.GetString(key)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
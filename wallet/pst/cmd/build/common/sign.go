@@ -0,0 +1,47 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/utils/perms"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+// Sign signs [tx] with the configured keychain. If [tx] is then fully signed,
+// it's written to the output in the format expected by issueTx. Otherwise, the
+// partially signed tx is written so that the remaining signatures can be
+// collected.
+func Sign(ctx context.Context, config *Config, tx *pst.Tx) error {
+	if err := tx.Sign(ctx, config.Keys.Keychain); err != nil {
+		return err
+	}
+
+	txBytes, err := tx.Finalize()
+	if errors.Is(err, pst.ErrMissingSignature) {
+		if err := tx.WriteFile(config.PSTOutputPath); err != nil {
+			return err
+		}
+		log.Printf("signatures are missing, wrote %s to collect them: %s\n", config.PSTOutputPath, err)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(config.OutputPath, []byte(txStr), perms.ReadWrite); err != nil {
+		return err
+	}
+	log.Printf("wrote signed tx to %s\n", config.OutputPath)
+	return nil
+}
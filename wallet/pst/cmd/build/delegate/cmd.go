@@ -0,0 +1,74 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package delegate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/common"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "delegate",
+		Short: "Builds and signs a delegation to a primary network validator",
+		RunE:  delegateFunc,
+	}
+	flags := c.Flags()
+	common.AddFlags(flags)
+	common.AddStakingFlags(flags)
+	return c
+}
+
+func delegateFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	staking, err := common.ParseStakingFlags(flags)
+	if err != nil {
+		return err
+	}
+
+	config, err := common.ParseFlags(flags)
+	if err != nil {
+		return err
+	}
+	defer config.Keys.Close()
+
+	ctx := c.Context()
+	backend, err := config.Context.PBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	builder := p.NewBuilder(config.Context.AddressSet(), backend)
+	utx, err := builder.NewAddPermissionlessDelegatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: staking.NodeID,
+				Start:  uint64(staking.StartTime.Unix()),
+				End:    uint64(staking.EndTime.Unix()),
+				Wght:   staking.Amount,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		config.Context.AVAXAssetID,
+		staking.RewardsOwner,
+	)
+	if err != nil {
+		return err
+	}
+
+	tx, err := pst.NewP(ctx, utx, backend)
+	if err != nil {
+		return err
+	}
+	return common.Sign(ctx, config, tx)
+}
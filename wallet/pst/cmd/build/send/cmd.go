@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package send
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/common"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "send",
+		Short: "Builds and signs an X-chain transfer",
+		RunE:  sendFunc,
+	}
+	flags := c.Flags()
+	AddFlags(flags)
+	return c
+}
+
+func sendFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	config, err := ParseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	defer config.Keys.Close()
+
+	ctx := c.Context()
+	backend, err := config.Context.XBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	assetID := config.AssetID
+	if assetID == ids.Empty {
+		assetID = config.Context.AVAXAssetID
+	}
+
+	builder := x.NewBuilder(config.Context.AddressSet(), backend)
+	utx, err := builder.NewBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: assetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          config.Amount,
+			OutputOwners: *config.To,
+		},
+	}})
+	if err != nil {
+		return err
+	}
+
+	tx, err := pst.NewX(ctx, utx, backend)
+	if err != nil {
+		return err
+	}
+	return common.Sign(ctx, config.Config, tx)
+}
@@ -0,0 +1,73 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package send
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/common"
+)
+
+const (
+	ToKey      = "to"
+	AmountKey  = "amount"
+	AssetIDKey = "asset-id"
+)
+
+func AddFlags(flags *pflag.FlagSet) {
+	common.AddFlags(flags)
+	flags.String(ToKey, "", "Address to send to")
+	flags.Uint64(AmountKey, 0, "Amount to send")
+	flags.String(AssetIDKey, "AVAX", "Asset to send")
+}
+
+type Config struct {
+	*common.Config
+	To      *secp256k1fx.OutputOwners
+	Amount  uint64
+	AssetID ids.ID // Empty if AVAX is sent
+}
+
+func ParseFlags(flags *pflag.FlagSet, args []string) (*Config, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	to, err := common.GetOwner(flags, ToKey)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := flags.GetUint64(AmountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var assetID ids.ID
+	if flags.Changed(AssetIDKey) {
+		assetIDStr, err := flags.GetString(AssetIDKey)
+		if err != nil {
+			return nil, err
+		}
+
+		assetID, err = ids.FromString(assetIDStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := common.ParseFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Config:  config,
+		To:      to,
+		Amount:  amount,
+		AssetID: assetID,
+	}, nil
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/vms/platformvm/txs"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/common"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "validate",
+		Short: "Builds and signs a primary network validator registration",
+		RunE:  validateFunc,
+	}
+	flags := c.Flags()
+	AddFlags(flags)
+	return c
+}
+
+func validateFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	config, err := ParseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	defer config.Keys.Close()
+
+	ctx := c.Context()
+	backend, err := config.Context.PBackend(ctx)
+	if err != nil {
+		return err
+	}
+
+	builder := p.NewBuilder(config.Context.AddressSet(), backend)
+	utx, err := builder.NewAddPermissionlessValidatorTx(
+		&txs.SubnetValidator{
+			Validator: txs.Validator{
+				NodeID: config.Staking.NodeID,
+				Start:  uint64(config.Staking.StartTime.Unix()),
+				End:    uint64(config.Staking.EndTime.Unix()),
+				Wght:   config.Staking.Amount,
+			},
+			Subnet: constants.PrimaryNetworkID,
+		},
+		config.ProofOfPossession,
+		config.Context.AVAXAssetID,
+		config.Staking.RewardsOwner,
+		config.DelegationRewardsOwner,
+		config.DelegationFee,
+	)
+	if err != nil {
+		return err
+	}
+
+	tx, err := pst.NewP(ctx, utx, backend)
+	if err != nil {
+		return err
+	}
+	return common.Sign(ctx, config.Config, tx)
+}
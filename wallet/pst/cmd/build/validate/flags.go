@@ -0,0 +1,109 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package validate
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/vms/platformvm/reward"
+	"github.com/ava-labs/avalanchego/vms/platformvm/signer"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build/common"
+)
+
+const (
+	BLSPublicKeyKey             = "bls-public-key"
+	BLSProofOfPossessionKey     = "bls-proof-of-possession"
+	DelegationFeeKey            = "delegation-fee"
+	DelegationRewardsAddressKey = "delegation-rewards-address"
+)
+
+var errInvalidDelegationFee = errors.New("delegation fee must be at most 1,000,000")
+
+func AddFlags(flags *pflag.FlagSet) {
+	common.AddFlags(flags)
+	common.AddStakingFlags(flags)
+	flags.String(BLSPublicKeyKey, "", "BLS public key of the node, as returned by info.getNodeID")
+	flags.String(BLSProofOfPossessionKey, "", "BLS proof of possession of the node, as returned by info.getNodeID")
+	flags.Uint32(DelegationFeeKey, 20_000, "Fraction, out of 1,000,000, of delegation rewards to take as a fee")
+	flags.String(DelegationRewardsAddressKey, "[rewards-address]", "Address to send the delegation fees to")
+}
+
+type Config struct {
+	*common.Config
+	Staking                *common.StakingConfig
+	ProofOfPossession      *signer.ProofOfPossession
+	DelegationFee          uint32
+	DelegationRewardsOwner *secp256k1fx.OutputOwners
+}
+
+func ParseFlags(flags *pflag.FlagSet, args []string) (*Config, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	staking, err := common.ParseStakingFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := flags.GetString(BLSPublicKeyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	proofOfPossession, err := flags.GetString(BLSProofOfPossessionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the proof of possession from its JSON form, in which info.getNodeID
+	// returns it
+	popJSON, err := json.Marshal(map[string]string{
+		"publicKey":         publicKey,
+		"proofOfPossession": proofOfPossession,
+	})
+	if err != nil {
+		return nil, err
+	}
+	pop := &signer.ProofOfPossession{}
+	if err := pop.UnmarshalJSON(popJSON); err != nil {
+		return nil, err
+	}
+	if err := pop.Verify(); err != nil {
+		return nil, err
+	}
+
+	delegationFee, err := flags.GetUint32(DelegationFeeKey)
+	if err != nil {
+		return nil, err
+	}
+	if delegationFee > reward.PercentDenominator {
+		return nil, errInvalidDelegationFee
+	}
+
+	delegationRewardsOwner := staking.RewardsOwner
+	if flags.Changed(DelegationRewardsAddressKey) {
+		delegationRewardsOwner, err = common.GetOwner(flags, DelegationRewardsAddressKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	config, err := common.ParseFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Config:                 config,
+		Staking:                staking,
+		ProofOfPossession:      pop,
+		DelegationFee:          delegationFee,
+		DelegationRewardsOwner: delegationRewardsOwner,
+	}, nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package decode
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+const (
+	ChainKey     = "chain"
+	NetworkIDKey = "network-id"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "decode [signed tx file]",
+		Short: "Prints a signed transaction in a human readable form for review",
+		Args:  cobra.ExactArgs(1),
+		RunE:  decodeFunc,
+	}
+	flags := c.Flags()
+	flags.String(ChainKey, string(pst.PChain), "Chain the transaction is issued on")
+	flags.Uint32(NetworkIDKey, constants.MainnetID, "Network to format addresses for")
+	return c
+}
+
+func decodeFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	chain, err := flags.GetString(ChainKey)
+	if err != nil {
+		return err
+	}
+
+	networkID, err := flags.GetUint32(NetworkIDKey)
+	if err != nil {
+		return err
+	}
+
+	txStr, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := formatting.Decode(formatting.Hex, strings.TrimSpace(string(txStr)))
+	if err != nil {
+		return err
+	}
+
+	decodedTx, err := pst.Decode(pst.Chain(chain), networkID, txBytes)
+	if err != nil {
+		return err
+	}
+
+	decodedTxJSON, err := json.MarshalIndent(decodedTx, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(decodedTxJSON))
+	return nil
+}
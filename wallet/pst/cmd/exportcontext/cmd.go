@@ -0,0 +1,41 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package exportcontext
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export-context",
+		Short: "Exports the UTXOs and fees needed to build transactions offline",
+		RunE:  exportContextFunc,
+	}
+	flags := c.Flags()
+	AddFlags(flags)
+	return c
+}
+
+func exportContextFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	config, err := ParseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	ctx, err := pst.FetchContext(c.Context(), config.URI, config.Addresses, config.PChainTxIDs)
+	if err != nil {
+		return err
+	}
+	if err := ctx.WriteFile(config.OutputPath); err != nil {
+		return err
+	}
+	log.Printf("wrote %s with %d UTXOs\n", config.OutputPath, len(ctx.UTXOs))
+	return nil
+}
@@ -0,0 +1,87 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package exportcontext
+
+import (
+	"errors"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+)
+
+const (
+	URIKey         = "uri"
+	AddressesKey   = "addresses"
+	PChainTxIDsKey = "p-chain-tx-ids"
+	OutputKey      = "output"
+)
+
+var errNoAddresses = errors.New("no addresses provided")
+
+func AddFlags(flags *pflag.FlagSet) {
+	flags.String(URIKey, primary.LocalAPIURI, "API URI to fetch the context from")
+	flags.StringSlice(AddressesKey, nil, "Addresses whose UTXOs can be spent by the transactions")
+	flags.StringSlice(PChainTxIDsKey, nil, "P-chain transactions, such as the CreateSubnetTx of a subnet, that the transactions depend on")
+	flags.String(OutputKey, "context.json", "File to write the context to")
+}
+
+type Config struct {
+	URI         string
+	Addresses   set.Set[ids.ShortID]
+	PChainTxIDs []ids.ID
+	OutputPath  string
+}
+
+func ParseFlags(flags *pflag.FlagSet, args []string) (*Config, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	uri, err := flags.GetString(URIKey)
+	if err != nil {
+		return nil, err
+	}
+
+	addrStrs, err := flags.GetStringSlice(AddressesKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrStrs) == 0 {
+		return nil, errNoAddresses
+	}
+
+	addrs, err := address.ParseToIDs(addrStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	txIDStrs, err := flags.GetStringSlice(PChainTxIDsKey)
+	if err != nil {
+		return nil, err
+	}
+
+	txIDs := make([]ids.ID, len(txIDStrs))
+	for i, txIDStr := range txIDStrs {
+		txIDs[i], err = ids.FromString(txIDStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	outputPath, err := flags.GetString(OutputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		URI:         uri,
+		Addresses:   set.Of(addrs...),
+		PChainTxIDs: txIDs,
+		OutputPath:  outputPath,
+	}, nil
+}
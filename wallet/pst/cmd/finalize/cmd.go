@@ -0,0 +1,39 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package finalize
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/utils/formatting"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+func Command() *cobra.Command {
+	return &cobra.Command{
+		Use:   "finalize [pst file]",
+		Short: "Verifies that a transaction is fully signed and prints it in the format expected by issueTx",
+		Args:  cobra.ExactArgs(1),
+		RunE:  finalizeFunc,
+	}
+}
+
+func finalizeFunc(_ *cobra.Command, args []string) error {
+	tx, err := pst.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	txBytes, err := tx.Finalize()
+	if err != nil {
+		return err
+	}
+	txStr, err := formatting.Encode(formatting.Hex, txBytes)
+	if err != nil {
+		return err
+	}
+	fmt.Println(txStr)
+	return nil
+}
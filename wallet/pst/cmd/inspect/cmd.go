@@ -0,0 +1,92 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package inspect
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/formatting/address"
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+const NetworkIDKey = "network-id"
+
+type signature struct {
+	Credential int    `json:"credential"`
+	Index      int    `json:"index"`
+	Signer     string `json:"signer"`
+	Signed     bool   `json:"signed"`
+}
+
+type inspection struct {
+	Chain      pst.Chain   `json:"chain"`
+	UnsignedTx interface{} `json:"unsignedTx"`
+	Signatures []signature `json:"signatures"`
+	Complete   bool        `json:"complete"`
+}
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "inspect [pst file]",
+		Short: "Prints a partially signed transaction and the signatures it's missing",
+		Args:  cobra.ExactArgs(1),
+		RunE:  inspectFunc,
+	}
+	flags := c.Flags()
+	flags.Uint32(NetworkIDKey, constants.MainnetID, "Network to format addresses for")
+	return c
+}
+
+func inspectFunc(c *cobra.Command, args []string) error {
+	networkID, err := c.Flags().GetUint32(NetworkIDKey)
+	if err != nil {
+		return err
+	}
+
+	tx, err := pst.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	utx, err := tx.Unsigned(networkID)
+	if err != nil {
+		return err
+	}
+	sigs, err := tx.Signatures()
+	if err != nil {
+		return err
+	}
+
+	chainAlias := string(tx.Chain)
+	hrp := constants.GetHRP(networkID)
+	i := inspection{
+		Chain:      tx.Chain,
+		UnsignedTx: utx,
+		Signatures: make([]signature, len(sigs)),
+		Complete:   true,
+	}
+	for j, sig := range sigs {
+		signer, err := address.Format(chainAlias, hrp, sig.Signer[:])
+		if err != nil {
+			return err
+		}
+		i.Signatures[j] = signature{
+			Credential: sig.Credential,
+			Index:      sig.Index,
+			Signer:     signer,
+			Signed:     sig.Signed,
+		}
+		i.Complete = i.Complete && sig.Signed
+	}
+
+	inspectionJSON, err := json.MarshalIndent(i, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(inspectionJSON))
+	return nil
+}
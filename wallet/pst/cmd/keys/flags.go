@@ -0,0 +1,130 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package keys
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/ledger"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+)
+
+const (
+	KeyFileKey         = "key-file"
+	LedgerKey          = "ledger"
+	LedgerAddressesKey = "ledger-addresses"
+)
+
+var (
+	errNoKeys        = errors.New("key file doesn't contain any keys")
+	errNoKeychain    = fmt.Errorf("either --%s or --%s must be provided", KeyFileKey, LedgerKey)
+	errManyKeychains = fmt.Errorf("only one of --%s and --%s can be provided", KeyFileKey, LedgerKey)
+)
+
+func AddFlags(flags *pflag.FlagSet) {
+	flags.String(KeyFileKey, "", "File with one private key per line to sign with")
+	flags.Bool(LedgerKey, false, "Sign with the connected Ledger")
+	flags.Int(LedgerAddressesKey, 1, "Number of Ledger addresses to sign with")
+}
+
+type Config struct {
+	Keychain keychain.Keychain
+	// Ledger is nil unless the keychain is backed by a Ledger, in which case
+	// it must be disconnected once signing is done.
+	Ledger keychain.Ledger
+}
+
+// ParseFlags returns the keychain specified by [flags]. The flags must have
+// already been parsed.
+func ParseFlags(flags *pflag.FlagSet) (*Config, error) {
+	keyFile, err := flags.GetString(KeyFileKey)
+	if err != nil {
+		return nil, err
+	}
+
+	useLedger, err := flags.GetBool(LedgerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case keyFile != "" && useLedger:
+		return nil, errManyKeychains
+	case keyFile != "":
+		kc, err := readKeyFile(keyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &Config{Keychain: kc}, nil
+	case useLedger:
+		numAddrs, err := flags.GetInt(LedgerAddressesKey)
+		if err != nil {
+			return nil, err
+		}
+
+		device, err := ledger.New()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't connect to Ledger: %w", err)
+		}
+		kc, err := keychain.NewLedgerKeychain(device, numAddrs)
+		if err != nil {
+			_ = device.Disconnect()
+			return nil, err
+		}
+		return &Config{
+			Keychain: kc,
+			Ledger:   device,
+		}, nil
+	default:
+		return nil, errNoKeychain
+	}
+}
+
+// Close disconnects from the Ledger, if one is used
+func (c *Config) Close() error {
+	if c.Ledger == nil {
+		return nil
+	}
+	return c.Ledger.Disconnect()
+}
+
+// readKeyFile returns a keychain with the private keys in [path]. Empty lines
+// and lines that start with "#" are ignored.
+func readKeyFile(path string) (*secp256k1fx.Keychain, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	kc := secp256k1fx.NewKeychain()
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var sk secp256k1.PrivateKey
+		if err := sk.UnmarshalText([]byte(`"` + line + `"`)); err != nil {
+			return nil, fmt.Errorf("couldn't parse private key: %w", err)
+		}
+		kc.Add(&sk)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if kc.Addresses().Len() == 0 {
+		return nil, errNoKeys
+	}
+	return kc, nil
+}
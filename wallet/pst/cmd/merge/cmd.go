@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package merge
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+const OutputKey = "output"
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "merge [pst file] [pst files to merge]...",
+		Short: "Merges the signatures of partially signed transactions",
+		Args:  cobra.MinimumNArgs(2),
+		RunE:  mergeFunc,
+	}
+	flags := c.Flags()
+	flags.String(OutputKey, "[pst file]", "File to write the merged transaction to")
+	return c
+}
+
+func mergeFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	outputPath := args[0]
+	if flags.Changed(OutputKey) {
+		var err error
+		outputPath, err = flags.GetString(OutputKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	tx, err := pst.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	for _, path := range args[1:] {
+		other, err := pst.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		if err := tx.Merge(other); err != nil {
+			return err
+		}
+	}
+	if err := tx.WriteFile(outputPath); err != nil {
+		return err
+	}
+	log.Printf("wrote %s\n", outputPath)
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/build"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/decode"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/exportcontext"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/finalize"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/inspect"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/merge"
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/sign"
+)
+
+func init() {
+	cobra.EnablePrefixMatching = true
+}
+
+func main() {
+	cmd := &cobra.Command{
+		Use:   "pst",
+		Short: "Builds, signs, and reviews transactions offline",
+	}
+	cmd.AddCommand(
+		exportcontext.Command(),
+		build.Command(),
+		decode.Command(),
+		sign.Command(),
+		merge.Command(),
+		inspect.Command(),
+		finalize.Command(),
+	)
+	ctx := context.Background()
+	if err := cmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintf(os.Stderr, "command failed %v\n", err)
+		os.Exit(1)
+	}
+}
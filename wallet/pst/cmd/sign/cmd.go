@@ -0,0 +1,57 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sign
+
+import (
+	"log"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ava-labs/avalanchego/wallet/pst"
+)
+
+func Command() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sign [pst file]",
+		Short: "Adds the signatures of the provided keys to a partially signed transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE:  signFunc,
+	}
+	flags := c.Flags()
+	AddFlags(flags)
+	return c
+}
+
+func signFunc(c *cobra.Command, args []string) error {
+	flags := c.Flags()
+	config, err := ParseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	defer config.Keys.Close()
+
+	tx, err := pst.ReadFile(config.Path)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(c.Context(), config.Keys.Keychain); err != nil {
+		return err
+	}
+	if err := tx.WriteFile(config.OutputPath); err != nil {
+		return err
+	}
+
+	sigs, err := tx.Signatures()
+	if err != nil {
+		return err
+	}
+	var numSigned int
+	for _, sig := range sigs {
+		if sig.Signed {
+			numSigned++
+		}
+	}
+	log.Printf("wrote %s with %d of %d signatures\n", config.OutputPath, numSigned, len(sigs))
+	return nil
+}
@@ -0,0 +1,50 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package sign
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/ava-labs/avalanchego/wallet/pst/cmd/keys"
+)
+
+const OutputKey = "output"
+
+func AddFlags(flags *pflag.FlagSet) {
+	keys.AddFlags(flags)
+	flags.String(OutputKey, "[pst file]", "File to write the signed transaction to")
+}
+
+type Config struct {
+	Path       string
+	Keys       *keys.Config
+	OutputPath string
+}
+
+func ParseFlags(flags *pflag.FlagSet, args []string) (*Config, error) {
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+
+	path := flags.Arg(0)
+	outputPath := path
+	if flags.Changed(OutputKey) {
+		var err error
+		outputPath, err = flags.GetString(OutputKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	keysConfig, err := keys.ParseFlags(flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		Path:       path,
+		Keys:       keysConfig,
+		OutputPath: outputPath,
+	}, nil
+}
@@ -0,0 +1,222 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/codec"
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/set"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary"
+	"github.com/ava-labs/avalanchego/wallet/subnet/primary/common"
+
+	stdcontext "context"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+// Context is the state of the primary network that is needed to build txs
+// that spend the UTXOs of a set of addresses. It is exported on a machine with
+// access to the network so that txs can be built on a machine without it.
+type Context struct {
+	NetworkID   uint32        `json:"networkID"`
+	AVAXAssetID ids.ID        `json:"avaxAssetID"`
+	XChainID    ids.ID        `json:"xChainID"`
+	Fees        Fees          `json:"fees"`
+	Addresses   []ids.ShortID `json:"addresses"`
+	UTXOs       []ContextUTXO `json:"utxos"`
+	// PChainTxs are the P-chain txs, such as the CreateSubnetTx of a subnet,
+	// that are needed to build txs that modify subnets.
+	PChainTxs []types.JSONByteSlice `json:"pChainTxs,omitempty"`
+}
+
+type Fees struct {
+	BaseTxFee                     uint64 `json:"baseTxFee"`
+	CreateAssetTxFee              uint64 `json:"createAssetTxFee"`
+	CreateSubnetTxFee             uint64 `json:"createSubnetTxFee"`
+	TransformSubnetTxFee          uint64 `json:"transformSubnetTxFee"`
+	CreateBlockchainTxFee         uint64 `json:"createBlockchainTxFee"`
+	AddPrimaryNetworkValidatorFee uint64 `json:"addPrimaryNetworkValidatorFee"`
+	AddPrimaryNetworkDelegatorFee uint64 `json:"addPrimaryNetworkDelegatorFee"`
+	AddSubnetValidatorFee         uint64 `json:"addSubnetValidatorFee"`
+	AddSubnetDelegatorFee         uint64 `json:"addSubnetDelegatorFee"`
+}
+
+// ContextUTXO is a UTXO that can be spent on [DestinationChainID]
+type ContextUTXO struct {
+	SourceChainID      ids.ID              `json:"sourceChainID"`
+	DestinationChainID ids.ID              `json:"destinationChainID"`
+	UTXO               types.JSONByteSlice `json:"utxo"`
+}
+
+// FetchContext fetches the context of [addrs] from the node at [uri],
+// including the P-chain txs in [pChainTxIDs].
+func FetchContext(
+	ctx stdcontext.Context,
+	uri string,
+	addrs set.Set[ids.ShortID],
+	pChainTxIDs []ids.ID,
+) (*Context, error) {
+	state, err := primary.FetchState(ctx, uri, addrs)
+	if err != nil {
+		return nil, err
+	}
+
+	xChainID := state.XCTX.BlockchainID()
+	c := &Context{
+		NetworkID:   state.PCTX.NetworkID(),
+		AVAXAssetID: state.PCTX.AVAXAssetID(),
+		XChainID:    xChainID,
+		Fees: Fees{
+			BaseTxFee:                     state.PCTX.BaseTxFee(),
+			CreateAssetTxFee:              state.XCTX.CreateAssetTxFee(),
+			CreateSubnetTxFee:             state.PCTX.CreateSubnetTxFee(),
+			TransformSubnetTxFee:          state.PCTX.TransformSubnetTxFee(),
+			CreateBlockchainTxFee:         state.PCTX.CreateBlockchainTxFee(),
+			AddPrimaryNetworkValidatorFee: state.PCTX.AddPrimaryNetworkValidatorFee(),
+			AddPrimaryNetworkDelegatorFee: state.PCTX.AddPrimaryNetworkDelegatorFee(),
+			AddSubnetValidatorFee:         state.PCTX.AddSubnetValidatorFee(),
+			AddSubnetDelegatorFee:         state.PCTX.AddSubnetDelegatorFee(),
+		},
+		Addresses: addrs.List(),
+	}
+
+	sourceChainIDs := []ids.ID{
+		constants.PlatformChainID,
+		xChainID,
+		state.CCTX.BlockchainID(),
+	}
+	destinationChains := []struct {
+		id    ids.ID
+		codec codec.Manager
+	}{
+		{
+			id:    constants.PlatformChainID,
+			codec: ptxs.Codec,
+		},
+		{
+			id:    xChainID,
+			codec: x.Parser.Codec(),
+		},
+	}
+	for _, destinationChain := range destinationChains {
+		for _, sourceChainID := range sourceChainIDs {
+			utxos, err := state.UTXOs.UTXOs(ctx, sourceChainID, destinationChain.id)
+			if err != nil {
+				return nil, err
+			}
+			for _, utxo := range utxos {
+				utxoBytes, err := destinationChain.codec.Marshal(codecVersion, utxo)
+				if err != nil {
+					return nil, fmt.Errorf("couldn't marshal UTXO %s: %w", utxo.InputID(), err)
+				}
+				c.UTXOs = append(c.UTXOs, ContextUTXO{
+					SourceChainID:      sourceChainID,
+					DestinationChainID: destinationChain.id,
+					UTXO:               utxoBytes,
+				})
+			}
+		}
+	}
+
+	for _, txID := range pChainTxIDs {
+		txBytes, err := state.PClient.GetTx(ctx, txID)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't fetch tx %s: %w", txID, err)
+		}
+		c.PChainTxs = append(c.PChainTxs, txBytes)
+	}
+	return c, nil
+}
+
+// ReadContextFile reads the context at [path]
+func ReadContextFile(path string) (*Context, error) {
+	c := &Context{}
+	return c, readJSON(path, c)
+}
+
+// WriteFile writes the context to [path]
+func (c *Context) WriteFile(path string) error {
+	return writeJSON(path, c)
+}
+
+// AddressSet returns the addresses whose UTXOs are in the context
+func (c *Context) AddressSet() set.Set[ids.ShortID] {
+	return set.Of(c.Addresses...)
+}
+
+// PBackend returns a P-chain backend that builds txs from the context
+func (c *Context) PBackend(ctx stdcontext.Context) (p.Backend, error) {
+	utxos, err := c.utxos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pChainTxs := make(map[ids.ID]*ptxs.Tx, len(c.PChainTxs))
+	for _, txBytes := range c.PChainTxs {
+		tx, err := ptxs.Parse(ptxs.Codec, txBytes)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse P-chain tx: %w", err)
+		}
+		pChainTxs[tx.ID()] = tx
+	}
+
+	pCTX := p.NewContext(
+		c.NetworkID,
+		c.AVAXAssetID,
+		c.Fees.BaseTxFee,
+		c.Fees.CreateSubnetTxFee,
+		c.Fees.TransformSubnetTxFee,
+		c.Fees.CreateBlockchainTxFee,
+		c.Fees.AddPrimaryNetworkValidatorFee,
+		c.Fees.AddPrimaryNetworkDelegatorFee,
+		c.Fees.AddSubnetValidatorFee,
+		c.Fees.AddSubnetDelegatorFee,
+	)
+	pUTXOs := common.NewChainUTXOs(constants.PlatformChainID, utxos)
+	return p.NewBackend(pCTX, pUTXOs, pChainTxs), nil
+}
+
+// XBackend returns an X-chain backend that builds txs from the context
+func (c *Context) XBackend(ctx stdcontext.Context) (x.Backend, error) {
+	utxos, err := c.utxos(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	xCTX := x.NewContext(
+		c.NetworkID,
+		c.XChainID,
+		c.AVAXAssetID,
+		c.Fees.BaseTxFee,
+		c.Fees.CreateAssetTxFee,
+	)
+	xUTXOs := common.NewChainUTXOs(c.XChainID, utxos)
+	return x.NewBackend(xCTX, xUTXOs), nil
+}
+
+func (c *Context) utxos(ctx stdcontext.Context) (common.UTXOs, error) {
+	utxos := common.NewUTXOs()
+	for _, contextUTXO := range c.UTXOs {
+		codec := x.Parser.Codec()
+		if contextUTXO.DestinationChainID == constants.PlatformChainID {
+			codec = ptxs.Codec
+		}
+
+		utxo := &avax.UTXO{}
+		if _, err := codec.Unmarshal(contextUTXO.UTXO, utxo); err != nil {
+			return nil, fmt.Errorf("couldn't unmarshal UTXO: %w", err)
+		}
+		err := utxos.AddUTXO(ctx, contextUTXO.SourceChainID, contextUTXO.DestinationChainID, utxo)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return utxos, nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+)
+
+func TestContextBuildOffline(t *testing.T) {
+	require := require.New(t)
+
+	key := secp256k1.TestKeys()[0]
+	xChainID := ids.GenerateTestID()
+	avaxAssetID := ids.GenerateTestID()
+	utxo := &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 1000,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{key.Address()},
+			},
+		},
+	}
+	utxoBytes, err := x.Parser.Codec().Marshal(codecVersion, utxo)
+	require.NoError(err)
+
+	c := &Context{
+		NetworkID:   constants.UnitTestID,
+		AVAXAssetID: avaxAssetID,
+		XChainID:    xChainID,
+		Fees: Fees{
+			BaseTxFee: 10,
+		},
+		Addresses: []ids.ShortID{key.Address()},
+		UTXOs: []ContextUTXO{{
+			SourceChainID:      xChainID,
+			DestinationChainID: xChainID,
+			UTXO:               utxoBytes,
+		}},
+	}
+
+	// Build, sign, and finalize a transfer without access to the network
+	backend, err := c.XBackend(context.Background())
+	require.NoError(err)
+	utx, err := x.NewBuilder(c.AddressSet(), backend).NewBaseTx([]*avax.TransferableOutput{{
+		Asset: avax.Asset{ID: avaxAssetID},
+		Out: &secp256k1fx.TransferOutput{
+			Amt: 500,
+			OutputOwners: secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{ids.GenerateTestShortID()},
+			},
+		},
+	}})
+	require.NoError(err)
+	tx, err := NewX(context.Background(), utx, backend)
+	require.NoError(err)
+	require.NoError(tx.Sign(context.Background(), secp256k1fx.NewKeychain(key)))
+	txBytes, err := tx.Finalize()
+	require.NoError(err)
+
+	decodedTx, err := Decode(XChain, constants.UnitTestID, txBytes)
+	require.NoError(err)
+	signedTx, err := x.Parser.ParseTx(txBytes)
+	require.NoError(err)
+	require.Equal(signedTx.ID(), decodedTx.ID)
+
+	_, err = Decode("C", constants.UnitTestID, txBytes)
+	require.ErrorIs(err, ErrUnknownChain)
+}
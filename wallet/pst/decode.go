@@ -0,0 +1,65 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/snow"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+)
+
+// DecodedTx is a signed tx in a form that can be reviewed before it's issued
+type DecodedTx struct {
+	ID ids.ID      `json:"id"`
+	Tx interface{} `json:"tx"`
+}
+
+// Decode returns the decoded form of the signed tx [txBytes], which is issued
+// on [chain] of [networkID].
+func Decode(chain Chain, networkID uint32, txBytes []byte) (*DecodedTx, error) {
+	var (
+		tx  signedTx
+		err error
+	)
+	switch chain {
+	case PChain:
+		tx, err = parsePTx(txBytes)
+	case XChain:
+		tx, err = parseXTx(txBytes)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownChain, chain)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse tx: %w", err)
+	}
+	if err := initCtx(tx.unsigned(), chain, networkID); err != nil {
+		return nil, err
+	}
+	return &DecodedTx{
+		ID: hashing.ComputeHash256Array(txBytes),
+		Tx: tx.signed(),
+	}, nil
+}
+
+// initCtx initializes [utx] so that its addresses are formatted as addresses
+// of [chain] on [networkID] when it's marshalled to JSON.
+func initCtx(utx interface{}, chain Chain, networkID uint32) error {
+	initializable, ok := utx.(snow.ContextInitializable)
+	if !ok {
+		return nil
+	}
+
+	// The ID of the chain doesn't matter, as long as it's aliased to [chain]
+	bcLookup := ids.NewAliaser()
+	if err := bcLookup.Alias(ids.Empty, string(chain)); err != nil {
+		return err
+	}
+	initializable.InitCtx(&snow.Context{
+		NetworkID: networkID,
+		BCLookup:  bcLookup,
+	})
+	return nil
+}
@@ -0,0 +1,27 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/ava-labs/avalanchego/utils/perms"
+)
+
+func readJSON(path string, v interface{}) error {
+	fileBytes, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(fileBytes, v)
+}
+
+func writeJSON(path string, v interface{}) error {
+	fileBytes, err := json.MarshalIndent(v, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, fileBytes, perms.ReadWrite)
+}
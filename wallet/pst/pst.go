@@ -0,0 +1,318 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+// Package pst implements partially signed transactions, which allow the
+// signatures of a transaction to be collected from multiple signers that don't
+// share a keychain and may not have access to the network.
+package pst
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/crypto/keychain"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/utils/hashing"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+	"github.com/ava-labs/avalanchego/vms/types"
+	"github.com/ava-labs/avalanchego/wallet/chain/p"
+	"github.com/ava-labs/avalanchego/wallet/chain/x"
+
+	stdcontext "context"
+	xtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+const (
+	PChain Chain = "P"
+	XChain Chain = "X"
+)
+
+var (
+	ErrUnknownChain     = errors.New("unknown chain")
+	ErrMissingUTXO      = errors.New("missing UTXO")
+	ErrMismatchedTx     = errors.New("partially signed txs are for different txs")
+	ErrMissingSignature = errors.New("missing signature")
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	errWrongNumCredentials = errors.New("wrong number of credentials")
+	errWrongNumSignatures  = errors.New("wrong number of signatures")
+
+	emptySig [secp256k1.SignatureLen]byte
+)
+
+// Chain is the chain a partially signed tx is issued on
+type Chain string
+
+// UTXO is a UTXO consumed by a partially signed tx
+type UTXO struct {
+	// ChainID is the chain the UTXO is consumed from. It differs from the chain
+	// of the tx for imported UTXOs.
+	ChainID ids.ID              `json:"chainID"`
+	UTXO    types.JSONByteSlice `json:"utxo"`
+}
+
+// SubnetOwner is the owner of a subnet that a partially signed tx must be
+// authorized by
+type SubnetOwner struct {
+	SubnetID ids.ID              `json:"subnetID"`
+	Owner    types.JSONByteSlice `json:"owner"`
+}
+
+// Tx is a tx whose signatures are being collected. It holds everything that
+// is needed to sign the tx without access to the network.
+type Tx struct {
+	Chain Chain `json:"chain"`
+	// Tx is the signed tx. Signatures that haven't been collected yet are
+	// empty.
+	Tx types.JSONByteSlice `json:"tx"`
+	// Signers is the address that must provide each signature of each
+	// credential of the tx.
+	Signers      [][]ids.ShortID `json:"signers"`
+	UTXOs        []UTXO          `json:"utxos"`
+	SubnetOwners []SubnetOwner   `json:"subnetOwners,omitempty"`
+}
+
+// Signature is a signature that the tx requires
+type Signature struct {
+	Credential int         `json:"credential"`
+	Index      int         `json:"index"`
+	Signer     ids.ShortID `json:"signer"`
+	Signed     bool        `json:"signed"`
+}
+
+// NewP returns the partially signed form of [utx], which is issued on the
+// P-chain. The UTXOs that [utx] consumes and the owners of the subnets it
+// modifies are fetched from [backend].
+func NewP(ctx stdcontext.Context, utx ptxs.UnsignedTx, backend p.SignerBackend) (*Tx, error) {
+	r := &recorder{
+		utxos:  backend,
+		owners: backend,
+		codec:  ptxs.Codec,
+	}
+	return newTx(ctx, PChain, &pTx{tx: &ptxs.Tx{Unsigned: utx}}, r)
+}
+
+// NewX returns the partially signed form of [utx], which is issued on the
+// X-chain. The UTXOs that [utx] consumes are fetched from [backend].
+func NewX(ctx stdcontext.Context, utx xtxs.UnsignedTx, backend x.SignerBackend) (*Tx, error) {
+	r := &recorder{
+		utxos: backend,
+		codec: x.Parser.Codec(),
+	}
+	return newTx(ctx, XChain, &xTx{tx: &xtxs.Tx{Unsigned: utx}}, r)
+}
+
+func newTx(ctx stdcontext.Context, chain Chain, tx signedTx, r *recorder) (*Tx, error) {
+	// Signing with [addressKeychain] populates every signature with the
+	// address of its signer.
+	if err := tx.sign(ctx, addressKeychain{}, r); err != nil {
+		return nil, err
+	}
+	creds, err := tx.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	signers := make([][]ids.ShortID, len(creds))
+	for credIndex, cred := range creds {
+		signers[credIndex] = make([]ids.ShortID, len(cred.Sigs))
+		for sigIndex, sig := range cred.Sigs {
+			if sig == emptySig {
+				return nil, fmt.Errorf("%w for credential %d", ErrMissingUTXO, credIndex)
+			}
+			copy(signers[credIndex][sigIndex][:], sig[:])
+			cred.Sigs[sigIndex] = emptySig
+		}
+	}
+
+	txBytes, err := tx.marshal()
+	if err != nil {
+		return nil, err
+	}
+	return &Tx{
+		Chain:        chain,
+		Tx:           txBytes,
+		Signers:      signers,
+		UTXOs:        r.recordedUTXOs,
+		SubnetOwners: r.recordedOwners,
+	}, nil
+}
+
+// ReadFile reads the partially signed tx at [path]
+func ReadFile(path string) (*Tx, error) {
+	tx := &Tx{}
+	return tx, readJSON(path, tx)
+}
+
+// WriteFile writes the partially signed tx to [path]
+func (t *Tx) WriteFile(path string) error {
+	return writeJSON(path, t)
+}
+
+// Unsigned returns the unsigned tx. The addresses of the unsigned tx are
+// formatted for [networkID] when it's marshalled to JSON.
+func (t *Tx) Unsigned(networkID uint32) (interface{}, error) {
+	tx, _, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+	utx := tx.unsigned()
+	return utx, initCtx(utx, t.Chain, networkID)
+}
+
+// Sign adds every signature that [kc] can provide to the tx. Signatures that
+// were already collected are kept.
+func (t *Tx) Sign(ctx stdcontext.Context, kc keychain.Keychain) error {
+	tx, _, err := t.parse()
+	if err != nil {
+		return err
+	}
+	backend, err := t.backend()
+	if err != nil {
+		return err
+	}
+	if err := tx.sign(ctx, kc, backend); err != nil {
+		return err
+	}
+	if _, err := t.verifyCredentials(tx); err != nil {
+		return err
+	}
+	t.Tx, err = tx.marshal()
+	return err
+}
+
+// Merge adds the signatures that were collected by [other] to the tx
+func (t *Tx) Merge(other *Tx) error {
+	if t.Chain != other.Chain {
+		return fmt.Errorf("%w: %s != %s", ErrMismatchedTx, t.Chain, other.Chain)
+	}
+	tx, creds, err := t.parse()
+	if err != nil {
+		return err
+	}
+	otherTx, otherCreds, err := other.parse()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(tx.unsignedBytes(), otherTx.unsignedBytes()) {
+		return ErrMismatchedTx
+	}
+
+	for credIndex, cred := range creds {
+		for sigIndex, sig := range cred.Sigs {
+			if sig == emptySig {
+				cred.Sigs[sigIndex] = otherCreds[credIndex].Sigs[sigIndex]
+			}
+		}
+	}
+	t.Tx, err = tx.marshal()
+	return err
+}
+
+// Signatures returns every signature that the tx requires
+func (t *Tx) Signatures() ([]Signature, error) {
+	_, creds, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	var sigs []Signature
+	for credIndex, cred := range creds {
+		for sigIndex, sig := range cred.Sigs {
+			sigs = append(sigs, Signature{
+				Credential: credIndex,
+				Index:      sigIndex,
+				Signer:     t.Signers[credIndex][sigIndex],
+				Signed:     sig != emptySig,
+			})
+		}
+	}
+	return sigs, nil
+}
+
+// Finalize verifies that every signature of the tx has been collected and
+// returns the bytes of the signed tx.
+func (t *Tx) Finalize() ([]byte, error) {
+	tx, creds, err := t.parse()
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedHash := hashing.ComputeHash256(tx.unsignedBytes())
+	for credIndex, cred := range creds {
+		for sigIndex, sig := range cred.Sigs {
+			signer := t.Signers[credIndex][sigIndex]
+			if sig == emptySig {
+				return nil, fmt.Errorf("%w %d of credential %d from %s",
+					ErrMissingSignature,
+					sigIndex,
+					credIndex,
+					signer,
+				)
+			}
+
+			pk, err := secp256k1.RecoverPublicKeyFromHash(unsignedHash, sig[:])
+			if err != nil || pk.Address() != signer {
+				return nil, fmt.Errorf("%w %d of credential %d from %s",
+					ErrInvalidSignature,
+					sigIndex,
+					credIndex,
+					signer,
+				)
+			}
+		}
+	}
+	return tx.marshal()
+}
+
+// parse returns the tx and its credentials
+func (t *Tx) parse() (signedTx, []*secp256k1fx.Credential, error) {
+	var (
+		tx  signedTx
+		err error
+	)
+	switch t.Chain {
+	case PChain:
+		tx, err = parsePTx(t.Tx)
+	case XChain:
+		tx, err = parseXTx(t.Tx)
+	default:
+		return nil, nil, fmt.Errorf("%w: %q", ErrUnknownChain, t.Chain)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't parse tx: %w", err)
+	}
+
+	creds, err := t.verifyCredentials(tx)
+	return tx, creds, err
+}
+
+// verifyCredentials returns the credentials of [tx] after verifying that they
+// match the signers of the tx
+func (t *Tx) verifyCredentials(tx signedTx) ([]*secp256k1fx.Credential, error) {
+	creds, err := tx.credentials()
+	if err != nil {
+		return nil, err
+	}
+	if len(creds) != len(t.Signers) {
+		return nil, fmt.Errorf("%w: expected %d but got %d",
+			errWrongNumCredentials,
+			len(t.Signers),
+			len(creds),
+		)
+	}
+	for credIndex, cred := range creds {
+		if expected := len(t.Signers[credIndex]); len(cred.Sigs) != expected {
+			return nil, fmt.Errorf("%w for credential %d: expected %d but got %d",
+				errWrongNumSignatures,
+				credIndex,
+				expected,
+				len(cred.Sigs),
+			)
+		}
+	}
+	return creds, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (C) 2019-2024, Ava Labs, Inc. All rights reserved.
+// See the file LICENSE for licensing terms.
+
+package pst
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ava-labs/avalanchego/ids"
+	"github.com/ava-labs/avalanchego/utils/constants"
+	"github.com/ava-labs/avalanchego/utils/crypto/secp256k1"
+	"github.com/ava-labs/avalanchego/vms/components/avax"
+	"github.com/ava-labs/avalanchego/vms/platformvm/fx"
+	"github.com/ava-labs/avalanchego/vms/secp256k1fx"
+
+	xtxs "github.com/ava-labs/avalanchego/vms/avm/txs"
+	ptxs "github.com/ava-labs/avalanchego/vms/platformvm/txs"
+)
+
+func newUTXO(owners secp256k1fx.OutputOwners) *avax.UTXO {
+	return &avax.UTXO{
+		UTXOID: avax.UTXOID{TxID: ids.GenerateTestID()},
+		Asset:  avax.Asset{ID: ids.GenerateTestID()},
+		Out: &secp256k1fx.TransferOutput{
+			Amt:          1000,
+			OutputOwners: owners,
+		},
+	}
+}
+
+func newInput(utxo *avax.UTXO, sigIndices ...uint32) *avax.TransferableInput {
+	return &avax.TransferableInput{
+		UTXOID: utxo.UTXOID,
+		Asset:  utxo.Asset,
+		In: &secp256k1fx.TransferInput{
+			Amt:   1000,
+			Input: secp256k1fx.Input{SigIndices: sigIndices},
+		},
+	}
+}
+
+// copyTx returns a copy of [tx], as it would be received by another signer
+func copyTx(t *testing.T, tx *Tx) *Tx {
+	txJSON, err := json.Marshal(tx)
+	require.NoError(t, err)
+	txCopy := &Tx{}
+	require.NoError(t, json.Unmarshal(txJSON, txCopy))
+	return txCopy
+}
+
+func TestPChainTx(t *testing.T) {
+	require := require.New(t)
+
+	keys := secp256k1.TestKeys()
+	utxo := newUTXO(secp256k1fx.OutputOwners{
+		Threshold: 2,
+		Addrs: []ids.ShortID{
+			keys[0].Address(),
+			keys[1].Address(),
+			keys[2].Address(),
+		},
+	})
+	subnetID := ids.GenerateTestID()
+	onlineBackend := &backend{
+		utxos: map[ids.ID]map[ids.ID]*avax.UTXO{
+			constants.PlatformChainID: {
+				utxo.InputID(): utxo,
+			},
+		},
+		subnetOwners: map[ids.ID]fx.Owner{
+			subnetID: &secp256k1fx.OutputOwners{
+				Threshold: 1,
+				Addrs:     []ids.ShortID{keys[3].Address()},
+			},
+		},
+	}
+
+	utx := &ptxs.CreateChainTx{
+		BaseTx: ptxs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: constants.PlatformChainID,
+			Ins:          []*avax.TransferableInput{newInput(utxo, 0, 2)},
+		}},
+		SubnetID:   subnetID,
+		ChainName:  "chain",
+		SubnetAuth: &secp256k1fx.Input{SigIndices: []uint32{0}},
+	}
+	tx, err := NewP(context.Background(), utx, onlineBackend)
+	require.NoError(err)
+	require.Equal(
+		[][]ids.ShortID{
+			{keys[0].Address(), keys[2].Address()},
+			{keys[3].Address()},
+		},
+		tx.Signers,
+	)
+	require.Len(tx.UTXOs, 1)
+	require.Len(tx.SubnetOwners, 1)
+
+	// Each signer signs their own copy of the tx
+	txA := copyTx(t, tx)
+	require.NoError(txA.Sign(context.Background(), secp256k1fx.NewKeychain(keys[0], keys[3])))
+	txB := copyTx(t, tx)
+	require.NoError(txB.Sign(context.Background(), secp256k1fx.NewKeychain(keys[1], keys[2])))
+
+	sigs, err := txA.Signatures()
+	require.NoError(err)
+	require.Equal([]Signature{
+		{Credential: 0, Index: 0, Signer: keys[0].Address(), Signed: true},
+		{Credential: 0, Index: 1, Signer: keys[2].Address(), Signed: false},
+		{Credential: 1, Index: 0, Signer: keys[3].Address(), Signed: true},
+	}, sigs)
+
+	_, err = txA.Finalize()
+	require.ErrorIs(err, ErrMissingSignature)
+
+	require.NoError(txA.Merge(txB))
+	signedBytes, err := txA.Finalize()
+	require.NoError(err)
+
+	signedTx, err := ptxs.Parse(ptxs.Codec, signedBytes)
+	require.NoError(err)
+	require.Equal(utx.Bytes(), signedTx.Unsigned.Bytes())
+	require.Len(signedTx.Creds, 2)
+
+	// A signature that doesn't match its signer is invalid
+	txA.Signers[0][1] = keys[1].Address()
+	_, err = txA.Finalize()
+	require.ErrorIs(err, ErrInvalidSignature)
+}
+
+func TestXChainTx(t *testing.T) {
+	require := require.New(t)
+
+	keys := secp256k1.TestKeys()
+	chainID := ids.GenerateTestID()
+	utxo := newUTXO(secp256k1fx.OutputOwners{
+		Threshold: 1,
+		Addrs:     []ids.ShortID{keys[0].Address()},
+	})
+	onlineBackend := &backend{
+		utxos: map[ids.ID]map[ids.ID]*avax.UTXO{
+			chainID: {
+				utxo.InputID(): utxo,
+			},
+		},
+	}
+
+	newBaseTx := func(memo string) *xtxs.BaseTx {
+		return &xtxs.BaseTx{BaseTx: avax.BaseTx{
+			NetworkID:    constants.UnitTestID,
+			BlockchainID: chainID,
+			Ins:          []*avax.TransferableInput{newInput(utxo, 0)},
+			Memo:         []byte(memo),
+		}}
+	}
+	tx, err := NewX(context.Background(), newBaseTx("a"), onlineBackend)
+	require.NoError(err)
+	require.Equal([][]ids.ShortID{{keys[0].Address()}}, tx.Signers)
+
+	// Signing with an unrelated key doesn't add any signatures
+	require.NoError(tx.Sign(context.Background(), secp256k1fx.NewKeychain(keys[1])))
+	_, err = tx.Finalize()
+	require.ErrorIs(err, ErrMissingSignature)
+
+	// Signatures of different txs can't be merged
+	otherTx, err := NewX(context.Background(), newBaseTx("b"), onlineBackend)
+	require.NoError(err)
+	err = tx.Merge(otherTx)
+	require.ErrorIs(err, ErrMismatchedTx)
+
+	require.NoError(tx.Sign(context.Background(), secp256k1fx.NewKeychain(keys[0])))
+	_, err = tx.Finalize()
+	require.NoError(err)
+
+	// Txs can't be created without their UTXOs
+	_, err = NewX(context.Background(), newBaseTx("c"), &backend{})
+	require.ErrorIs(err, ErrMissingUTXO)
+}